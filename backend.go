@@ -0,0 +1,278 @@
+package steamworks
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// Backend is an implementation of the parts of the Steamworks API used by
+// this module.
+//
+// The default Backend calls into the Steamworks SDK. Tests can replace it
+// using SetBackend, for example with the fake in the steamtest package, to
+// run code that uses this module without a Steam client.
+//
+// Methods of Backend and the interfaces it returns correspond closely to
+// functions in the Steamworks API, but use Go types and return values rather
+// than output parameters. Callbacks registered with the On* methods are called
+// from RunCallbacks.
+type Backend interface {
+	// RestartAppIfNecessary implements steamworks.RestartAppIfNecessary.
+	RestartAppIfNecessary(ownAppID AppID) bool
+	// InitClient initializes the backend for a game client.
+	InitClient() error
+	// InitServer initializes the backend for a game server.
+	InitServer(ip uint32, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string) error
+	// Shutdown shuts down the client and server, if initialized.
+	Shutdown()
+	// RunCallbacks dispatches pending callbacks.
+	RunCallbacks()
+	// IsGameServer returns true if the backend is acting as a game server.
+	IsGameServer() bool
+	// Side returns a Backend for the game server if gameServer is true, or
+	// for the game client otherwise. Interfaces and callbacks that exist on
+	// both use the selected one, and Shutdown and RunCallbacks only affect
+	// the selected one.
+	Side(gameServer bool) Backend
+
+	// AppID returns the App ID of the current process.
+	AppID() AppID
+	// SteamID returns the SteamID of the current user or game server.
+	SteamID() SteamID
+	// APICallFailureReason returns the reason an API call failed.
+	APICallFailureReason(call APICall) APICallFailure
+	// OnEvent registers f to be called with each callback of the specified
+	// type, converted to an Event.
+	OnEvent(id CallbackID, f func(Event)) Registration
+	// Registrations returns the live callback registrations made through
+	// the backend, for both the game client and the game server.
+	Registrations() []RegistrationInfo
+
+	// Auth returns the user authentication API.
+	Auth() AuthBackend
+	// Networking returns the peer-to-peer networking API.
+	Networking() NetworkingBackend
+	// Utils returns the utility API.
+	Utils() UtilsBackend
+	// Controller returns the controller input API.
+	Controller() ControllerBackend
+	// Voice returns the voice chat API.
+	Voice() VoiceBackend
+	// ParentalSettings returns the parental settings API.
+	ParentalSettings() ParentalSettingsBackend
+	// UserStats returns the stats and leaderboards API.
+	UserStats() UserStatsBackend
+	// GameServer returns the game server API. Its methods must only be
+	// called if the backend is acting as a game server.
+	GameServer() GameServerBackend
+}
+
+// AuthBackend is the user authentication part of a Backend. Depending on
+// whether the Backend is acting as a game server, it wraps ISteamUser or
+// ISteamGameServer.
+type AuthBackend interface {
+	GetAuthSessionTicket(ticket []byte) (handle uint32, length int)
+	CancelAuthTicket(handle uint32)
+	BeginAuthSession(ticket []byte, steamID SteamID) internal.EBeginAuthSessionResult
+	EndAuthSession(steamID SteamID)
+	UserHasLicenseForApp(steamID SteamID, appID AppID) internal.EUserHasLicenseForAppResult
+
+	OnValidateAuthTicketResponse(f func(steamID, ownerID SteamID, response internal.EAuthSessionResponse)) Registration
+}
+
+// P2PSessionState is the state of a peer-to-peer session as returned by
+// NetworkingBackend.
+type P2PSessionState struct {
+	ConnectionActive     bool
+	Connecting           bool
+	SessionError         internal.EP2PSessionError
+	UsingRelay           bool
+	BytesQueuedForSend   int32
+	PacketsQueuedForSend int32
+	RemoteIP             uint32
+	RemotePort           uint16
+}
+
+// NetworkingBackend is the peer-to-peer networking part of a Backend. It
+// wraps ISteamNetworking.
+type NetworkingBackend interface {
+	SendP2PPacket(remote SteamID, data []byte, sendType internal.EP2PSend, channel int32) bool
+	IsP2PPacketAvailable(channel int32) (size uint32, ok bool)
+	ReadP2PPacket(buffer []byte, channel int32) (size uint32, remote SteamID, ok bool)
+	AcceptP2PSessionWithUser(remote SteamID) bool
+	CloseP2PSessionWithUser(remote SteamID) bool
+	CloseP2PChannelWithUser(remote SteamID, channel int32) bool
+	GetP2PSessionState(remote SteamID) (state P2PSessionState, ok bool)
+	AllowP2PPacketRelay(allow bool) bool
+
+	OnP2PSessionRequest(f func(remote SteamID)) Registration
+	OnP2PSessionConnectFail(f func(remote SteamID, sessionError internal.EP2PSessionError)) Registration
+}
+
+// UtilsBackend is the utility part of a Backend. It wraps ISteamUtils.
+type UtilsBackend interface {
+	CurrentBatteryPower() uint8
+	IPCountry() string
+	SecondsSinceAppActive() uint32
+	SecondsSinceComputerActive() uint32
+	ServerRealTime() uint32
+
+	OverlayNeedsPresent() bool
+	IsOverlayEnabled() bool
+	IsSteamInBigPictureMode() bool
+	SetOverlayNotificationInset(horizontal, vertical int32)
+	SetOverlayNotificationPosition(position internal.ENotificationPosition)
+	ShowGamepadTextInput(inputMode internal.EGamepadTextInputMode, lineInputMode internal.EGamepadTextInputLineMode, description string, maxLength uint32, existingText string) bool
+	GetEnteredGamepadTextInput(length uint32) (string, bool)
+
+	IsSteamRunningInVR() bool
+	StartVRDashboard()
+	IsVRHeadsetStreamingEnabled() bool
+	SetVRHeadsetStreamingEnabled(enabled bool)
+
+	// SetWarningMessageHook arranges for debug and warning messages from
+	// Steam to be passed to the given functions.
+	SetWarningMessageHook(debug, warning func(string))
+
+	OnLowBatteryPower(f func(minutesLeft uint8)) Registration
+	OnIPCountryChanged(f func()) Registration
+	OnSteamShutdown(f func()) Registration
+	OnGamepadTextInputDismissed(f func(submitted bool, length uint32)) Registration
+}
+
+// ControllerBackend is the controller input part of a Backend. It wraps
+// ISteamController.
+type ControllerBackend interface {
+	Init() bool
+	Shutdown() bool
+	RunFrame()
+
+	GetConnectedControllers(handles []internal.ControllerHandle) int
+	GetControllerForGamepadIndex(index int32) internal.ControllerHandle
+	GetGamepadIndexForController(controller internal.ControllerHandle) int32
+	GetMotionData(controller internal.ControllerHandle) (rotQuat [4]float32, posAccel, rotVel [3]float32)
+	SetLEDColor(controller internal.ControllerHandle, r, g, b uint8, flags internal.ESteamControllerLEDFlag)
+	ShowBindingPanel(controller internal.ControllerHandle) bool
+
+	GetActionSetHandle(name string) internal.ControllerActionSetHandle
+	ActivateActionSet(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle)
+	GetCurrentActionSet(controller internal.ControllerHandle) internal.ControllerActionSetHandle
+
+	GetDigitalActionHandle(name string) internal.ControllerDigitalActionHandle
+	GetDigitalActionData(controller internal.ControllerHandle, action internal.ControllerDigitalActionHandle) (state, active bool)
+	GetDigitalActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerDigitalActionHandle, origins []internal.EControllerActionOrigin) int
+
+	GetAnalogActionHandle(name string) internal.ControllerAnalogActionHandle
+	GetAnalogActionData(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) (x, y float32, mode internal.EControllerSourceMode, active bool)
+	GetAnalogActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerAnalogActionHandle, origins []internal.EControllerActionOrigin) int
+	StopAnalogActionMomentum(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle)
+
+	TriggerHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec uint16)
+	TriggerRepeatedHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec, offMicroSec, repeat uint16)
+	TriggerVibration(controller internal.ControllerHandle, leftSpeed, rightSpeed uint16)
+
+	GetGlyphForActionOrigin(origin internal.EControllerActionOrigin) string
+	GetStringForActionOrigin(origin internal.EControllerActionOrigin) string
+}
+
+// VoiceBackend is the voice chat part of a Backend. It wraps the voice
+// functions of ISteamUser and ISteamFriends.
+type VoiceBackend interface {
+	StartVoiceRecording()
+	StopVoiceRecording()
+	SetInGameVoiceSpeaking(speaking bool)
+	GetAvailableVoice() (size uint32, result internal.EVoiceResult)
+	GetVoice(buffer []byte) (size uint32, result internal.EVoiceResult)
+	DecompressVoice(compressed []byte, buffer []uint16, sampleRate uint32) (samples uint32, result internal.EVoiceResult)
+	GetVoiceOptimalSampleRate() uint32
+}
+
+// ParentalSettingsBackend is the parental settings part of a Backend. It
+// wraps ISteamParentalSettings.
+type ParentalSettingsBackend interface {
+	IsParentalLockEnabled() bool
+	IsParentalLockLocked() bool
+	IsAppBlocked(appID AppID) bool
+	IsAppInBlockList(appID AppID) bool
+	IsFeatureBlocked(feature internal.EParentalFeature) bool
+	IsFeatureInBlockList(feature internal.EParentalFeature) bool
+
+	OnParentalSettingsChanged(f func()) Registration
+}
+
+// UserStatsBackend is the stats and leaderboards part of a Backend. It wraps
+// ISteamUserStats.
+type UserStatsBackend interface {
+	FindLeaderboard(name string) APICall
+
+	// OnLeaderboardFindResult registers f to be called with the result of
+	// call, which was returned by FindLeaderboard. The registration is
+	// removed after the result is delivered.
+	OnLeaderboardFindResult(call APICall, f func(leaderboard uint64, found, ioFailure bool)) Registration
+}
+
+// GameServerBackend is the game server part of a Backend. It wraps
+// ISteamGameServer.
+type GameServerBackend interface {
+	// HandleIncomingPacket passes a packet received on the game port to
+	// Steam, for servers in GameSocketShare mode. The address is an IPv4
+	// address in host byte order.
+	HandleIncomingPacket(data []byte, srcIP uint32, srcPort uint16) bool
+	// GetNextOutgoingPacket copies the next packet Steam wants to send from
+	// the game port into buffer. It returns 0 if there are no packets.
+	GetNextOutgoingPacket(buffer []byte) (size int, dstIP uint32, dstPort uint16)
+}
+
+// ErrUnsupported is returned by InitClient, InitServer, and functions in the
+// other steamworks packages that return errors if the Steamworks SDK is not
+// available in this build. This is the case if cgo is disabled or the target
+// is not 386 or amd64 Windows, Linux, or macOS.
+//
+// Packages that only use value types such as SteamID and GameID work in every
+// build. Code that uses the rest of the API can still be tested in these
+// builds by installing another Backend, such as the steamtest fake.
+var ErrUnsupported = errors.New("steamworks: the Steamworks API is not supported by this build")
+
+// Supported returns false if the current Backend is the placeholder used when
+// the Steamworks SDK is not available in this build. Every function on the
+// placeholder Backend does nothing, and InitClient and InitServer return
+// ErrUnsupported.
+func Supported() bool {
+	b := GetBackend()
+	if ib, ok := b.(instrumentedBackend); ok {
+		b = ib.b
+	}
+
+	_, unsupported := b.(unsupportedBackend)
+	return !unsupported
+}
+
+var backendLock sync.RWMutex
+var backend Backend = defaultBackend
+
+// GetBackend returns the Backend currently in use.
+func GetBackend() Backend {
+	backendLock.RLock()
+	defer backendLock.RUnlock()
+
+	return backend
+}
+
+// SetBackend replaces the Backend used by this module and returns the
+// previous Backend. Passing nil restores the default Backend.
+//
+// SetBackend must not be called while the Steamworks API is initialized.
+func SetBackend(b Backend) Backend {
+	if b == nil {
+		b = defaultBackend
+	}
+
+	backendLock.Lock()
+	defer backendLock.Unlock()
+
+	previous := backend
+	backend = b
+	return previous
+}
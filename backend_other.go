@@ -0,0 +1,17 @@
+//go:build !cgo || !(386 || amd64) || !(windows || linux || darwin)
+// +build !cgo !386,!amd64 !windows,!linux,!darwin
+
+package steamworks
+
+import "time"
+
+var defaultBackend Backend = unsupportedBackend{}
+
+// Callbacks from the Steamworks SDK only exist in builds that link it.
+func setDispatch(enabled bool) {}
+func callbacksRun() uint64     { return 0 }
+
+// The worker thread only exists in builds that link the Steamworks SDK.
+func startWorker(releaseInterval time.Duration) {}
+func stopWorker()                               {}
+func batch(fn func())                           { fn() }
@@ -0,0 +1,791 @@
+//go:build cgo && (windows || linux || darwin) && (386 || amd64)
+// +build cgo
+// +build windows linux darwin
+// +build 386 amd64
+
+package steamworks
+
+import (
+	"runtime"
+	"sync/atomic"
+	"time"
+	"unsafe"
+
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamapi"
+)
+
+var defaultBackend Backend = steamBackend{}
+
+// steamBackend is the default Backend, which calls into the Steamworks SDK.
+// The side decides whether interfaces and callbacks that exist on both game
+// clients and game servers use the client or the server.
+type steamBackend struct {
+	side internal.Side
+}
+
+func init() {
+	internal.OnPanic = func(callbackType int32, value interface{}, stack []byte) {
+		reportPanic(CallbackPanic{
+			CallbackID: CallbackID(callbackType),
+			Value:      value,
+			Stack:      stack,
+		})
+	}
+}
+
+// setDispatch routes callbacks through Execute while an Executor or a
+// dispatch queue is configured. Otherwise, callbacks run immediately, without
+// copying their data.
+func setDispatch(enabled bool) {
+	if enabled {
+		internal.Dispatch = Execute
+	} else {
+		internal.Dispatch = nil
+	}
+}
+
+// callbacksRun returns the number of callbacks run without Execute.
+func callbacksRun() uint64 { return atomic.LoadUint64(&internal.Ran) }
+
+func startWorker(releaseInterval time.Duration) { internal.StartWorker(releaseInterval) }
+func stopWorker()                               { internal.StopWorker() }
+func batch(fn func())                           { internal.Call(fn) }
+
+// call runs f using internal.Call and returns its result.
+func call[T any](f func() T) T {
+	var result T
+	internal.Call(func() {
+		result = f()
+	})
+	return result
+}
+
+func (steamBackend) RestartAppIfNecessary(ownAppID AppID) bool {
+	return call(func() bool {
+		return internal.SteamAPI_RestartAppIfNecessary(uint32(ownAppID))
+	})
+}
+
+func (steamBackend) InitClient() error {
+	if !internal.SteamAPI_Init() {
+		return diagnoseClientInit(internal.SteamAPI_IsSteamRunning())
+	}
+
+	return nil
+}
+
+func (steamBackend) InitServer(ip uint32, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string) error {
+	cversion := internal.CString(version)
+	defer internal.Free(unsafe.Pointer(cversion))
+
+	if !internal.SteamGameServer_Init(ip, steamPort, gamePort, queryPort, serverMode, cversion) {
+		return diagnoseServerInit(ip, steamPort, queryPort)
+	}
+
+	return nil
+}
+
+func (b steamBackend) Shutdown() {
+	internal.SteamAPI_Shutdown(b.side)
+}
+
+func (b steamBackend) RunCallbacks() {
+	internal.SteamAPI_RunCallbacks(b.side)
+}
+
+func (b steamBackend) IsGameServer() bool {
+	return b.side.GameServer()
+}
+
+func (b steamBackend) Side(gameServer bool) Backend {
+	if gameServer {
+		return steamBackend{side: internal.SideServer}
+	}
+	return steamBackend{side: internal.SideClient}
+}
+
+func (b steamBackend) AppID() AppID {
+	return AppID(internal.SteamAPI_ISteamUtils_GetAppID(b.side))
+}
+
+func (b steamBackend) SteamID() SteamID {
+	if b.side.GameServer() {
+		return SteamID(internal.SteamAPI_ISteamGameServer_GetSteamID())
+	}
+
+	return SteamID(internal.SteamAPI_ISteamUser_GetSteamID())
+}
+
+func (b steamBackend) APICallFailureReason(call APICall) APICallFailure {
+	return internal.SteamAPI_ISteamUtils_GetAPICallFailureReason(b.side, internal.SteamAPICall(call))
+}
+
+func (b steamBackend) OnEvent(id CallbackID, f func(Event)) Registration {
+	register, ok := eventRegistrations[id]
+	if !ok {
+		return unsupportedRegistration{}
+	}
+
+	return register(b.side, f)
+}
+
+func (steamBackend) Registrations() []RegistrationInfo {
+	regs := internal.Registrations()
+	infos := make([]RegistrationInfo, len(regs))
+	for i, r := range regs {
+		infos[i] = RegistrationInfo{
+			ID:         uint64(r.ID),
+			CallbackID: CallbackID(r.CallbackType),
+			Call:       APICall(r.APICallID),
+			GameServer: r.GameServer,
+			Registered: r.Registered,
+			PCs:        r.PCs,
+		}
+	}
+	return infos
+}
+
+// goStringArray converts a NUL-terminated C char array to a string.
+func goStringArray(s []internal.CChar) string {
+	b := make([]byte, 0, len(s))
+	for _, c := range s {
+		if c == 0 {
+			break
+		}
+		b = append(b, byte(c))
+	}
+	return string(b)
+}
+
+func (b steamBackend) Auth() AuthBackend             { return steamAuth{b.side} }
+func (b steamBackend) Networking() NetworkingBackend { return steamNetworking{b.side} }
+func (b steamBackend) Utils() UtilsBackend           { return steamUtils{b.side} }
+func (steamBackend) Controller() ControllerBackend   { return steamController{} }
+func (steamBackend) Voice() VoiceBackend             { return steamVoice{} }
+func (steamBackend) ParentalSettings() ParentalSettingsBackend {
+	return steamParentalSettings{steamapi.SteamParentalSettings()}
+}
+func (steamBackend) UserStats() UserStatsBackend   { return steamUserStats{} }
+func (steamBackend) GameServer() GameServerBackend { return steamGameServer{} }
+
+type steamAuth struct {
+	side internal.Side
+}
+
+func (a steamAuth) GetAuthSessionTicket(ticket []byte) (uint32, int) {
+	var handle internal.HAuthTicket
+	var actualLength uint32
+
+	internal.Call(func() {
+		if a.side.GameServer() {
+			handle = internal.SteamAPI_ISteamGameServer_GetAuthSessionTicket(unsafe.Pointer(&ticket[0]), int32(len(ticket)), &actualLength)
+		} else {
+			handle = internal.SteamAPI_ISteamUser_GetAuthSessionTicket(unsafe.Pointer(&ticket[0]), int32(len(ticket)), &actualLength)
+		}
+	})
+
+	return uint32(handle), int(actualLength)
+}
+
+func (a steamAuth) CancelAuthTicket(handle uint32) {
+	internal.Call(func() {
+		if a.side.GameServer() {
+			internal.SteamAPI_ISteamGameServer_CancelAuthTicket(internal.HAuthTicket(handle))
+		} else {
+			internal.SteamAPI_ISteamUser_CancelAuthTicket(internal.HAuthTicket(handle))
+		}
+	})
+}
+
+func (a steamAuth) BeginAuthSession(ticket []byte, steamID SteamID) internal.EBeginAuthSessionResult {
+	if len(ticket) == 0 {
+		return internal.EBeginAuthSessionResult_InvalidTicket
+	}
+
+	return call(func() internal.EBeginAuthSessionResult {
+		var result internal.EBeginAuthSessionResult
+
+		if a.side.GameServer() {
+			result = internal.SteamAPI_ISteamGameServer_BeginAuthSession(unsafe.Pointer(&ticket[0]), int32(len(ticket)), internal.SteamID(steamID))
+		} else {
+			result = internal.SteamAPI_ISteamUser_BeginAuthSession(unsafe.Pointer(&ticket[0]), int32(len(ticket)), internal.SteamID(steamID))
+		}
+
+		runtime.KeepAlive(ticket)
+
+		return result
+	})
+}
+
+func (a steamAuth) EndAuthSession(steamID SteamID) {
+	internal.Call(func() {
+		if a.side.GameServer() {
+			internal.SteamAPI_ISteamGameServer_EndAuthSession(internal.SteamID(steamID))
+		} else {
+			internal.SteamAPI_ISteamUser_EndAuthSession(internal.SteamID(steamID))
+		}
+	})
+}
+
+func (a steamAuth) UserHasLicenseForApp(steamID SteamID, appID AppID) internal.EUserHasLicenseForAppResult {
+	return call(func() internal.EUserHasLicenseForAppResult {
+		if a.side.GameServer() {
+			return internal.SteamAPI_ISteamGameServer_UserHasLicenseForApp(internal.SteamID(steamID), internal.AppId(appID))
+		}
+
+		return internal.SteamAPI_ISteamUser_UserHasLicenseForApp(internal.SteamID(steamID), internal.AppId(appID))
+	})
+}
+
+func (a steamAuth) OnValidateAuthTicketResponse(f func(steamID, ownerID SteamID, response internal.EAuthSessionResponse)) Registration {
+	return internal.RegisterCallback_ValidateAuthTicketResponse(func(data *internal.ValidateAuthTicketResponse, _ bool) {
+		f(SteamID(data.SteamID.Get()), SteamID(data.OwnerSteamID.Get()), internal.EAuthSessionResponse(data.EAuthSessionResponse))
+	}, 0, a.side)
+}
+
+type steamNetworking struct {
+	side internal.Side
+}
+
+func (n steamNetworking) SendP2PPacket(remote SteamID, data []byte, sendType internal.EP2PSend, channel int32) bool {
+	return call(func() bool {
+		var ptr unsafe.Pointer
+		if len(data) != 0 {
+			ptr = unsafe.Pointer(&data[0])
+		}
+
+		ok := internal.SteamAPI_ISteamNetworking_SendP2PPacket(n.side, internal.SteamID(remote), ptr, uint32(len(data)), sendType, channel)
+		runtime.KeepAlive(data)
+
+		return ok
+	})
+}
+
+func (n steamNetworking) IsP2PPacketAvailable(channel int32) (uint32, bool) {
+	var size uint32
+	var ok bool
+	internal.Call(func() {
+		ok = internal.SteamAPI_ISteamNetworking_IsP2PPacketAvailable(n.side, &size, channel)
+	})
+
+	return size, ok
+}
+
+func (n steamNetworking) ReadP2PPacket(buffer []byte, channel int32) (uint32, SteamID, bool) {
+	var ptr unsafe.Pointer
+	if len(buffer) != 0 {
+		ptr = unsafe.Pointer(&buffer[0])
+	}
+
+	var size uint32
+	var steamID internal.SteamID
+	var ok bool
+	internal.Call(func() {
+		ok = internal.SteamAPI_ISteamNetworking_ReadP2PPacket(n.side, ptr, uint32(len(buffer)), &size, &steamID, channel)
+	})
+	runtime.KeepAlive(buffer)
+
+	return size, SteamID(steamID), ok
+}
+
+func (n steamNetworking) AcceptP2PSessionWithUser(remote SteamID) bool {
+	return internal.SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(n.side, internal.SteamID(remote))
+}
+
+func (n steamNetworking) CloseP2PSessionWithUser(remote SteamID) bool {
+	return call(func() bool {
+		return internal.SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(n.side, internal.SteamID(remote))
+	})
+}
+
+func (n steamNetworking) CloseP2PChannelWithUser(remote SteamID, channel int32) bool {
+	return call(func() bool {
+		return internal.SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(n.side, internal.SteamID(remote), channel)
+	})
+}
+
+func (n steamNetworking) GetP2PSessionState(remote SteamID) (P2PSessionState, bool) {
+	var state internal.P2PSessionState
+	if !call(func() bool {
+		return internal.SteamAPI_ISteamNetworking_GetP2PSessionState(n.side, internal.SteamID(remote), &state)
+	}) {
+		return P2PSessionState{}, false
+	}
+
+	return P2PSessionState{
+		ConnectionActive:     state.BConnectionActive != 0,
+		Connecting:           state.BConnecting != 0,
+		SessionError:         internal.EP2PSessionError(state.EP2PSessionError),
+		UsingRelay:           state.BUsingRelay != 0,
+		BytesQueuedForSend:   int32(state.NBytesQueuedForSend),
+		PacketsQueuedForSend: int32(state.NPacketsQueuedForSend),
+		RemoteIP:             uint32(state.NRemoteIP),
+		RemotePort:           uint16(state.NRemotePort),
+	}, true
+}
+
+func (n steamNetworking) AllowP2PPacketRelay(allow bool) bool {
+	return internal.SteamAPI_ISteamNetworking_AllowP2PPacketRelay(n.side, allow)
+}
+
+func (n steamNetworking) OnP2PSessionRequest(f func(SteamID)) Registration {
+	return internal.RegisterCallback_P2PSessionRequest(func(data *internal.P2PSessionRequest, _ bool) {
+		f(SteamID(data.SteamIDRemote.Get()))
+	}, 0, n.side)
+}
+
+func (n steamNetworking) OnP2PSessionConnectFail(f func(SteamID, internal.EP2PSessionError)) Registration {
+	return internal.RegisterCallback_P2PSessionConnectFail(func(data *internal.P2PSessionConnectFail, _ bool) {
+		f(SteamID(data.SteamIDRemote.Get()), internal.EP2PSessionError(data.EP2PSessionError))
+	}, 0, n.side)
+}
+
+type steamUtils struct {
+	side internal.Side
+}
+
+func (u steamUtils) CurrentBatteryPower() uint8 {
+	return internal.SteamAPI_ISteamUtils_GetCurrentBatteryPower(u.side)
+}
+
+func (u steamUtils) IPCountry() string {
+	return internal.GoString(internal.SteamAPI_ISteamUtils_GetIPCountry(u.side))
+}
+
+func (u steamUtils) SecondsSinceAppActive() uint32 {
+	return internal.SteamAPI_ISteamUtils_GetSecondsSinceAppActive(u.side)
+}
+
+func (u steamUtils) SecondsSinceComputerActive() uint32 {
+	return internal.SteamAPI_ISteamUtils_GetSecondsSinceComputerActive(u.side)
+}
+
+func (u steamUtils) ServerRealTime() uint32 {
+	return internal.SteamAPI_ISteamUtils_GetServerRealTime(u.side)
+}
+
+func (u steamUtils) OverlayNeedsPresent() bool {
+	return internal.SteamAPI_ISteamUtils_BOverlayNeedsPresent(u.side)
+}
+
+func (u steamUtils) IsOverlayEnabled() bool {
+	return internal.SteamAPI_ISteamUtils_IsOverlayEnabled(u.side)
+}
+
+func (u steamUtils) IsSteamInBigPictureMode() bool {
+	return internal.SteamAPI_ISteamUtils_IsSteamInBigPictureMode(u.side)
+}
+
+func (u steamUtils) SetOverlayNotificationInset(horizontal, vertical int32) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamUtils_SetOverlayNotificationInset(u.side, horizontal, vertical)
+	})
+}
+
+func (u steamUtils) SetOverlayNotificationPosition(position internal.ENotificationPosition) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(u.side, position)
+	})
+}
+
+func (u steamUtils) ShowGamepadTextInput(inputMode internal.EGamepadTextInputMode, lineInputMode internal.EGamepadTextInputLineMode, description string, maxLength uint32, existingText string) bool {
+	return call(func() bool {
+		cdescription := internal.CString(description)
+		defer internal.Free(unsafe.Pointer(cdescription))
+		cexistingText := internal.CString(existingText)
+		defer internal.Free(unsafe.Pointer(cexistingText))
+
+		return internal.SteamAPI_ISteamUtils_ShowGamepadTextInput(u.side, inputMode, lineInputMode, cdescription, maxLength, cexistingText)
+	})
+}
+
+func (u steamUtils) GetEnteredGamepadTextInput(length uint32) (string, bool) {
+	ctextBuf := internal.Malloc(uintptr(length) + 1)
+	defer internal.Free(ctextBuf)
+	ctext := (*internal.CChar)(ctextBuf)
+	if !internal.SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(u.side, ctext, length) {
+		return "", false
+	}
+
+	return internal.GoStringN(ctext, uintptr(length)), true
+}
+
+func (u steamUtils) IsSteamRunningInVR() bool {
+	return internal.SteamAPI_ISteamUtils_IsSteamRunningInVR(u.side)
+}
+
+func (u steamUtils) StartVRDashboard() {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamUtils_StartVRDashboard(u.side)
+	})
+}
+
+func (u steamUtils) IsVRHeadsetStreamingEnabled() bool {
+	return internal.SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled(u.side)
+}
+
+func (u steamUtils) SetVRHeadsetStreamingEnabled(enabled bool) {
+	internal.SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(u.side, enabled)
+}
+
+func (steamUtils) SetWarningMessageHook(debug, warning func(string)) {
+	internal.OnDebugMessage = debug
+	internal.OnWarningMessage = warning
+	internal.SetWarningMessageHook()
+}
+
+func (u steamUtils) OnLowBatteryPower(f func(uint8)) Registration {
+	return internal.RegisterCallback_LowBatteryPower(func(data *internal.LowBatteryPower, _ bool) {
+		f(uint8(data.NMinutesBatteryLeft))
+	}, 0, u.side)
+}
+
+func (u steamUtils) OnIPCountryChanged(f func()) Registration {
+	return internal.RegisterCallback_IPCountry(func(*internal.IPCountry, bool) {
+		f()
+	}, 0, u.side)
+}
+
+func (u steamUtils) OnSteamShutdown(f func()) Registration {
+	return internal.RegisterCallback_SteamShutdown(func(*internal.SteamShutdown, bool) {
+		f()
+	}, 0, u.side)
+}
+
+func (u steamUtils) OnGamepadTextInputDismissed(f func(bool, uint32)) Registration {
+	return internal.RegisterCallback_GamepadTextInputDismissed(func(data *internal.GamepadTextInputDismissed, _ bool) {
+		f(bool(data.BSubmitted), uint32(data.UnSubmittedText))
+	}, 0, u.side)
+}
+
+type steamController struct{}
+
+func (steamController) Init() bool {
+	return call(func() bool {
+		return internal.SteamAPI_ISteamController_Init()
+	})
+}
+
+func (steamController) Shutdown() bool {
+	return call(func() bool {
+		return internal.SteamAPI_ISteamController_Shutdown()
+	})
+}
+
+func (steamController) RunFrame() {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamController_RunFrame()
+	})
+}
+
+func (steamController) GetConnectedControllers(handles []internal.ControllerHandle) int {
+	return call(func() int {
+		// STEAM_CONTROLLER_MAX_COUNT
+		var handlesOut [16]internal.ControllerHandle
+
+		count := internal.SteamAPI_ISteamController_GetConnectedControllers(&handlesOut[0])
+
+		return copy(handles, handlesOut[:count])
+	})
+}
+
+func (steamController) GetControllerForGamepadIndex(index int32) internal.ControllerHandle {
+	return call(func() internal.ControllerHandle {
+		return internal.SteamAPI_ISteamController_GetControllerForGamepadIndex(index)
+	})
+}
+
+func (steamController) GetGamepadIndexForController(controller internal.ControllerHandle) int32 {
+	return call(func() int32 {
+		return internal.SteamAPI_ISteamController_GetGamepadIndexForController(controller)
+	})
+}
+
+func (steamController) GetMotionData(controller internal.ControllerHandle) (rotQuat [4]float32, posAccel, rotVel [3]float32) {
+	data := call(func() internal.ControllerMotionData {
+		return internal.SteamAPI_ISteamController_GetMotionData(controller)
+	})
+
+	rotQuat = [4]float32{
+		float32(data.RotQuatX),
+		float32(data.RotQuatY),
+		float32(data.RotQuatZ),
+		float32(data.RotQuatW),
+	}
+	posAccel = [3]float32{
+		float32(data.PosAccelX),
+		float32(data.PosAccelY),
+		float32(data.PosAccelZ),
+	}
+	rotVel = [3]float32{
+		float32(data.RotVelX),
+		float32(data.RotVelY),
+		float32(data.RotVelZ),
+	}
+
+	return
+}
+
+func (steamController) SetLEDColor(controller internal.ControllerHandle, r, g, b uint8, flags internal.ESteamControllerLEDFlag) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamController_SetLEDColor(controller, r, g, b, uint32(flags))
+	})
+}
+
+func (steamController) ShowBindingPanel(controller internal.ControllerHandle) bool {
+	return call(func() bool {
+		return internal.SteamAPI_ISteamController_ShowBindingPanel(controller)
+	})
+}
+
+func (steamController) GetActionSetHandle(name string) internal.ControllerActionSetHandle {
+	return call(func() internal.ControllerActionSetHandle {
+		cname := internal.CString(name)
+		defer internal.Free(unsafe.Pointer(cname))
+
+		return internal.SteamAPI_ISteamController_GetActionSetHandle(cname)
+	})
+}
+
+func (steamController) ActivateActionSet(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamController_ActivateActionSet(controller, actionSet)
+	})
+}
+
+func (steamController) GetCurrentActionSet(controller internal.ControllerHandle) internal.ControllerActionSetHandle {
+	return call(func() internal.ControllerActionSetHandle {
+		return internal.SteamAPI_ISteamController_GetCurrentActionSet(controller)
+	})
+}
+
+func (steamController) GetDigitalActionHandle(name string) internal.ControllerDigitalActionHandle {
+	return call(func() internal.ControllerDigitalActionHandle {
+		cname := internal.CString(name)
+		defer internal.Free(unsafe.Pointer(cname))
+
+		return internal.SteamAPI_ISteamController_GetDigitalActionHandle(cname)
+	})
+}
+
+func (steamController) GetDigitalActionData(controller internal.ControllerHandle, action internal.ControllerDigitalActionHandle) (state, active bool) {
+	data := call(func() internal.ControllerDigitalActionData {
+		return internal.SteamAPI_ISteamController_GetDigitalActionData(controller, action)
+	})
+
+	return bool(data.BState), bool(data.BActive)
+}
+
+func (steamController) GetDigitalActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerDigitalActionHandle, origins []internal.EControllerActionOrigin) int {
+	return call(func() int {
+		// STEAM_CONTROLLER_MAX_ORIGINS
+		var originsOut [8]internal.EControllerActionOrigin
+
+		count := internal.SteamAPI_ISteamController_GetDigitalActionOrigins(controller, actionSet, action, &originsOut[0])
+
+		return copy(origins, originsOut[:count])
+	})
+}
+
+func (steamController) GetAnalogActionHandle(name string) internal.ControllerAnalogActionHandle {
+	return call(func() internal.ControllerAnalogActionHandle {
+		cname := internal.CString(name)
+		defer internal.Free(unsafe.Pointer(cname))
+
+		return internal.SteamAPI_ISteamController_GetAnalogActionHandle(cname)
+	})
+}
+
+func (steamController) GetAnalogActionData(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) (x, y float32, mode internal.EControllerSourceMode, active bool) {
+	data := call(func() internal.ControllerAnalogActionData {
+		return internal.SteamAPI_ISteamController_GetAnalogActionData(controller, action)
+	})
+
+	return float32(data.X), float32(data.Y), internal.EControllerSourceMode(data.EMode), bool(data.BActive)
+}
+
+func (steamController) GetAnalogActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerAnalogActionHandle, origins []internal.EControllerActionOrigin) int {
+	return call(func() int {
+		// STEAM_CONTROLLER_MAX_ORIGINS
+		var originsOut [8]internal.EControllerActionOrigin
+
+		count := internal.SteamAPI_ISteamController_GetAnalogActionOrigins(controller, actionSet, action, &originsOut[0])
+
+		return copy(origins, originsOut[:count])
+	})
+}
+
+func (steamController) StopAnalogActionMomentum(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamController_StopAnalogActionMomentum(controller, action)
+	})
+}
+
+func (steamController) TriggerHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec uint16) {
+	internal.Call(func() {
+		internal.SteamAPI_ISteamController_TriggerHapticPulse(controller, targetPad, durationMicroSec)
+	})
+}
+
+func (steamController) TriggerRepeatedHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec, offMicroSec, repeat uint16) {
+	internal.SteamAPI_ISteamController_TriggerRepeatedHapticPulse(controller, targetPad, durationMicroSec, offMicroSec, repeat, 0)
+}
+
+func (steamController) TriggerVibration(controller internal.ControllerHandle, leftSpeed, rightSpeed uint16) {
+	internal.SteamAPI_ISteamController_TriggerVibration(controller, leftSpeed, rightSpeed)
+}
+
+func (steamController) GetGlyphForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return call(func() string {
+		return internal.GoString(internal.SteamAPI_ISteamController_GetGlyphForActionOrigin(origin))
+	})
+}
+
+func (steamController) GetStringForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return call(func() string {
+		return internal.GoString(internal.SteamAPI_ISteamController_GetStringForActionOrigin(origin))
+	})
+}
+
+type steamVoice struct{}
+
+func (steamVoice) StartVoiceRecording() {
+	internal.SteamAPI_ISteamUser_StartVoiceRecording()
+}
+
+func (steamVoice) StopVoiceRecording() {
+	internal.SteamAPI_ISteamUser_StopVoiceRecording()
+}
+
+func (steamVoice) SetInGameVoiceSpeaking(speaking bool) {
+	internal.SteamAPI_ISteamFriends_SetInGameVoiceSpeaking(0, speaking)
+}
+
+func (steamVoice) GetAvailableVoice() (uint32, internal.EVoiceResult) {
+	var bytesAvailable uint32
+	result := call(func() internal.EVoiceResult {
+		return internal.SteamAPI_ISteamUser_GetAvailableVoice(&bytesAvailable, nil, 0)
+	})
+
+	return bytesAvailable, result
+}
+
+func (steamVoice) GetVoice(buffer []byte) (uint32, internal.EVoiceResult) {
+	var ptr unsafe.Pointer
+	if len(buffer) != 0 {
+		ptr = unsafe.Pointer(&buffer[0])
+	}
+
+	var bytesWritten uint32
+	result := call(func() internal.EVoiceResult {
+		return internal.SteamAPI_ISteamUser_GetVoice(true, ptr, uint32(len(buffer)), &bytesWritten, false, nil, 0, nil, 0)
+	})
+	runtime.KeepAlive(buffer)
+
+	return bytesWritten, result
+}
+
+func (steamVoice) DecompressVoice(compressed []byte, buffer []uint16, sampleRate uint32) (uint32, internal.EVoiceResult) {
+	if len(compressed) == 0 || len(buffer) == 0 {
+		return 0, internal.EVoiceResult_NoData
+	}
+
+	var bytesWritten uint32
+	result := internal.SteamAPI_ISteamUser_DecompressVoice(unsafe.Pointer(&compressed[0]), uint32(len(compressed)), unsafe.Pointer(&buffer[0]), uint32(len(buffer))*2, &bytesWritten, sampleRate)
+	runtime.KeepAlive(compressed)
+	runtime.KeepAlive(buffer)
+
+	return bytesWritten / 2, result
+}
+
+func (steamVoice) GetVoiceOptimalSampleRate() uint32 {
+	return internal.SteamAPI_ISteamUser_GetVoiceOptimalSampleRate()
+}
+
+// steamParentalSettings calls ISteamParentalSettings through api, which is
+// steamapi.SteamParentalSettings() outside of tests.
+type steamParentalSettings struct {
+	api steamapi.ISteamParentalSettings
+}
+
+func (s steamParentalSettings) IsParentalLockEnabled() bool {
+	return s.api.BIsParentalLockEnabled()
+}
+
+func (s steamParentalSettings) IsParentalLockLocked() bool {
+	return s.api.BIsParentalLockLocked()
+}
+
+func (s steamParentalSettings) IsAppBlocked(appID AppID) bool {
+	return s.api.BIsAppBlocked(uint32(appID))
+}
+
+func (s steamParentalSettings) IsAppInBlockList(appID AppID) bool {
+	return s.api.BIsAppInBlockList(uint32(appID))
+}
+
+func (s steamParentalSettings) IsFeatureBlocked(feature internal.EParentalFeature) bool {
+	return s.api.BIsFeatureBlocked(feature)
+}
+
+func (s steamParentalSettings) IsFeatureInBlockList(feature internal.EParentalFeature) bool {
+	return s.api.BIsFeatureInBlockList(feature)
+}
+
+func (steamParentalSettings) OnParentalSettingsChanged(f func()) Registration {
+	return internal.RegisterCallback_SteamParentalSettingsChanged(func(*internal.SteamParentalSettingsChanged, bool) {
+		f()
+	}, 0, internal.SideClient)
+}
+
+type steamUserStats struct{}
+
+func (steamUserStats) FindLeaderboard(name string) APICall {
+	return call(func() APICall {
+		cname := internal.CString(name)
+		defer internal.Free(unsafe.Pointer(cname))
+
+		return APICall(internal.SteamAPI_ISteamUserStats_FindLeaderboard(cname))
+	})
+}
+
+func (steamUserStats) OnLeaderboardFindResult(call APICall, f func(leaderboard uint64, found, ioFailure bool)) Registration {
+	return internal.RegisterCallback_LeaderboardFindResult(func(data *internal.LeaderboardFindResult, ioFailure bool) {
+		f(uint64(data.HSteamLeaderboard.Get()), data.BLeaderboardFound != 0, ioFailure)
+	}, internal.SteamAPICall(call), internal.SideClient)
+}
+
+type steamGameServer struct{}
+
+func (steamGameServer) HandleIncomingPacket(data []byte, srcIP uint32, srcPort uint16) bool {
+	return call(func() bool {
+		var ptr unsafe.Pointer
+		if len(data) != 0 {
+			ptr = unsafe.Pointer(&data[0])
+		}
+
+		ok := internal.SteamAPI_ISteamGameServer_HandleIncomingPacket(ptr, int32(len(data)), srcIP, srcPort)
+		runtime.KeepAlive(data)
+
+		return ok
+	})
+}
+
+func (steamGameServer) GetNextOutgoingPacket(buffer []byte) (int, uint32, uint16) {
+	var ip uint32
+	var port uint16
+	size := call(func() int32 {
+		var ptr unsafe.Pointer
+		if len(buffer) != 0 {
+			ptr = unsafe.Pointer(&buffer[0])
+		}
+
+		size := internal.SteamAPI_ISteamGameServer_GetNextOutgoingPacket(ptr, int32(len(buffer)), &ip, &port)
+		runtime.KeepAlive(buffer)
+
+		return size
+	})
+
+	return int(size), ip, port
+}
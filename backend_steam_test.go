@@ -0,0 +1,101 @@
+//go:build cgo && (windows || linux || darwin) && (386 || amd64)
+// +build cgo
+// +build windows linux darwin
+// +build 386 amd64
+
+package steamworks
+
+import (
+	"testing"
+
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamapi"
+)
+
+func TestSteamParentalSettings(t *testing.T) {
+	rec := new(steamapi.Recorder)
+	settings := steamParentalSettings{&steamapi.MockISteamParentalSettings{
+		Recorder: rec,
+		BIsAppBlockedFunc: func(appID uint32) bool {
+			return appID == 480
+		},
+		BIsFeatureBlockedFunc: func(feature steamapi.EParentalFeature) bool {
+			return feature == steamapi.EParentalFeature_Store
+		},
+	}}
+
+	if !settings.IsAppBlocked(480) {
+		t.Error("IsAppBlocked(480) = false, expected true")
+	}
+	if settings.IsAppBlocked(570) {
+		t.Error("IsAppBlocked(570) = true, expected false")
+	}
+	if !settings.IsFeatureBlocked(internal.EParentalFeature_Store) {
+		t.Error("IsFeatureBlocked(Store) = false, expected true")
+	}
+	if settings.IsParentalLockEnabled() {
+		t.Error("IsParentalLockEnabled() = true, expected the zero value")
+	}
+
+	expected := []string{
+		"ISteamParentalSettings.BIsAppBlocked(480)",
+		"ISteamParentalSettings.BIsAppBlocked(570)",
+		"ISteamParentalSettings.BIsFeatureBlocked(Store)",
+		"ISteamParentalSettings.BIsParentalLockEnabled()",
+	}
+	calls := rec.Calls()
+	if len(calls) != len(expected) {
+		t.Fatalf("recorded %d calls, expected %d: %v", len(calls), len(expected), calls)
+	}
+	for i, call := range calls {
+		if call.String() != expected[i] {
+			t.Errorf("call %d: got %v, expected %s", i, call, expected[i])
+		}
+	}
+}
+
+func TestSetExecutorDispatch(t *testing.T) {
+	defer setExecutor(initOptions{})
+
+	setExecutor(initOptions{})
+	if internal.Dispatch != nil {
+		t.Error("Dispatch is set without an executor")
+	}
+
+	setExecutor(initOptions{queue: true})
+	if internal.Dispatch == nil {
+		t.Error("Dispatch is not set with a dispatch queue")
+	}
+
+	setExecutor(initOptions{executor: ExecutorFunc(func(fn func()) { fn() })})
+	if internal.Dispatch == nil {
+		t.Error("Dispatch is not set with an executor")
+	}
+
+	setExecutor(initOptions{})
+	if internal.Dispatch != nil {
+		t.Error("Dispatch is still set after the executor was reset")
+	}
+}
+
+func TestTraceLockOSThreadWorker(t *testing.T) {
+	var metrics Metrics
+	reg := TraceLockOSThread(&metrics)
+	defer reg.Unregister()
+
+	internal.Call(func() {})
+	if calls := metrics.Stats()[APILockOSThread].Calls; calls != 1 {
+		t.Errorf("without worker: %s reported %d times, expected 1", APILockOSThread, calls)
+	}
+
+	internal.StartWorker(0)
+	defer internal.StopWorker()
+
+	internal.Call(func() {})
+	internal.Call(func() {})
+	internal.StopWorker()
+
+	if calls := metrics.Stats()[APILockOSThread].Calls; calls != 3 {
+		t.Errorf("with worker: %s reported %d times, expected 3", APILockOSThread, calls)
+	}
+}
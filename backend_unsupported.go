@@ -0,0 +1,229 @@
+package steamworks
+
+import "github.com/BenLubar/steamworks/internal"
+
+// unsupportedBackend is the default Backend in builds that cannot link the
+// Steamworks SDK. Initialization fails with ErrUnsupported, and everything
+// else reports that nothing is available.
+type unsupportedBackend struct{}
+
+func (unsupportedBackend) RestartAppIfNecessary(ownAppID AppID) bool { return false }
+func (unsupportedBackend) InitClient() error                         { return ErrUnsupported }
+func (unsupportedBackend) InitServer(ip uint32, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string) error {
+	return ErrUnsupported
+}
+func (unsupportedBackend) Shutdown()          {}
+func (unsupportedBackend) RunCallbacks()      {}
+func (unsupportedBackend) IsGameServer() bool { return false }
+func (unsupportedBackend) AppID() AppID       { return 0 }
+func (unsupportedBackend) SteamID() SteamID   { return SteamIDNil }
+func (unsupportedBackend) APICallFailureReason(call APICall) APICallFailure {
+	return APICallFailureSteamGone
+}
+func (b unsupportedBackend) Side(gameServer bool) Backend {
+	return b
+}
+func (unsupportedBackend) OnEvent(id CallbackID, f func(Event)) Registration {
+	return unsupportedRegistration{}
+}
+func (unsupportedBackend) Registrations() []RegistrationInfo {
+	return nil
+}
+
+func (unsupportedBackend) Auth() AuthBackend             { return unsupportedAuth{} }
+func (unsupportedBackend) Networking() NetworkingBackend { return unsupportedNetworking{} }
+func (unsupportedBackend) Utils() UtilsBackend           { return unsupportedUtils{} }
+func (unsupportedBackend) Controller() ControllerBackend { return unsupportedController{} }
+func (unsupportedBackend) Voice() VoiceBackend           { return unsupportedVoice{} }
+func (unsupportedBackend) ParentalSettings() ParentalSettingsBackend {
+	return unsupportedParentalSettings{}
+}
+func (unsupportedBackend) UserStats() UserStatsBackend   { return unsupportedUserStats{} }
+func (unsupportedBackend) GameServer() GameServerBackend { return unsupportedGameServer{} }
+
+// unsupportedRegistration is returned by the On* methods of
+// unsupportedBackend. The callbacks are never called.
+type unsupportedRegistration struct{}
+
+func (unsupportedRegistration) Unregister() {}
+
+type unsupportedAuth struct{}
+
+func (unsupportedAuth) GetAuthSessionTicket(ticket []byte) (uint32, int) { return 0, 0 }
+func (unsupportedAuth) CancelAuthTicket(handle uint32)                   {}
+func (unsupportedAuth) BeginAuthSession(ticket []byte, steamID SteamID) internal.EBeginAuthSessionResult {
+	return internal.EBeginAuthSessionResult_InvalidTicket
+}
+func (unsupportedAuth) EndAuthSession(steamID SteamID) {}
+func (unsupportedAuth) UserHasLicenseForApp(steamID SteamID, appID AppID) internal.EUserHasLicenseForAppResult {
+	return internal.EUserHasLicenseForAppResult_EUserHasLicenseResultNoAuth
+}
+func (unsupportedAuth) OnValidateAuthTicketResponse(f func(steamID, ownerID SteamID, response internal.EAuthSessionResponse)) Registration {
+	return unsupportedRegistration{}
+}
+
+type unsupportedNetworking struct{}
+
+func (unsupportedNetworking) SendP2PPacket(remote SteamID, data []byte, sendType internal.EP2PSend, channel int32) bool {
+	return false
+}
+func (unsupportedNetworking) IsP2PPacketAvailable(channel int32) (uint32, bool) { return 0, false }
+func (unsupportedNetworking) ReadP2PPacket(buffer []byte, channel int32) (uint32, SteamID, bool) {
+	return 0, SteamIDNil, false
+}
+func (unsupportedNetworking) AcceptP2PSessionWithUser(remote SteamID) bool { return false }
+func (unsupportedNetworking) CloseP2PSessionWithUser(remote SteamID) bool  { return false }
+func (unsupportedNetworking) CloseP2PChannelWithUser(remote SteamID, channel int32) bool {
+	return false
+}
+func (unsupportedNetworking) GetP2PSessionState(remote SteamID) (P2PSessionState, bool) {
+	return P2PSessionState{}, false
+}
+func (unsupportedNetworking) AllowP2PPacketRelay(allow bool) bool { return false }
+func (unsupportedNetworking) OnP2PSessionRequest(f func(remote SteamID)) Registration {
+	return unsupportedRegistration{}
+}
+func (unsupportedNetworking) OnP2PSessionConnectFail(f func(remote SteamID, sessionError internal.EP2PSessionError)) Registration {
+	return unsupportedRegistration{}
+}
+
+type unsupportedUtils struct{}
+
+func (unsupportedUtils) CurrentBatteryPower() uint8                                    { return 255 }
+func (unsupportedUtils) IPCountry() string                                             { return "" }
+func (unsupportedUtils) SecondsSinceAppActive() uint32                                 { return 0 }
+func (unsupportedUtils) SecondsSinceComputerActive() uint32                            { return 0 }
+func (unsupportedUtils) ServerRealTime() uint32                                        { return 0 }
+func (unsupportedUtils) OverlayNeedsPresent() bool                                     { return false }
+func (unsupportedUtils) IsOverlayEnabled() bool                                        { return false }
+func (unsupportedUtils) IsSteamInBigPictureMode() bool                                 { return false }
+func (unsupportedUtils) SetOverlayNotificationInset(horizontal, vertical int32)        {}
+func (unsupportedUtils) SetOverlayNotificationPosition(internal.ENotificationPosition) {}
+func (unsupportedUtils) GetEnteredGamepadTextInput(length uint32) (string, bool)       { return "", false }
+func (unsupportedUtils) IsSteamRunningInVR() bool                                      { return false }
+func (unsupportedUtils) StartVRDashboard()                                             {}
+func (unsupportedUtils) IsVRHeadsetStreamingEnabled() bool                             { return false }
+func (unsupportedUtils) SetVRHeadsetStreamingEnabled(enabled bool)                     {}
+func (unsupportedUtils) SetWarningMessageHook(debug, warning func(string))             {}
+func (unsupportedUtils) OnLowBatteryPower(f func(minutesLeft uint8)) Registration {
+	return unsupportedRegistration{}
+}
+func (unsupportedUtils) OnIPCountryChanged(f func()) Registration { return unsupportedRegistration{} }
+func (unsupportedUtils) OnSteamShutdown(f func()) Registration    { return unsupportedRegistration{} }
+func (unsupportedUtils) OnGamepadTextInputDismissed(f func(bool, uint32)) Registration {
+	return unsupportedRegistration{}
+}
+func (unsupportedUtils) ShowGamepadTextInput(inputMode internal.EGamepadTextInputMode, lineInputMode internal.EGamepadTextInputLineMode, description string, maxLength uint32, existingText string) bool {
+	return false
+}
+
+type unsupportedController struct{}
+
+func (unsupportedController) Init() bool     { return false }
+func (unsupportedController) Shutdown() bool { return false }
+func (unsupportedController) RunFrame()      {}
+func (unsupportedController) GetConnectedControllers(handles []internal.ControllerHandle) int {
+	return 0
+}
+func (unsupportedController) GetControllerForGamepadIndex(index int32) internal.ControllerHandle {
+	return 0
+}
+func (unsupportedController) GetGamepadIndexForController(controller internal.ControllerHandle) int32 {
+	return -1
+}
+func (unsupportedController) GetMotionData(controller internal.ControllerHandle) (rotQuat [4]float32, posAccel, rotVel [3]float32) {
+	return
+}
+func (unsupportedController) SetLEDColor(controller internal.ControllerHandle, r, g, b uint8, flags internal.ESteamControllerLEDFlag) {
+}
+func (unsupportedController) ShowBindingPanel(controller internal.ControllerHandle) bool {
+	return false
+}
+func (unsupportedController) GetActionSetHandle(name string) internal.ControllerActionSetHandle {
+	return 0
+}
+func (unsupportedController) ActivateActionSet(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle) {
+}
+func (unsupportedController) GetCurrentActionSet(controller internal.ControllerHandle) internal.ControllerActionSetHandle {
+	return 0
+}
+func (unsupportedController) GetDigitalActionHandle(name string) internal.ControllerDigitalActionHandle {
+	return 0
+}
+func (unsupportedController) GetDigitalActionData(controller internal.ControllerHandle, action internal.ControllerDigitalActionHandle) (state, active bool) {
+	return false, false
+}
+func (unsupportedController) GetDigitalActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerDigitalActionHandle, origins []internal.EControllerActionOrigin) int {
+	return 0
+}
+func (unsupportedController) GetAnalogActionHandle(name string) internal.ControllerAnalogActionHandle {
+	return 0
+}
+func (unsupportedController) GetAnalogActionData(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) (x, y float32, mode internal.EControllerSourceMode, active bool) {
+	return
+}
+func (unsupportedController) GetAnalogActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerAnalogActionHandle, origins []internal.EControllerActionOrigin) int {
+	return 0
+}
+func (unsupportedController) StopAnalogActionMomentum(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) {
+}
+func (unsupportedController) TriggerHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec uint16) {
+}
+func (unsupportedController) TriggerRepeatedHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec, offMicroSec, repeat uint16) {
+}
+func (unsupportedController) TriggerVibration(controller internal.ControllerHandle, leftSpeed, rightSpeed uint16) {
+}
+func (unsupportedController) GetGlyphForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return ""
+}
+func (unsupportedController) GetStringForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return ""
+}
+
+type unsupportedVoice struct{}
+
+func (unsupportedVoice) StartVoiceRecording()                 {}
+func (unsupportedVoice) StopVoiceRecording()                  {}
+func (unsupportedVoice) SetInGameVoiceSpeaking(speaking bool) {}
+func (unsupportedVoice) GetAvailableVoice() (uint32, internal.EVoiceResult) {
+	return 0, internal.EVoiceResult_NotInitialized
+}
+func (unsupportedVoice) GetVoice(buffer []byte) (uint32, internal.EVoiceResult) {
+	return 0, internal.EVoiceResult_NotInitialized
+}
+func (unsupportedVoice) DecompressVoice(compressed []byte, buffer []uint16, sampleRate uint32) (uint32, internal.EVoiceResult) {
+	return 0, internal.EVoiceResult_NotInitialized
+}
+func (unsupportedVoice) GetVoiceOptimalSampleRate() uint32 { return 0 }
+
+type unsupportedParentalSettings struct{}
+
+func (unsupportedParentalSettings) IsParentalLockEnabled() bool       { return false }
+func (unsupportedParentalSettings) IsParentalLockLocked() bool        { return false }
+func (unsupportedParentalSettings) IsAppBlocked(appID AppID) bool     { return false }
+func (unsupportedParentalSettings) IsAppInBlockList(appID AppID) bool { return false }
+func (unsupportedParentalSettings) IsFeatureBlocked(feature internal.EParentalFeature) bool {
+	return false
+}
+func (unsupportedParentalSettings) IsFeatureInBlockList(feature internal.EParentalFeature) bool {
+	return false
+}
+func (unsupportedParentalSettings) OnParentalSettingsChanged(f func()) Registration {
+	return unsupportedRegistration{}
+}
+
+type unsupportedUserStats struct{}
+
+func (unsupportedUserStats) FindLeaderboard(name string) APICall { return 0 }
+func (unsupportedUserStats) OnLeaderboardFindResult(call APICall, f func(uint64, bool, bool)) Registration {
+	return unsupportedRegistration{}
+}
+
+type unsupportedGameServer struct{}
+
+func (unsupportedGameServer) HandleIncomingPacket(data []byte, srcIP uint32, srcPort uint16) bool {
+	return false
+}
+func (unsupportedGameServer) GetNextOutgoingPacket(buffer []byte) (int, uint32, uint16) {
+	return 0, 0, 0
+}
@@ -8,25 +8,21 @@
 package steamworks
 
 import (
-	"encoding/binary"
 	"errors"
 	"net"
 	"runtime"
 	"time"
-	"unsafe"
 
 	"github.com/BenLubar/steamworks/internal"
 )
 
 // Registration is an opaque type that represents a registered callback.
 type Registration interface {
-	// Unregister unregisters the callback. It is unsafe to call Unregister
-	// multiple times on the same Registration.
+	// Unregister unregisters the callback. Calling Unregister more than
+	// once on the same Registration has no effect.
 	Unregister()
 }
 
-var callbackShutdown chan<- chan<- struct{}
-
 // RestartAppIfNecessary checks if your executable was launched through Steam
 // and relaunches it through Steam if it wasn't started by Steam originally.
 //
@@ -44,6 +40,8 @@ var callbackShutdown chan<- chan<- struct{}
 // present then this will return false regardless. This allows you to develop
 // and test without launching your game through the Steam client. Make sure to
 // remove the steam_appid.txt file when uploading the game to your Steam depot!
+// DevMode can create and remove the file for you, and refuses to run in
+// release builds.
 //
 // Example:
 //
@@ -62,16 +60,16 @@ var callbackShutdown chan<- chan<- struct{}
 //        mainGameLoop()
 //    }
 func RestartAppIfNecessary(ownAppID AppID) bool {
-	defer internal.Cleanup()()
-
-	return internal.SteamAPI_RestartAppIfNecessary(uint32(ownAppID))
+	return GetBackend().RestartAppIfNecessary(ownAppID)
 }
 
 // Errors that can be returned by InitClient or InitServer.
+//
+// If the Steam client is not running, the error is an *InitError that wraps
+// ErrSteamNotRunning, so use errors.Is to check for it.
 var (
 	ErrSteamNotRunning = errors.New("steamworks: the Steam client is not running")
 	ErrIPv4Only        = errors.New("steamworks: only IPv4 addresses are supported")
-	errSteamInitFailed = errors.New("steamworks: failed to initialize") // TODO: be more specific
 )
 
 // InitClient initializes the Steamworks API for game clients.
@@ -82,6 +80,9 @@ var (
 // If startCallbackGoroutine is true, RunCallbacks will automatically be called
 // in a loop. Set startCallbackGoroutine to false if you plan to call
 // RunCallbacks manually, e.g. if your callback code is not thread-safe.
+// Alternatively, the WithDispatchQueue and WithExecutor options control where
+// callback handlers run, and WithPollInterval controls how often the callback
+// goroutine polls Steam.
 //
 // Returns nil if all required interfaces have been acquired and are accessible.
 //
@@ -102,23 +103,25 @@ var (
 //      Steam account. Your game must show up in your Steam library.
 //    - Your App ID is not completely set up, i.e. in Release State:
 //      Unavailable, or it's missing default packages.
-func InitClient(startCallbackGoroutine bool) error {
-	if !internal.SteamAPI_Init() {
-		if !internal.SteamAPI_IsSteamRunning() {
-			return ErrSteamNotRunning
-		}
-		return errSteamInitFailed
-	}
-
-	stopCallbackGoroutine()
-
-	if startCallbackGoroutine {
-		ch := make(chan chan<- struct{}, 1)
-		callbackShutdown = ch
-		go runCallbacksForever(ch)
-	}
-
-	return nil
+//    - The Steamworks SDK is not available in this build, in which case the
+//      error is ErrUnsupported.
+//
+// Except for ErrUnsupported, the error is an *InitError, which has the most
+// likely of these causes and a suggestion for fixing it:
+//
+//    if err := steamworks.InitClient(true); err != nil {
+//        var initErr *steamworks.InitError
+//        if errors.As(err, &initErr) {
+//            log.Println(initErr.Remediation())
+//        }
+//        handleFatalError(err)
+//    }
+//
+// InitClient is equivalent to NewClient, except that the Client is only
+// available through the package-level functions.
+func InitClient(startCallbackGoroutine bool, options ...InitOption) error {
+	_, err := NewClient(startCallbackGoroutine, options...)
+	return err
 }
 
 // ServerMode is the authentication mode for a Steam game server.
@@ -150,73 +153,96 @@ const UseGameSocketShare = ^uint16(0)
 // If you pass in UseGameSocketShare into queryPort, then the game server will
 // use GameSocketShare mode, which means that the game is responsible for
 // sending and receiving UDP packets for the master server updater.
-func InitServer(ip net.IP, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string, startCallbackGoroutine bool) error {
-	cversion := internal.CString(version)
-	defer internal.Free(unsafe.Pointer(cversion))
-
-	var ipInt uint32
-	if ip4 := ip.To4(); ip4 != nil {
-		ipInt = binary.BigEndian.Uint32(ip4)
-	} else if len(ip) != 0 {
-		return ErrIPv4Only
-	}
-
-	if !internal.SteamGameServer_Init(ipInt, steamPort, gamePort, queryPort, serverMode, cversion) {
-		return errSteamInitFailed
-	}
-
-	stopCallbackGoroutine()
-
-	if startCallbackGoroutine {
-		ch := make(chan chan<- struct{}, 1)
-		callbackShutdown = ch
-		go runCallbacksForever(ch)
-	}
-
-	return nil
+// steamgameserver.ShareSocket does this for the game port's net.PacketConn.
+//
+// The startCallbackGoroutine and options parameters have the same meaning as
+// for InitClient.
+//
+// If the Steamworks SDK is not available in this build, InitServer returns
+// ErrUnsupported. Otherwise, if initialization fails, the error is an
+// *InitError. If the steam or query port could not be bound, its Cause is
+// InitCausePortInUse and its Port and PortName say which one.
+//
+// InitServer is equivalent to NewServer, except that the Server is only
+// available through the package-level functions.
+func InitServer(ip net.IP, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string, startCallbackGoroutine bool, options ...InitOption) error {
+	_, err := NewServer(ip, steamPort, gamePort, queryPort, serverMode, version, startCallbackGoroutine, options...)
+	return err
 }
 
 // Shutdown shuts down the Steamworks API, releases pointers and frees memory.
+// If both a game server and a game client are running, both are shut down.
 //
 // You should call this during process shutdown if possible.
 //
 // This will not unhook the Steam Overlay from your game as there's no
 // guarantee that your rendering API is done using it.
 func Shutdown() {
-	stopCallbackGoroutine()
+	handlesLock.Lock()
+	client, server := currentClient, currentServer
+	handlesLock.Unlock()
 
-	internal.ResetOnce()
+	if server != nil {
+		server.Shutdown()
+	}
+	if client != nil {
+		client.Shutdown()
+	}
 
-	internal.SteamAPI_Shutdown()
+	if client == nil && server == nil {
+		// The Backend may have been initialized without NewClient or
+		// NewServer, so shut it down anyway.
+		shutdownGlobal()
+		GetBackend().Shutdown()
+		setExecutor(initOptions{})
+		stopWorker()
+	}
 }
 
-func stopCallbackGoroutine() {
-	if callbackShutdown == nil {
+func stopCallbackGoroutine(quit chan<- chan<- struct{}) {
+	if quit == nil {
 		return
 	}
 
 	ch := make(chan struct{}, 1)
-	callbackShutdown <- ch
+	quit <- ch
 	<-ch
-
-	callbackShutdown = nil
 }
 
-func runCallbacksForever(quit <-chan chan<- struct{}) {
+func runCallbacksForever(b Backend, quit <-chan chan<- struct{}, minInterval, maxInterval time.Duration) {
 	runtime.LockOSThread()
 	defer runtime.UnlockOSThread()
 
-	ticker := time.NewTicker(time.Millisecond)
-	defer ticker.Stop()
+	interval := minInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
 
 	for {
-		internal.SteamAPI_RunCallbacks()
+		before := dispatched()
+		runCallbacks(b)
+
+		if dispatched() != before {
+			interval = minInterval
+		} else if interval < maxInterval {
+			interval *= 2
+			if interval > maxInterval {
+				interval = maxInterval
+			}
+		}
+
+		if !timer.Stop() {
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+		timer.Reset(interval)
 
 		select {
 		case ch := <-quit:
 			ch <- struct{}{}
 			return
-		case <-ticker.C:
+		case <-timer.C:
 		}
 	}
 }
@@ -235,6 +261,13 @@ func runCallbacksForever(quit <-chan chan<- struct{}) {
 //
 // Calling this function is required if and only if InitClient or InitServer
 // was called with startCallbackGoroutine set to false.
+//
+// If InitClient or InitServer was called with WithExecutor or
+// WithDispatchQueue, RunCallbacks only polls Steam, and the handlers are run
+// by the Executor or by DispatchPending.
+//
+// After the callbacks, RunCallbacks calls the functions registered with
+// OnRunCallbacks.
 func RunCallbacks() {
-	internal.SteamAPI_RunCallbacks()
+	runCallbacks(GetBackend())
 }
@@ -0,0 +1,207 @@
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// APICall is a handle to an asynchronous Steam API call.
+//
+// Steam API functions that return a SteamAPICall_t deliver their results
+// later, during RunCallbacks. Use NewCallResult to wait for the result.
+type APICall uint64
+
+// APICallFailure is the reason an asynchronous Steam API call failed.
+type APICallFailure = internal.ESteamAPICallFailure
+
+// Constants for APICallFailure
+const (
+	APICallFailureNone               APICallFailure = internal.ESteamAPICallFailure_None
+	APICallFailureSteamGone          APICallFailure = internal.ESteamAPICallFailure_SteamGone
+	APICallFailureNetworkFailure     APICallFailure = internal.ESteamAPICallFailure_NetworkFailure
+	APICallFailureInvalidHandle      APICallFailure = internal.ESteamAPICallFailure_InvalidHandle
+	APICallFailureMismatchedCallback APICallFailure = internal.ESteamAPICallFailure_MismatchedCallback
+)
+
+// CallResultError is returned by CallResult.Wait if Steam reports an I/O
+// failure for the API call.
+type CallResultError struct {
+	Call   APICall
+	Reason APICallFailure
+}
+
+func (err *CallResultError) Error() string {
+	var reason string
+	switch err.Reason {
+	case APICallFailureSteamGone:
+		reason = "the local Steam process has gone away"
+	case APICallFailureNetworkFailure:
+		reason = "the network connection to the Steam servers has been lost"
+	case APICallFailureInvalidHandle:
+		reason = "the API call handle is invalid"
+	case APICallFailureMismatchedCallback:
+		reason = "the API call result was requested with the wrong callback type"
+	default:
+		reason = "unknown failure (" + err.Reason.String() + ")"
+	}
+
+	return "steamworks: API call " + strconv.FormatUint(uint64(err.Call), 10) + " failed: " + reason
+}
+
+// Temporary returns true iff the call might succeed if it is made again.
+func (err *CallResultError) Temporary() bool {
+	return err.Reason == APICallFailureNetworkFailure
+}
+
+// ErrCallCanceled is returned by CallResult.Wait if the CallResult was
+// canceled before Steam delivered the result.
+var ErrCallCanceled = errors.New("steamworks: API call result was canceled")
+
+// CallResult is the pending result of an asynchronous Steam API call.
+//
+// All methods on CallResult are safe to call concurrently.
+type CallResult[T any] struct {
+	call APICall
+	done chan struct{}
+
+	lock     sync.Mutex
+	finished bool
+	reg      Registration
+	result   T
+	err      error
+}
+
+// NewCallResult waits for the result of an asynchronous Steam API call.
+//
+// This is intended for use by packages that wrap Steam API functions. The
+// register function is called once, and it must register a call result for
+// call that calls complete with the converted result. The call result
+// registrations made by Backend methods remove themselves once the result is
+// delivered; CallResult only calls Unregister if it is canceled first.
+//
+// If call is zero, which Steam API functions return if the call could not be
+// made, the returned CallResult has already failed with a CallResultError.
+//
+// Example, from steamuserstats:
+//
+//    func FindLeaderboard(name string) *steamworks.CallResult[Leaderboard] {
+//        userStats := steamworks.GetBackend().UserStats()
+//        call := userStats.FindLeaderboard(name)
+//
+//        return steamworks.NewCallResult(call, func(call steamworks.APICall, complete func(Leaderboard, bool)) steamworks.Registration {
+//            return userStats.OnLeaderboardFindResult(call, func(leaderboard uint64, found, ioFailure bool) {
+//                complete(Leaderboard(leaderboard), ioFailure)
+//            })
+//        })
+//    }
+func NewCallResult[T any](call APICall, register func(call APICall, complete func(result T, ioFailure bool)) Registration) *CallResult[T] {
+	r := &CallResult[T]{
+		call: call,
+		done: make(chan struct{}),
+	}
+
+	if call == 0 {
+		r.finished = true
+		r.err = &CallResultError{Call: call, Reason: APICallFailureInvalidHandle}
+		close(r.done)
+		return r
+	}
+
+	reg := register(call, r.complete)
+
+	r.lock.Lock()
+	if !r.finished {
+		// If the result was already delivered, the registration has
+		// already been removed.
+		r.reg = reg
+	}
+	r.lock.Unlock()
+
+	return r
+}
+
+func (r *CallResult[T]) complete(result T, ioFailure bool) {
+	var err error
+	if ioFailure {
+		var zero T
+		result = zero
+		err = &CallResultError{
+			Call:   r.call,
+			Reason: GetBackend().APICallFailureReason(r.call),
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.finished {
+		return
+	}
+
+	r.finished = true
+	r.reg = nil
+	r.result = result
+	r.err = err
+	close(r.done)
+}
+
+// Call returns the handle of the API call this CallResult is waiting for.
+func (r *CallResult[T]) Call() APICall {
+	// immutable; no need to lock
+	return r.call
+}
+
+// Done returns a channel that is closed when the result is available or the
+// CallResult is canceled.
+func (r *CallResult[T]) Done() <-chan struct{} {
+	// immutable; no need to lock
+	return r.done
+}
+
+// Wait blocks until the result is available, the CallResult is canceled, or
+// ctx is done, whichever happens first.
+//
+// If Steam reports a failure, the error is a *CallResultError. If the
+// CallResult was canceled, the error is ErrCallCanceled. If ctx is done
+// first, the error is ctx.Err() and the CallResult is still pending; call
+// Cancel if the result is no longer needed.
+func (r *CallResult[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-r.done:
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		return r.result, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// Cancel stops waiting for the result and unregisters the underlying call
+// result. Any current or future calls to Wait return ErrCallCanceled.
+//
+// Cancel does nothing if the result has already been delivered. It is safe
+// to call Cancel multiple times.
+func (r *CallResult[T]) Cancel() {
+	r.lock.Lock()
+	if r.finished {
+		r.lock.Unlock()
+		return
+	}
+
+	reg := r.reg
+	r.finished = true
+	r.reg = nil
+	r.err = ErrCallCanceled
+	close(r.done)
+	r.lock.Unlock()
+
+	if reg != nil {
+		reg.Unregister()
+	}
+}
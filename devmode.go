@@ -0,0 +1,249 @@
+package steamworks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrReleaseBuild is returned by DevMode in builds with the steamworks_release
+// build tag.
+var ErrReleaseBuild = errors.New("steamworks: DevMode cannot be used in release builds")
+
+// appIDFileName is the file Steam reads the app ID from when the program is
+// not launched by the Steam client.
+const appIDFileName = "steam_appid.txt"
+
+// LaunchOption is a launch option configured for the app on the Steamworks
+// partner site.
+type LaunchOption struct {
+	// Name identifies the launch option, such as "Play" or "Dedicated
+	// server". It is not used by DetectLaunch.
+	Name string
+	// Args are the arguments of the launch option.
+	Args []string
+}
+
+// Launch describes how the process was started.
+type Launch struct {
+	// BySteam is true if the Steam client started the process, either
+	// directly or because RestartAppIfNecessary returned true.
+	BySteam bool
+	// AppID is the app ID the Steam client set in the environment, if
+	// BySteam is true.
+	AppID AppID
+	// Option is the launch option that matches the command line, or nil if
+	// none of them match.
+	Option *LaunchOption
+}
+
+// launchEnv is the environment the process started with, before DevMode
+// changes it.
+var launchEnv = struct {
+	clientLaunch, appID, gameID string
+}{
+	clientLaunch: os.Getenv("SteamClientLaunch"),
+	appID:        os.Getenv("SteamAppId"),
+	gameID:       os.Getenv("SteamGameId"),
+}
+
+// DetectLaunch reports whether the Steam client started the process and
+// which of launchOptions it used. A launch option matches if its arguments
+// appear in order in the command line. If several match, the one with the
+// most arguments is used, so a launch option with no arguments only matches
+// if no other does.
+//
+// DetectLaunch looks at the environment the process started with, so it is
+// not affected by DevMode.
+func DetectLaunch(launchOptions ...LaunchOption) Launch {
+	var launch Launch
+
+	if id, err := strconv.ParseUint(launchEnv.appID, 10, 32); err == nil && id != 0 {
+		launch.AppID = AppID(id)
+		launch.BySteam = launchEnv.clientLaunch == "1" || launchEnv.gameID != ""
+	}
+
+	for i := range launchOptions {
+		option := &launchOptions[i]
+		if !containsArgs(os.Args[1:], option.Args) {
+			continue
+		}
+		if launch.Option == nil || len(option.Args) > len(launch.Option.Args) {
+			launch.Option = option
+		}
+	}
+
+	return launch
+}
+
+func containsArgs(args, sub []string) bool {
+	if len(sub) == 0 {
+		return true
+	}
+
+	for i := 0; i+len(sub) <= len(args); i++ {
+		match := true
+		for j := range sub {
+			if args[i+j] != sub[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DevSession undoes the changes made by DevMode.
+type DevSession struct {
+	// Launch is how the process was started.
+	Launch Launch
+
+	created []string
+	env     map[string]*string
+}
+
+// DevMode prepares to run the program outside of the Steam client during
+// development, so that InitClient and RestartAppIfNecessary behave as if the
+// program was launched by Steam for appID. It should be called before either
+// of them.
+//
+// DevMode writes a steam_appid.txt containing appID in the working directory,
+// where Steam looks for it, and next to the executable if that is a
+// different directory. A steam_appid.txt that already contains appID is left
+// alone. A steam_appid.txt with any other contents is an error, so that the
+// wrong app is not used by mistake. DevMode also sets the SteamAppId and
+// SteamGameId environment variables.
+//
+// If the Steam client started the process, DevMode changes nothing. In
+// builds with the steamworks_release build tag, DevMode does nothing and
+// returns ErrReleaseBuild, so the call can be left in place without
+// steam_appid.txt ending up in a depot.
+//
+// Close the returned DevSession before exiting to remove the files that were
+// created and restore the environment:
+//
+//    dev, err := steamworks.DevMode(480)
+//    if err != nil && !errors.Is(err, steamworks.ErrReleaseBuild) {
+//        log.Fatal(err)
+//    }
+//    defer dev.Close()
+//
+//    if steamworks.RestartAppIfNecessary(480) {
+//        return
+//    }
+func DevMode(appID AppID, launchOptions ...LaunchOption) (*DevSession, error) {
+	if releaseBuild {
+		return nil, ErrReleaseBuild
+	}
+
+	s := &DevSession{
+		Launch: DetectLaunch(launchOptions...),
+		env:    make(map[string]*string),
+	}
+	if s.Launch.BySteam {
+		return s, nil
+	}
+
+	dirs := []string{workingDirectory()}
+	if exe, err := os.Executable(); err == nil {
+		if dir := filepath.Dir(exe); !sameDir(dir, dirs[0]) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	for _, dir := range dirs {
+		if err := s.writeAppIDFile(filepath.Join(dir, appIDFileName), appID); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+
+	id := strconv.FormatUint(uint64(appID), 10)
+	for _, name := range []string{"SteamAppId", "SteamGameId"} {
+		if old, ok := os.LookupEnv(name); ok {
+			s.env[name] = &old
+		} else {
+			s.env[name] = nil
+		}
+		if err := os.Setenv(name, id); err != nil {
+			_ = s.Close()
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (s *DevSession) writeAppIDFile(path string, appID AppID) error {
+	b, err := os.ReadFile(path)
+	if err == nil {
+		id, parseErr := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 32)
+		if parseErr != nil || id == 0 {
+			return &InitError{Cause: InitCauseAppIDMalformed, Path: path}
+		}
+		if AppID(id) != appID {
+			return errors.New("steamworks: " + path + " contains app ID " + strconv.FormatUint(id, 10) + ", not " + strconv.FormatUint(uint64(appID), 10))
+		}
+		return nil
+	}
+	if !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.WriteFile(path, []byte(strconv.FormatUint(uint64(appID), 10)), 0644); err != nil {
+		return err
+	}
+	s.created = append(s.created, path)
+
+	return nil
+}
+
+// Close removes the steam_appid.txt files created by DevMode and restores the
+// environment variables it set. Calling Close on a nil *DevSession, or more
+// than once, does nothing.
+func (s *DevSession) Close() error {
+	if s == nil {
+		return nil
+	}
+
+	var firstErr error
+	for _, path := range s.created {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) && firstErr == nil {
+			firstErr = err
+		}
+	}
+	s.created = nil
+
+	for name, old := range s.env {
+		var err error
+		if old != nil {
+			err = os.Setenv(name, *old)
+		} else {
+			err = os.Unsetenv(name)
+		}
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	s.env = nil
+
+	return firstErr
+}
+
+func sameDir(a, b string) bool {
+	ai, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	bi, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(ai, bi)
+}
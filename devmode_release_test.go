@@ -0,0 +1,26 @@
+//go:build steamworks_release
+// +build steamworks_release
+
+package steamworks
+
+import (
+	"os"
+	"testing"
+)
+
+func TestDevModeReleaseBuild(t *testing.T) {
+	chdir(t, t.TempDir())
+
+	dev, err := DevMode(480)
+	if dev != nil || err != ErrReleaseBuild {
+		t.Errorf("got (%v, %v), expected ErrReleaseBuild", dev, err)
+	}
+	if _, err := os.Stat(appIDFileName); !os.IsNotExist(err) {
+		t.Errorf("%s was created: %v", appIDFileName, err)
+	}
+
+	// The result can be closed without checking it.
+	if err := dev.Close(); err != nil {
+		t.Errorf("Close: %v", err)
+	}
+}
@@ -0,0 +1,250 @@
+//go:build !steamworks_release
+// +build !steamworks_release
+
+package steamworks
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// setLaunchEnv replaces the environment DetectLaunch sees until the test
+// ends.
+func setLaunchEnv(t *testing.T, clientLaunch, appID, gameID string) {
+	t.Helper()
+
+	old := launchEnv
+	launchEnv.clientLaunch, launchEnv.appID, launchEnv.gameID = clientLaunch, appID, gameID
+	t.Cleanup(func() { launchEnv = old })
+}
+
+// unsetenv unsets an environment variable until the test ends.
+func unsetenv(t *testing.T, name string) {
+	t.Helper()
+
+	t.Setenv(name, "")
+	if err := os.Unsetenv(name); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// exeAppIDFile returns the steam_appid.txt next to the test executable, or
+// skips the test if it already exists.
+func exeAppIDFile(t *testing.T) string {
+	t.Helper()
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skip(err)
+	}
+	path := filepath.Join(filepath.Dir(exe), appIDFileName)
+	if _, err := os.Stat(path); err == nil {
+		t.Skip(path + " already exists")
+	}
+	return path
+}
+
+func TestDevMode(t *testing.T) {
+	chdir(t, t.TempDir())
+	setLaunchEnv(t, "", "", "")
+	exeFile := exeAppIDFile(t)
+	t.Setenv("SteamAppId", "570")
+	unsetenv(t, "SteamGameId")
+
+	dev, err := DevMode(480)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{appIDFileName, exeFile} {
+		if b, err := os.ReadFile(path); err != nil || string(b) != "480" {
+			t.Errorf("%s: got (%q, %v), expected \"480\"", path, b, err)
+		}
+	}
+	for _, name := range []string{"SteamAppId", "SteamGameId"} {
+		if value := os.Getenv(name); value != "480" {
+			t.Errorf("%s = %q, expected \"480\"", name, value)
+		}
+	}
+
+	if err := dev.Close(); err != nil {
+		t.Error(err)
+	}
+
+	for _, path := range []string{appIDFileName, exeFile} {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s was not removed: %v", path, err)
+		}
+	}
+	if value := os.Getenv("SteamAppId"); value != "570" {
+		t.Errorf("SteamAppId = %q after Close, expected \"570\"", value)
+	}
+	if value, ok := os.LookupEnv("SteamGameId"); ok {
+		t.Errorf("SteamGameId = %q after Close, expected it to be unset", value)
+	}
+
+	if err := dev.Close(); err != nil {
+		t.Errorf("second Close: %v", err)
+	}
+}
+
+func TestDevModeExistingFile(t *testing.T) {
+	chdir(t, t.TempDir())
+	setLaunchEnv(t, "", "", "")
+	exeAppIDFile(t)
+	unsetenv(t, "SteamAppId")
+	unsetenv(t, "SteamGameId")
+
+	if err := os.WriteFile(appIDFileName, []byte("480\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	dev, err := DevMode(480)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dev.Close(); err != nil {
+		t.Error(err)
+	}
+
+	if b, err := os.ReadFile(appIDFileName); err != nil || string(b) != "480\n" {
+		t.Errorf("existing file: got (%q, %v) after Close, expected it to be unchanged", b, err)
+	}
+}
+
+func TestDevModeWrongFile(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		file  string
+		cause InitCause // or 0 for a mismatched app ID
+	}{
+		{"mismatched", "570", 0},
+		{"malformed", "app 480", InitCauseAppIDMalformed},
+		{"zero", "0", InitCauseAppIDMalformed},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			chdir(t, t.TempDir())
+			setLaunchEnv(t, "", "", "")
+			exeFile := exeAppIDFile(t)
+			unsetenv(t, "SteamAppId")
+			unsetenv(t, "SteamGameId")
+
+			if err := os.WriteFile(appIDFileName, []byte(tt.file), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			dev, err := DevMode(480)
+			if err == nil {
+				_ = dev.Close()
+				t.Fatal("DevMode succeeded, expected an error")
+			}
+			var initErr *InitError
+			if tt.cause == 0 && errors.As(err, &initErr) {
+				t.Errorf("got %v, expected a mismatched app ID", err)
+			} else if tt.cause != 0 && (!errors.As(err, &initErr) || initErr.Cause != tt.cause) {
+				t.Errorf("got %v, expected %v", err, tt.cause)
+			}
+
+			if b, err := os.ReadFile(appIDFileName); err != nil || string(b) != tt.file {
+				t.Errorf("got (%q, %v), expected the file to be unchanged", b, err)
+			}
+			if _, err := os.Stat(exeFile); !os.IsNotExist(err) {
+				t.Errorf("%s was created: %v", exeFile, err)
+			}
+			for _, name := range []string{"SteamAppId", "SteamGameId"} {
+				if value, ok := os.LookupEnv(name); ok {
+					t.Errorf("%s = %q, expected it to be unset", name, value)
+				}
+			}
+		})
+	}
+}
+
+func TestDevModeLaunchedBySteam(t *testing.T) {
+	chdir(t, t.TempDir())
+	setLaunchEnv(t, "1", "480", "")
+	unsetenv(t, "SteamGameId")
+
+	dev, err := DevMode(480)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dev.Close()
+
+	if !dev.Launch.BySteam || dev.Launch.AppID != 480 {
+		t.Errorf("Launch = %+v, expected BySteam for app 480", dev.Launch)
+	}
+	if _, err := os.Stat(appIDFileName); !os.IsNotExist(err) {
+		t.Errorf("%s was created: %v", appIDFileName, err)
+	}
+	if value, ok := os.LookupEnv("SteamGameId"); ok {
+		t.Errorf("SteamGameId = %q, expected it to be unset", value)
+	}
+}
+
+func TestDetectLaunch(t *testing.T) {
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+
+	options := []LaunchOption{
+		{Name: "Play"},
+		{Name: "Dedicated server", Args: []string{"-dedicated"}},
+		{Name: "LAN server", Args: []string{"-dedicated", "-lan"}},
+	}
+
+	for _, tt := range []struct {
+		args                        []string
+		clientLaunch, appID, gameID string
+		bySteam                     bool
+		launchAppID                 AppID
+		option                      string
+	}{
+		{args: nil, option: "Play"},
+		{args: []string{"-dedicated"}, option: "Dedicated server"},
+		{args: []string{"-port", "27015", "-dedicated", "-lan"}, option: "LAN server"},
+		{args: []string{"-lan", "-dedicated"}, option: "Dedicated server"},
+		{args: nil, clientLaunch: "1", appID: "480", bySteam: true, launchAppID: 480, option: "Play"},
+		{args: nil, appID: "480", gameID: "480", bySteam: true, launchAppID: 480, option: "Play"},
+		{args: nil, appID: "480", launchAppID: 480, option: "Play"},
+		{args: nil, clientLaunch: "1", appID: "0", option: "Play"},
+		{args: nil, clientLaunch: "1", appID: "dota", option: "Play"},
+	} {
+		setLaunchEnv(t, tt.clientLaunch, tt.appID, tt.gameID)
+		os.Args = append([]string{"game"}, tt.args...)
+
+		launch := DetectLaunch(options...)
+		if launch.BySteam != tt.bySteam || launch.AppID != tt.launchAppID {
+			t.Errorf("%q with %q/%q/%q: got BySteam %v, AppID %d, expected %v, %d", tt.args, tt.clientLaunch, tt.appID, tt.gameID, launch.BySteam, launch.AppID, tt.bySteam, tt.launchAppID)
+		}
+		if launch.Option == nil || launch.Option.Name != tt.option {
+			t.Errorf("%q: got option %+v, expected %q", tt.args, launch.Option, tt.option)
+		}
+	}
+
+	os.Args = []string{"game"}
+	if launch := DetectLaunch(options[1:]...); launch.Option != nil {
+		t.Errorf("no matching option: got %+v, expected nil", launch.Option)
+	}
+}
+
+func TestContainsArgs(t *testing.T) {
+	for _, tt := range []struct {
+		args, sub []string
+		contains  bool
+	}{
+		{nil, nil, true},
+		{[]string{"-a"}, nil, true},
+		{nil, []string{"-a"}, false},
+		{[]string{"-a", "-b", "-c"}, []string{"-b", "-c"}, true},
+		{[]string{"-a", "-b", "-c"}, []string{"-a", "-c"}, false},
+		{[]string{"-a", "-b"}, []string{"-b", "-a"}, false},
+		{[]string{"-a"}, []string{"-a", "-b"}, false},
+		{[]string{"-a", "-a", "-b"}, []string{"-a", "-b"}, true},
+	} {
+		if contains := containsArgs(tt.args, tt.sub); contains != tt.contains {
+			t.Errorf("containsArgs(%q, %q) = %v, expected %v", tt.args, tt.sub, contains, tt.contains)
+		}
+	}
+}
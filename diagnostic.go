@@ -0,0 +1,99 @@
+package steamworks
+
+import (
+	"os"
+	"sync"
+)
+
+// Diagnostic describes a mistake in the use of this module that is detected
+// at run time but cannot be returned as an error, such as an auth session
+// that was garbage collected without being closed.
+type Diagnostic struct {
+	// Package is the package that detected the mistake, such as
+	// "steamworks/steamauth".
+	Package string
+	// Message describes the mistake.
+	Message string
+}
+
+// OnDiagnostic is called with each Diagnostic that is not sent to a handler
+// registered with HandleDiagnostics. The default writes it to os.Stderr.
+// OnDiagnostic may be called from any goroutine, including the garbage
+// collector's finalizer goroutine. Assigning to it is not synchronized, so
+// it must be set before InitClient or InitServer is called; use
+// HandleDiagnostics to change the handler later.
+var OnDiagnostic = func(d Diagnostic) {
+	// Don't handle an error writing to Stderr because there's nothing we
+	// can do about it.
+
+	// nolint: gosec
+	_, _ = os.Stderr.WriteString("[DEVELOPER ERROR] " + d.Package + ": " + d.Message + "\n")
+}
+
+var diagnosticHandlers handlerStack[Diagnostic]
+
+// HandleDiagnostics sends each Diagnostic to f instead of OnDiagnostic until
+// the returned Registration is unregistered. If more than one handler is
+// registered, the most recent one receives the diagnostics. It is safe to
+// call HandleDiagnostics at any time.
+func HandleDiagnostics(f func(Diagnostic)) Registration {
+	return diagnosticHandlers.push(f)
+}
+
+// ReportDiagnostic passes d to the most recent handler registered with
+// HandleDiagnostics, or to OnDiagnostic if there is none. It is used by the
+// packages in this module to report mistakes.
+func ReportDiagnostic(d Diagnostic) {
+	if f := diagnosticHandlers.top(); f != nil {
+		f(d)
+		return
+	}
+
+	OnDiagnostic(d)
+}
+
+// handlerStack is a list of handlers where the most recently registered
+// handler that has not been unregistered is used.
+type handlerStack[T any] struct {
+	lock     sync.Mutex
+	handlers []*stackedHandler[T]
+}
+
+type stackedHandler[T any] struct {
+	s *handlerStack[T]
+	f func(T)
+}
+
+func (s *handlerStack[T]) push(f func(T)) Registration {
+	h := &stackedHandler[T]{s: s, f: f}
+
+	s.lock.Lock()
+	s.handlers = append(s.handlers, h)
+	s.lock.Unlock()
+
+	return h
+}
+
+// top returns the current handler, or nil if there is none.
+func (s *handlerStack[T]) top() func(T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.handlers) == 0 {
+		return nil
+	}
+
+	return s.handlers[len(s.handlers)-1].f
+}
+
+func (h *stackedHandler[T]) Unregister() {
+	h.s.lock.Lock()
+	defer h.s.lock.Unlock()
+
+	for i, other := range h.s.handlers {
+		if other == h {
+			h.s.handlers = append(h.s.handlers[:i:i], h.s.handlers[i+1:]...)
+			return
+		}
+	}
+}
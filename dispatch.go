@@ -0,0 +1,217 @@
+package steamworks
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Executor runs callback handlers on behalf of RunCallbacks.
+//
+// Execute may run fn immediately or arrange for it to be run later, but it
+// must run handlers in the order they are passed to Execute.
+type Executor interface {
+	Execute(fn func())
+}
+
+// ExecutorFunc adapts a function to the Executor interface.
+type ExecutorFunc func(fn func())
+
+// Execute implements Executor.
+func (f ExecutorFunc) Execute(fn func()) {
+	f(fn)
+}
+
+// InitOption configures InitClient and InitServer.
+type InitOption func(*initOptions)
+
+type initOptions struct {
+	executor    Executor
+	queue       bool
+	executorSet bool
+	minInterval time.Duration
+	maxInterval time.Duration
+
+	worker          bool
+	releaseInterval time.Duration
+}
+
+// WithExecutor passes callback handlers to e instead of running them during
+// RunCallbacks.
+func WithExecutor(e Executor) InitOption {
+	return func(opts *initOptions) {
+		opts.executor = e
+		opts.queue = false
+		opts.executorSet = true
+	}
+}
+
+// WithDispatchQueue holds callback handlers in a queue until DispatchPending
+// is called. This allows the callback goroutine to poll Steam while every
+// handler runs on the goroutine that calls DispatchPending, such as the main
+// thread, in the order Steam delivered the callbacks.
+func WithDispatchQueue() InitOption {
+	return func(opts *initOptions) {
+		opts.executor = nil
+		opts.queue = true
+		opts.executorSet = true
+	}
+}
+
+// WithPollInterval sets how often the callback goroutine polls Steam. After
+// a poll that runs at least one callback, the next poll happens after min.
+// Otherwise, the interval doubles, up to max.
+//
+// The default is 1 millisecond, without backoff.
+func WithPollInterval(min, max time.Duration) InitOption {
+	return func(opts *initOptions) {
+		if min <= 0 {
+			min = time.Millisecond
+		}
+		if max < min {
+			max = min
+		}
+		opts.minInterval = min
+		opts.maxInterval = max
+	}
+}
+
+// compatible returns false if opts, passed to a second Client or Server,
+// would change the process-wide options the first one was created with.
+// Options that are not passed are inherited.
+func (opts initOptions) compatible(running initOptions) bool {
+	if opts.executorSet && (opts.queue != running.queue || !sameExecutor(opts.executor, running.executor)) {
+		return false
+	}
+	if opts.worker && (!running.worker || opts.releaseInterval != running.releaseInterval) {
+		return false
+	}
+	return true
+}
+
+func sameExecutor(a, b Executor) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	// ExecutorFunc and other func types cannot be compared.
+	t := reflect.TypeOf(a)
+	return t == reflect.TypeOf(b) && t.Comparable() && a == b
+}
+
+func applyInitOptions(options []InitOption) initOptions {
+	opts := initOptions{
+		minInterval: time.Millisecond,
+		maxInterval: time.Millisecond,
+	}
+	for _, o := range options {
+		o(&opts)
+	}
+	return opts
+}
+
+var (
+	executorLock sync.Mutex
+	executor     Executor
+
+	// dispatchCount counts calls to Execute, so the callback goroutine
+	// can tell whether a poll found anything. Callbacks run without
+	// Execute are counted by callbacksRun.
+	dispatchCount uint64
+
+	queueLock sync.Mutex
+	queue     []func()
+)
+
+// setExecutor installs the executor configured by opts. Handlers queued by
+// a previous WithDispatchQueue are discarded.
+func setExecutor(opts initOptions) {
+	var e Executor
+	if opts.queue {
+		e = ExecutorFunc(enqueue)
+	} else {
+		e = opts.executor
+	}
+
+	executorLock.Lock()
+	executor = e
+	executorLock.Unlock()
+
+	setDispatch(e != nil)
+
+	queueLock.Lock()
+	queue = nil
+	queueLock.Unlock()
+}
+
+// Execute runs a callback handler using the Executor configured by InitClient
+// or InitServer, or immediately if there is none.
+//
+// Backend implementations must call Execute for each callback handler they
+// run from RunCallbacks. If fn panics, the panic is passed to OnCallbackPanic,
+// or to the handler registered with HandleCallbackPanics.
+func Execute(fn func()) {
+	atomic.AddUint64(&dispatchCount, 1)
+
+	execute(fn)
+}
+
+// execute is Execute without counting fn as a callback, for the functions
+// registered with OnRunCallbacks.
+func execute(fn func()) {
+	fn = protect(0, fn)
+
+	executorLock.Lock()
+	e := executor
+	executorLock.Unlock()
+
+	if e == nil {
+		fn()
+		return
+	}
+
+	e.Execute(fn)
+}
+
+// dispatched returns the number of callback handlers run or queued so far.
+func dispatched() uint64 {
+	return atomic.LoadUint64(&dispatchCount) + callbacksRun()
+}
+
+func enqueue(fn func()) {
+	queueLock.Lock()
+	queue = append(queue, fn)
+	queueLock.Unlock()
+}
+
+// DispatchPending runs the callback handlers queued since the last call, on
+// the calling goroutine, and returns the number of handlers that were run.
+//
+// DispatchPending only has an effect if InitClient or InitServer was called
+// with WithDispatchQueue. Handlers queued while DispatchPending is running are
+// left for the next call.
+//
+// Example:
+//
+//    if err := steamworks.InitClient(true, steamworks.WithDispatchQueue()); err != nil {
+//        handleFatalError(err)
+//    }
+//    defer steamworks.Shutdown()
+//
+//    for !quit {
+//        steamworks.DispatchPending()
+//        renderFrame()
+//    }
+func DispatchPending() int {
+	queueLock.Lock()
+	pending := queue
+	queue = nil
+	queueLock.Unlock()
+
+	for _, fn := range pending {
+		fn()
+	}
+
+	return len(pending)
+}
@@ -0,0 +1,236 @@
+package steamworks
+
+import (
+	"sync"
+	"time"
+)
+
+// CallbackID identifies a type of Steam callback. It is the k_iCallback value
+// from the Steamworks SDK.
+type CallbackID int32
+
+// Event is a Steam callback converted to a Go value.
+//
+// Each type of callback has its own struct type in this package, named after
+// the callback struct in the Steamworks SDK without the _t suffix, such as
+// P2PSessionRequest or LobbyChatUpdate. Use a type switch to tell them apart.
+//
+// The fields are converted to Go types: SteamIDs are SteamID, timestamps are
+// time.Time, character arrays are strings, and enums are types such as Result
+// whose String method returns the name of the value. Each field has a JSON
+// tag, so events can be logged or sent elsewhere using encoding/json.
+type Event interface {
+	// CallbackID returns the callback ID of this type of Event.
+	CallbackID() CallbackID
+}
+
+// OverflowPolicy decides what happens to events delivered to a subscription
+// whose channel is full.
+type OverflowPolicy int
+
+const (
+	// OverflowDropNewest discards the event that does not fit in the
+	// channel. This is the default.
+	OverflowDropNewest OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest event in the channel to make
+	// room for the new event.
+	OverflowDropOldest
+	// OverflowBlock makes RunCallbacks wait until there is room in the
+	// channel or the subscription is unregistered. The receiver must not
+	// call RunCallbacks itself.
+	//
+	// No other callbacks are delivered while RunCallbacks is waiting, so a
+	// receiver that stops reading stalls every callback in the process.
+	// Set SubscribeOptions.BlockTimeout to limit how long RunCallbacks
+	// waits.
+	OverflowBlock
+)
+
+// SubscribeOptions configures a call to Subscribe.
+type SubscribeOptions struct {
+	// Events limits the subscription to the types of the listed events, for
+	// example steamworks.P2PSessionRequest{}. The values of the fields are
+	// ignored. If Events is empty, every type of callback is delivered.
+	Events []Event
+
+	// Buffer is the capacity of the channel. If Buffer is 0, a capacity of
+	// 64 is used.
+	Buffer int
+
+	// Overflow decides what happens to events when the channel is full.
+	Overflow OverflowPolicy
+
+	// BlockTimeout, if positive, is the longest RunCallbacks waits for room
+	// in the channel when Overflow is OverflowBlock. An event that does not
+	// fit in time is discarded. If BlockTimeout is 0, RunCallbacks waits
+	// indefinitely.
+	BlockTimeout time.Duration
+
+	// OnDrop, if non-nil, is called from RunCallbacks with each event that
+	// is discarded because the channel is full. It is called without any
+	// locks held, so it may call Unregister.
+	OnDrop func(Event)
+}
+
+// Subscribe returns a channel that receives Steam callbacks as Events, in the
+// order they are dispatched by RunCallbacks.
+//
+// Calling Unregister on the returned Registration stops delivery and closes
+// the channel. Events already in the channel can still be received.
+//
+// Like other callback registrations, Subscribe should be called after
+// InitClient or InitServer.
+//
+// Example:
+//
+//    events, reg := steamworks.Subscribe(steamworks.SubscribeOptions{
+//        Events: []steamworks.Event{
+//            steamworks.P2PSessionRequest{},
+//            steamworks.LobbyChatUpdate{},
+//        },
+//    })
+//    defer reg.Unregister()
+//
+//    for {
+//        select {
+//        case e := <-events:
+//            switch e := e.(type) {
+//            case steamworks.P2PSessionRequest:
+//                ...
+//            case steamworks.LobbyChatUpdate:
+//                ...
+//            }
+//        case <-ticker.C:
+//            ...
+//        }
+//    }
+func Subscribe(opts SubscribeOptions) (<-chan Event, Registration) {
+	if opts.Buffer <= 0 {
+		opts.Buffer = 64
+	}
+
+	s := &subscription{
+		opts: opts,
+		ch:   make(chan Event, opts.Buffer),
+		done: make(chan struct{}),
+	}
+
+	events := opts.Events
+	if len(events) == 0 {
+		events = allEvents[:]
+	}
+
+	backend := GetBackend()
+	seen := make(map[CallbackID]bool, len(events))
+	for _, e := range events {
+		id := e.CallbackID()
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		s.regs = append(s.regs, backend.OnEvent(id, s.deliver))
+	}
+
+	return s.ch, s
+}
+
+type subscription struct {
+	opts SubscribeOptions
+	regs []Registration
+
+	lock   sync.Mutex
+	ch     chan Event
+	closed bool
+
+	done chan struct{}
+	once sync.Once
+}
+
+func (s *subscription) deliver(e Event) {
+	for _, d := range s.send(e) {
+		s.opts.OnDrop(d)
+	}
+}
+
+// send puts e in the channel according to the overflow policy and returns
+// the events that were discarded if OnDrop needs to be called.
+func (s *subscription) send(e Event) (dropped []Event) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	drop := func(d Event) {
+		if s.opts.OnDrop != nil {
+			dropped = append(dropped, d)
+		}
+	}
+
+	switch s.opts.Overflow {
+	case OverflowBlock:
+		var timeout <-chan time.Time
+		if s.opts.BlockTimeout > 0 {
+			t := time.NewTimer(s.opts.BlockTimeout)
+			defer t.Stop()
+			timeout = t.C
+		}
+
+		select {
+		case s.ch <- e:
+		case <-s.done:
+		case <-timeout:
+			drop(e)
+		}
+	case OverflowDropOldest:
+		for {
+			select {
+			case s.ch <- e:
+				return
+			default:
+			}
+
+			select {
+			case old := <-s.ch:
+				drop(old)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.ch <- e:
+		default:
+			drop(e)
+		}
+	}
+
+	return
+}
+
+func (s *subscription) Unregister() {
+	s.once.Do(func() {
+		// wake up a blocked deliver before taking the lock
+		close(s.done)
+
+		for _, r := range s.regs {
+			r.Unregister()
+		}
+
+		s.lock.Lock()
+		s.closed = true
+		close(s.ch)
+		s.lock.Unlock()
+	})
+}
+
+// unixTime converts a timestamp from the Steamworks API to a time.Time. Steam
+// uses 0 for timestamps that are not set, which becomes the zero time.Time.
+func unixTime(t uint32) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(t), 0)
+}
@@ -0,0 +1,76 @@
+package steamworks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+func TestSubscribeUnregisterInOnDrop(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	var reg steamworks.Registration
+	var dropped []steamworks.Event
+	events, reg := steamworks.Subscribe(steamworks.SubscribeOptions{
+		Events: []steamworks.Event{steamworks.IPCountry{}},
+		Buffer: 1,
+		OnDrop: func(e steamworks.Event) {
+			dropped = append(dropped, e)
+			reg.Unregister()
+		},
+	})
+
+	fake.PostEvent(steamworks.IPCountry{})
+	fake.PostEvent(steamworks.IPCountry{})
+	fake.PostEvent(steamworks.IPCountry{})
+	steamworks.RunCallbacks()
+
+	if len(dropped) != 1 {
+		t.Errorf("%d events dropped, expected 1", len(dropped))
+	}
+	if _, ok := <-events; !ok {
+		t.Error("the buffered event was not received")
+	}
+	if _, ok := <-events; ok {
+		t.Error("the channel was not closed by Unregister")
+	}
+}
+
+func TestSubscribeBlockTimeout(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	dropped := 0
+	events, reg := steamworks.Subscribe(steamworks.SubscribeOptions{
+		Events:       []steamworks.Event{steamworks.IPCountry{}},
+		Buffer:       1,
+		Overflow:     steamworks.OverflowBlock,
+		BlockTimeout: time.Millisecond,
+		OnDrop:       func(steamworks.Event) { dropped++ },
+	})
+	defer reg.Unregister()
+
+	fake.PostEvent(steamworks.IPCountry{})
+	fake.PostEvent(steamworks.IPCountry{})
+	steamworks.RunCallbacks()
+
+	if dropped != 1 {
+		t.Errorf("%d events dropped, expected 1", dropped)
+	}
+	if len(events) != 1 {
+		t.Errorf("%d events in the channel, expected 1", len(events))
+	}
+}
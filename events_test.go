@@ -0,0 +1,75 @@
+package steamworks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+)
+
+func TestEventJSON(t *testing.T) {
+	for _, tt := range []struct {
+		event steamworks.Event
+		json  string
+	}{
+		{
+			steamworks.P2PSessionConnectFail{SteamIDRemote: 76561197960287930, P2PSessionError: steamworks.P2PSessionErrorTimeout},
+			`{"steam_id_remote":"76561197960287930","p2p_session_error":4}`,
+		},
+		{
+			steamworks.GamepadTextInputDismissed{Submitted: true, SubmittedText: 5},
+			`{"submitted":true,"submitted_text":5}`,
+		},
+		{
+			steamworks.CheckFileSignatureResult{CheckFileSignature: steamworks.CheckFileSignatureValidSignature},
+			`{"check_file_signature":1}`,
+		},
+		{
+			steamworks.AppProofOfPurchaseKeyResponse{AppID: 480, KeyLength: 3, Key: "abc"},
+			`{"result":0,"app_id":480,"key_length":3,"key":"abc"}`,
+		},
+		{
+			steamworks.FavoritesListChanged{AppID: 480, Add: true},
+			`{"ip":0,"query_port":0,"conn_port":0,"app_id":480,"flags":0,"add":true,"account_id":0}`,
+		},
+		{
+			steamworks.PersonaStateChange{SteamID: 76561197960287930, ChangeFlags: steamworks.PersonaChangeName | steamworks.PersonaChangeStatus},
+			`{"steam_id":"76561197960287930","change_flags":3}`,
+		},
+		{
+			steamworks.LobbyChatUpdate{ChatMemberStateChange: steamworks.ChatMemberStateChangeLeft | steamworks.ChatMemberStateChangeDisconnected},
+			`{"steam_id_lobby":"0","steam_id_user_changed":"0","steam_id_making_change":"0","chat_member_state_change":6}`,
+		},
+	} {
+		b, err := json.Marshal(tt.event)
+		if err != nil {
+			t.Errorf("%T: %v", tt.event, err)
+		} else if string(b) != tt.json {
+			t.Errorf("%T: got %s, expected %s", tt.event, b, tt.json)
+		}
+	}
+
+	if s := steamworks.P2PSessionErrorTimeout.String(); s != "Timeout" {
+		t.Errorf("P2PSessionErrorTimeout.String() = %q, expected \"Timeout\"", s)
+	}
+}
+
+// TestEventFieldTypes checks fields that the SDK declares as plain integers
+// but that hold an app ID or enum flags.
+func TestEventFieldTypes(t *testing.T) {
+	var appID steamworks.AppID = 480
+	_ = steamworks.AppProofOfPurchaseKeyResponse{AppID: appID}
+	_ = steamworks.FavoritesListChanged{AppID: appID}
+	_ = steamworks.ClientGameServerDeny{AppID: appID}
+	_ = steamworks.MicroTxnAuthorizationResponse{AppID: appID}
+
+	change := steamworks.PersonaStateChange{ChangeFlags: steamworks.PersonaChangeGamePlayed | steamworks.PersonaChangeGameServer}
+	if change.ChangeFlags&steamworks.PersonaChangeGameServer == 0 {
+		t.Errorf("ChangeFlags = %v, expected PersonaChangeGameServer to be set", change.ChangeFlags)
+	}
+
+	update := steamworks.LobbyChatUpdate{ChatMemberStateChange: steamworks.ChatMemberStateChangeKicked}
+	if s := update.ChatMemberStateChange.String(); s != "Kicked" {
+		t.Errorf("ChatMemberStateChange.String() = %q, expected \"Kicked\"", s)
+	}
+}
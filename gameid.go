@@ -25,7 +25,8 @@ const (
 	TypeGameMod GameIDType = internal.EGameIDType_GameMod
 	// TypeShortcut is a shortcut to a non-Steam game.
 	TypeShortcut GameIDType = internal.EGameIDType_Shortcut
-	_            GameIDType = internal.EGameIDType_P2P
+	// TypeP2P is a file shared peer-to-peer.
+	TypeP2P GameIDType = internal.EGameIDType_P2P
 )
 
 // GameID identifies a game in Steam.
@@ -55,6 +56,12 @@ func NewShortcutID(exePath, appName string) GameID {
 	return crc(exePath, appName) | GameID(TypeShortcut)<<24
 }
 
+// NewP2PID returns a GameID for a peer-to-peer file with the given rendered
+// file ID.
+func NewP2PID(fileID string) GameID {
+	return crc(fileID) | GameID(TypeP2P)<<24
+}
+
 // AppID returns the AppID for this GameID.
 func (id GameID) AppID() AppID {
 	return AppID(id & 0xffffff)
@@ -65,7 +72,33 @@ func (id GameID) Type() GameIDType {
 	return GameIDType((id >> 24) & 0xff)
 }
 
+// ModID returns the mod ID of this GameID. For mods, shortcuts, and P2P files,
+// this is a CRC of the name the GameID was created from, with the high bit set.
+// For apps, it is 0.
+func (id GameID) ModID() uint32 {
+	return uint32(id >> 32)
+}
+
+// IsValid returns true if this GameID has a valid format. It does not check
+// whether the game exists.
+func (id GameID) IsValid() bool {
+	// This is a port of CGameID::IsValid from the Steamworks SDK.
+
+	switch id.Type() {
+	case TypeApp:
+		return id.AppID() != 0
+	case TypeGameMod:
+		return id.AppID() != 0 && id.ModID()&0x80000000 != 0
+	case TypeShortcut:
+		return id.ModID()&0x80000000 != 0
+	case TypeP2P:
+		return id.AppID() == 0 && id.ModID()&0x80000000 != 0
+	default:
+		return false
+	}
+}
+
 // GetAppID returns the App ID of the current process.
 func GetAppID() AppID {
-	return AppID(internal.SteamAPI_ISteamUtils_GetAppID())
+	return GetBackend().AppID()
 }
@@ -0,0 +1,177 @@
+package steamworks_test
+
+import (
+	"encoding/json"
+	"hash/crc32"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+)
+
+func TestGameIDComponents(t *testing.T) {
+	// Like CGameID, the mod ID is the CRC-32 of the name with the high
+	// bit set.
+	crc := func(s string) uint32 {
+		return crc32.ChecksumIEEE([]byte(s)) | 0x80000000
+	}
+
+	for _, tt := range []struct {
+		name     string
+		id       steamworks.GameID
+		appID    steamworks.AppID
+		gameType steamworks.GameIDType
+		modID    uint32
+	}{
+		{"app", steamworks.AppID(480).GameID(), 480, steamworks.TypeApp, 0},
+		{"mod", steamworks.NewModID(220, "/games/hl2/mymod"), 220, steamworks.TypeGameMod, crc("mymod")},
+		{"mod with extension", steamworks.NewModID(220, "mymod.dir"), 220, steamworks.TypeGameMod, crc("mymod")},
+		{"shortcut", steamworks.NewShortcutID("game.exe", "Game"), 0, steamworks.TypeShortcut, crc("game.exeGame")},
+		{"p2p", steamworks.NewP2PID("file"), 0, steamworks.TypeP2P, crc("file")},
+	} {
+		if appID := tt.id.AppID(); appID != tt.appID {
+			t.Errorf("%s: AppID() = %d, expected %d", tt.name, appID, tt.appID)
+		}
+		if gameType := tt.id.Type(); gameType != tt.gameType {
+			t.Errorf("%s: Type() = %v, expected %v", tt.name, gameType, tt.gameType)
+		}
+		if modID := tt.id.ModID(); modID != tt.modID {
+			t.Errorf("%s: ModID() = %#x, expected %#x", tt.name, modID, tt.modID)
+		}
+	}
+}
+
+// TestGameIDIsValid checks IsValid against the rules of CGameID::IsValid in
+// gameid.h.
+func TestGameIDIsValid(t *testing.T) {
+	id := func(modID uint32, gameType steamworks.GameIDType, appID steamworks.AppID) steamworks.GameID {
+		return steamworks.GameID(modID)<<32 | steamworks.GameID(gameType)<<24 | steamworks.GameID(appID)
+	}
+
+	for _, tt := range []struct {
+		name  string
+		id    steamworks.GameID
+		valid bool
+	}{
+		{"zero", 0, false},
+		{"app", id(0, steamworks.TypeApp, 480), true},
+		{"app 0", id(0, steamworks.TypeApp, 0), false},
+		{"app with mod ID", id(0x80000001, steamworks.TypeApp, 480), true},
+
+		{"mod", id(0x80000001, steamworks.TypeGameMod, 220), true},
+		{"mod app 0", id(0x80000001, steamworks.TypeGameMod, 0), false},
+		{"mod without high bit", id(1, steamworks.TypeGameMod, 220), false},
+
+		{"shortcut", id(0x80000001, steamworks.TypeShortcut, 0), true},
+		{"shortcut with app", id(0x80000001, steamworks.TypeShortcut, 220), true},
+		{"shortcut without high bit", id(1, steamworks.TypeShortcut, 0), false},
+
+		{"p2p", id(0x80000001, steamworks.TypeP2P, 0), true},
+		{"p2p with app", id(0x80000001, steamworks.TypeP2P, 220), false},
+		{"p2p without high bit", id(1, steamworks.TypeP2P, 0), false},
+
+		{"unknown type", id(0x80000001, 4, 220), false},
+	} {
+		if valid := tt.id.IsValid(); valid != tt.valid {
+			t.Errorf("%s (%#x): IsValid() = %v, expected %v", tt.name, uint64(tt.id), valid, tt.valid)
+		}
+	}
+
+	for _, id := range []steamworks.GameID{
+		steamworks.NewModID(220, "mymod"),
+		steamworks.NewShortcutID("game.exe", "Game"),
+		steamworks.NewP2PID("file"),
+	} {
+		if !id.IsValid() {
+			t.Errorf("%#x: constructed GameID is not valid", uint64(id))
+		}
+	}
+}
+
+func TestParseGameID(t *testing.T) {
+	for _, text := range []string{
+		"",
+		"-1",
+		"480.0",
+		"0x1e0",
+		" 480",
+		"18446744073709551616",
+	} {
+		if id, err := steamworks.ParseGameID(text); err == nil {
+			t.Errorf("ParseGameID(%q) = %d, expected an error", text, uint64(id))
+		} else if _, ok := err.(*steamworks.ParseError); !ok {
+			t.Errorf("ParseGameID(%q): error %v is not a *ParseError", text, err)
+		}
+	}
+}
+
+func TestGameIDTextRoundTrip(t *testing.T) {
+	for _, id := range []steamworks.GameID{
+		0,
+		steamworks.AppID(480).GameID(),
+		steamworks.NewModID(220, "mymod"),
+		steamworks.NewShortcutID("game.exe", "Game"),
+		steamworks.NewP2PID("file"),
+		^steamworks.GameID(0),
+	} {
+		text, err := id.MarshalText()
+		if err != nil {
+			t.Errorf("%d: MarshalText: %v", uint64(id), err)
+			continue
+		}
+		if string(text) != id.String() {
+			t.Errorf("%d: MarshalText = %q, expected %q", uint64(id), text, id.String())
+		}
+
+		var parsed steamworks.GameID
+		if err := parsed.UnmarshalText(text); err != nil {
+			t.Errorf("UnmarshalText(%q): %v", text, err)
+		} else if parsed != id {
+			t.Errorf("UnmarshalText(%q) = %d, expected %d", text, uint64(parsed), uint64(id))
+		}
+
+		data, err := json.Marshal(id)
+		if err != nil {
+			t.Errorf("%d: json.Marshal: %v", uint64(id), err)
+			continue
+		}
+		if expected := `"` + id.String() + `"`; string(data) != expected {
+			t.Errorf("%d: json.Marshal = %s, expected %s", uint64(id), data, expected)
+		}
+
+		parsed = 0
+		if err := json.Unmarshal(data, &parsed); err != nil {
+			t.Errorf("json.Unmarshal(%s): %v", data, err)
+		} else if parsed != id {
+			t.Errorf("json.Unmarshal(%s) = %d, expected %d", data, uint64(parsed), uint64(id))
+		}
+	}
+}
+
+func TestGameIDUnmarshalJSON(t *testing.T) {
+	for _, tt := range []struct {
+		json string
+		id   steamworks.GameID
+	}{
+		{`480`, 480},
+		{`"480"`, 480},
+		{`"9295271761215062496"`, 9295271761215062496},
+	} {
+		var id steamworks.GameID
+		if err := json.Unmarshal([]byte(tt.json), &id); err != nil {
+			t.Errorf("json.Unmarshal(%s): %v", tt.json, err)
+		} else if id != tt.id {
+			t.Errorf("json.Unmarshal(%s) = %d, expected %d", tt.json, uint64(id), uint64(tt.id))
+		}
+	}
+
+	id := steamworks.GameID(480)
+	if err := json.Unmarshal([]byte(`null`), &id); err != nil || id != 480 {
+		t.Errorf("json.Unmarshal(null) = (%d, %v), expected the ID to be unchanged", uint64(id), err)
+	}
+
+	for _, data := range []string{`"abc"`, `-1`, `true`} {
+		if err := json.Unmarshal([]byte(data), &id); err == nil {
+			t.Errorf("json.Unmarshal(%s): expected an error", data)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package steamworks
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// String returns the 64-bit decimal representation of this GameID, which is
+// the form Steam uses in URLs such as steam://rungameid/. For apps, this is
+// the same as the AppID.
+func (id GameID) String() string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// ParseGameID parses the 64-bit decimal representation of a GameID.
+//
+// If s cannot be parsed, the error is a *ParseError.
+func ParseGameID(s string) (GameID, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, &ParseError{Kind: "GameID", Text: s}
+	}
+	return GameID(n), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (id GameID) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(id), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (id *GameID) UnmarshalText(text []byte) error {
+	parsed, err := ParseGameID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The ID is encoded as a string, as
+// JavaScript numbers cannot represent the GameID of a mod or shortcut exactly.
+func (id GameID) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, id.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The ID may be a JSON number or a
+// string.
+func (id *GameID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) != 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return id.UnmarshalText([]byte(s))
+	}
+
+	return id.UnmarshalText(data)
+}
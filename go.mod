@@ -1,3 +1,5 @@
 module github.com/BenLubar/steamworks
 
+go 1.18
+
 require golang.org/x/tools v0.0.0-20181009034425-a2b3f7f249e9 // indirect
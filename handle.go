@@ -0,0 +1,341 @@
+package steamworks
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// Handle is an initialized game client or game server. Packages that keep
+// state for each game client or game server, such as steamauth and steamnet,
+// take a Handle so that the state of a game client and a game server in the
+// same process is kept separate.
+//
+// Handle is implemented by *Client, *Server, and the handle returned by
+// Global.
+type Handle interface {
+	// Backend returns the Backend to use for this handle.
+	Backend() Backend
+
+	// OnShutdown registers f to be called when the handle is shut down,
+	// before the Steamworks API is shut down. Functions are called in the
+	// reverse of the order they were registered in. If the handle has
+	// already been shut down, f is called immediately.
+	OnShutdown(f func())
+}
+
+// Errors returned by NewClient and NewServer.
+var (
+	ErrAlreadyInitialized = errors.New("steamworks: already initialized")
+	ErrClientAfterServer  = errors.New("steamworks: the game client must be initialized before the game server if both are used")
+	ErrOptionsConflict    = errors.New("steamworks: the executor or worker thread options differ from those of the running game client")
+)
+
+var (
+	handlesLock   sync.Mutex
+	currentClient *Client
+	currentServer *Server
+
+	// processOptions are the options of the first of the running Client
+	// and Server. The executor, dispatch queue, and worker thread they
+	// configure are shared by both.
+	processOptions initOptions
+)
+
+// Client is a handle to an initialized game client.
+//
+// A process can have one Client at a time. A Client and a Server can run in
+// the same process, for example for a listen server, as long as the Client is
+// created first. Each has its own callbacks and its own callback goroutine.
+//
+// The WithExecutor, WithDispatchQueue, and WithWorkerThread options apply to
+// the whole process, so a Server created while a Client is running uses the
+// Client's. NewServer returns ErrOptionsConflict if it is passed different
+// ones. The executor and worker thread are reset when the last of the Client
+// and Server is shut down.
+type Client struct {
+	h handle
+}
+
+// NewClient initializes the Steamworks API for game clients and returns a
+// handle to it. The parameters and errors are the same as for InitClient.
+//
+// If a game client is already initialized, NewClient returns
+// ErrAlreadyInitialized.
+func NewClient(startCallbackGoroutine bool, options ...InitOption) (*Client, error) {
+	handlesLock.Lock()
+	defer handlesLock.Unlock()
+
+	if currentClient != nil {
+		return nil, ErrAlreadyInitialized
+	}
+	if currentServer != nil {
+		return nil, ErrClientAfterServer
+	}
+
+	b := GetBackend()
+	if err := b.InitClient(); err != nil {
+		return nil, err
+	}
+
+	c := &Client{}
+	c.h.init(b.Side(false), startCallbackGoroutine, applyInitOptions(options), true)
+	currentClient = c
+	updateProcessLocked()
+
+	return c, nil
+}
+
+// Backend returns the Backend for the game client. Interfaces and callbacks
+// that exist on both game clients and game servers use the game client.
+func (c *Client) Backend() Backend {
+	return c.h.backend
+}
+
+// OnShutdown implements Handle.
+func (c *Client) OnShutdown(f func()) {
+	c.h.onShutdown(f)
+}
+
+// RunCallbacks dispatches callbacks and call results for the game client.
+// See the package-level RunCallbacks for details.
+func (c *Client) RunCallbacks() {
+	runCallbacks(c.h.backend)
+}
+
+// Shutdown shuts down the game client. It is safe to call Shutdown more than
+// once.
+func (c *Client) Shutdown() {
+	c.h.shutdown(func() bool {
+		if currentClient != c {
+			return false
+		}
+		currentClient = nil
+		return true
+	})
+}
+
+// Server is a handle to an initialized game server.
+//
+// A process can have one Server at a time. See Client for how to run a game
+// client and a game server in the same process.
+type Server struct {
+	h handle
+}
+
+// NewServer initializes the Steamworks API for game servers and returns a
+// handle to it. The parameters and errors are the same as for InitServer.
+//
+// If a game server is already initialized, NewServer returns
+// ErrAlreadyInitialized. If a game client is running and options conflict
+// with its options, NewServer returns ErrOptionsConflict; see Client.
+func NewServer(ip net.IP, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string, startCallbackGoroutine bool, options ...InitOption) (*Server, error) {
+	var ipInt uint32
+	if ip4 := ip.To4(); ip4 != nil {
+		ipInt = binary.BigEndian.Uint32(ip4)
+	} else if len(ip) != 0 {
+		return nil, ErrIPv4Only
+	}
+
+	opts := applyInitOptions(options)
+
+	handlesLock.Lock()
+	defer handlesLock.Unlock()
+
+	if currentServer != nil {
+		return nil, ErrAlreadyInitialized
+	}
+
+	first := currentClient == nil
+	if !first && !opts.compatible(processOptions) {
+		return nil, ErrOptionsConflict
+	}
+
+	b := GetBackend()
+	if err := b.InitServer(ipInt, steamPort, gamePort, queryPort, serverMode, version); err != nil {
+		return nil, err
+	}
+
+	s := &Server{}
+	s.h.init(b.Side(true), startCallbackGoroutine, opts, first)
+	currentServer = s
+	updateProcessLocked()
+
+	return s, nil
+}
+
+// Backend returns the Backend for the game server. Interfaces and callbacks
+// that exist on both game clients and game servers use the game server.
+func (s *Server) Backend() Backend {
+	return s.h.backend
+}
+
+// OnShutdown implements Handle.
+func (s *Server) OnShutdown(f func()) {
+	s.h.onShutdown(f)
+}
+
+// RunCallbacks dispatches callbacks and call results for the game server.
+// See the package-level RunCallbacks for details.
+func (s *Server) RunCallbacks() {
+	runCallbacks(s.h.backend)
+}
+
+// Shutdown shuts down the game server. It is safe to call Shutdown more than
+// once.
+func (s *Server) Shutdown() {
+	s.h.shutdown(func() bool {
+		if currentServer != s {
+			return false
+		}
+		currentServer = nil
+		return true
+	})
+}
+
+// handle is the state shared by Client and Server.
+type handle struct {
+	backend Backend
+	appID   AppID
+
+	lock   sync.Mutex
+	quit   chan<- chan<- struct{}
+	funcs  []func()
+	closed bool
+}
+
+// init starts the handle. If first is true, no other handle is running, and
+// the process-wide options are set from opts. The caller must hold
+// handlesLock.
+func (h *handle) init(b Backend, startCallbackGoroutine bool, opts initOptions, first bool) {
+	h.backend = b
+	h.appID = b.AppID()
+
+	if first {
+		processOptions = opts
+		setExecutor(opts)
+
+		if opts.worker {
+			startWorker(opts.releaseInterval)
+		}
+	}
+
+	if startCallbackGoroutine {
+		ch := make(chan chan<- struct{}, 1)
+		h.quit = ch
+		go runCallbacksForever(b, ch, opts.minInterval, opts.maxInterval)
+	}
+}
+
+func (h *handle) onShutdown(f func()) {
+	h.lock.Lock()
+	if !h.closed {
+		h.funcs = append(h.funcs, f)
+		h.lock.Unlock()
+		return
+	}
+	h.lock.Unlock()
+
+	f()
+}
+
+// shutdown shuts down the handle. release is called with handlesLock held
+// and returns true if the handle was still current.
+func (h *handle) shutdown(release func() bool) {
+	h.lock.Lock()
+	if h.closed {
+		h.lock.Unlock()
+		return
+	}
+	h.closed = true
+	quit, funcs := h.quit, h.funcs
+	h.quit, h.funcs = nil, nil
+	h.lock.Unlock()
+
+	handlesLock.Lock()
+	current := release()
+	last := currentClient == nil && currentServer == nil
+	updateProcessLocked()
+	handlesLock.Unlock()
+
+	stopCallbackGoroutine(quit)
+	runShutdownFuncs(funcs)
+
+	if !current {
+		return
+	}
+
+	if last {
+		shutdownGlobal()
+	}
+
+	h.backend.Shutdown()
+
+	if last {
+		setExecutor(initOptions{})
+		stopWorker()
+	}
+}
+
+// updateProcessLocked records the running handles for internal.CurrentProcess.
+// The caller must hold handlesLock.
+func updateProcessLocked() {
+	var p internal.Process
+	switch {
+	case currentClient != nil:
+		p = internal.Process{Live: true, AppID: uint32(currentClient.h.appID)}
+	case currentServer != nil:
+		p = internal.Process{Live: true, AppID: uint32(currentServer.h.appID), GameServer: true}
+	}
+	internal.SetProcess(p)
+}
+
+func runShutdownFuncs(funcs []func()) {
+	for i := len(funcs) - 1; i >= 0; i-- {
+		funcs[i]()
+	}
+}
+
+// Global returns the Handle used by the package-level functions in this
+// module, such as steamauth.BeginSession. Its Backend is GetBackend, which
+// uses the game server if it is the only one initialized, and the game client
+// otherwise. Functions passed to its OnShutdown are called when the last
+// Client or Server is shut down, or by Shutdown.
+//
+// Code that runs a game client and a game server in the same process should
+// use the Client and Server handles instead.
+func Global() Handle {
+	return &global
+}
+
+type globalHandle struct {
+	lock     sync.Mutex
+	shutdown []func()
+}
+
+var global globalHandle
+
+func (*globalHandle) Backend() Backend {
+	return GetBackend()
+}
+
+func (g *globalHandle) OnShutdown(f func()) {
+	g.lock.Lock()
+	g.shutdown = append(g.shutdown, f)
+	g.lock.Unlock()
+}
+
+// shutdownGlobal releases the state of the package-level functions.
+func shutdownGlobal() {
+	global.lock.Lock()
+	funcs := global.shutdown
+	global.shutdown = nil
+	global.lock.Unlock()
+
+	runShutdownFuncs(funcs)
+
+	internal.ResetOnce()
+}
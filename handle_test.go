@@ -0,0 +1,59 @@
+package steamworks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+func TestServerInheritsClientOptions(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	client, err := steamworks.NewClient(false, steamworks.WithDispatchQueue())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Shutdown()
+
+	called := 0
+	reg := steamworks.OnRunCallbacks(func() { called++ })
+	defer reg.Unregister()
+
+	client.RunCallbacks()
+
+	for _, tt := range []struct {
+		name    string
+		options []steamworks.InitOption
+	}{
+		{"executor", []steamworks.InitOption{steamworks.WithExecutor(steamworks.ExecutorFunc(func(fn func()) { fn() }))}},
+		{"worker thread", []steamworks.InitOption{steamworks.WithWorkerThread(time.Second)}},
+	} {
+		if _, err := steamworks.NewServer(nil, 0, 27015, 27016, steamworks.Authentication, "1.0", false, tt.options...); err != steamworks.ErrOptionsConflict {
+			t.Errorf("%s: got error %v, expected %v", tt.name, err, steamworks.ErrOptionsConflict)
+		}
+	}
+
+	server, err := steamworks.NewServer(nil, 0, 27015, 27016, steamworks.Authentication, "1.0", false, steamworks.WithDispatchQueue())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client's queued handler was not dropped by NewServer.
+	if n := steamworks.DispatchPending(); n != 1 || called != 1 {
+		t.Errorf("DispatchPending ran %d handlers (hook called %d times), expected 1", n, called)
+	}
+
+	server.Shutdown()
+
+	// The queue is still used by the client.
+	client.RunCallbacks()
+	if called != 1 {
+		t.Errorf("hook called %d times before DispatchPending, expected 1", called)
+	}
+	if n := steamworks.DispatchPending(); n != 1 || called != 2 {
+		t.Errorf("DispatchPending ran %d handlers (hook called %d times), expected 1", n, called)
+	}
+}
@@ -0,0 +1,255 @@
+package steamworks
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// InitCause is the likely reason InitClient or InitServer failed.
+type InitCause int
+
+const (
+	// InitCauseUnknown means the cause of the failure could not be
+	// determined. The most common reasons are listed in the documentation
+	// for InitClient.
+	InitCauseUnknown InitCause = iota
+	// InitCauseSteamNotRunning means the Steam client is not running.
+	InitCauseSteamNotRunning
+	// InitCauseAppIDMissing means the program was not launched by Steam
+	// and there is no steam_appid.txt file.
+	InitCauseAppIDMissing
+	// InitCauseAppIDMalformed means steam_appid.txt does not contain a
+	// valid app ID.
+	InitCauseAppIDMalformed
+	// InitCauseAppIDWrongDirectory means steam_appid.txt is next to the
+	// executable, but Steam looks for it in the current working directory.
+	InitCauseAppIDWrongDirectory
+	// InitCauseUserMismatch means the Steam client is running as a
+	// different OS user than this program.
+	InitCauseUserMismatch
+	// InitCauseLibraryUnreadable means a Steam library could not be read.
+	InitCauseLibraryUnreadable
+	// InitCauseWrongArchitecture means a Steam library was built for a
+	// different architecture than this program.
+	InitCauseWrongArchitecture
+	// InitCausePortInUse means a port passed to InitServer could not be
+	// bound.
+	InitCausePortInUse
+	// InitCauseAppIDUnreadable means steam_appid.txt exists but could not
+	// be read.
+	InitCauseAppIDUnreadable
+)
+
+var initCauseText = [...]string{
+	InitCauseUnknown:             "failed to initialize",
+	InitCauseSteamNotRunning:     "the Steam client is not running",
+	InitCauseAppIDMissing:        "could not determine the app ID",
+	InitCauseAppIDMalformed:      "steam_appid.txt does not contain a valid app ID",
+	InitCauseAppIDWrongDirectory: "steam_appid.txt is not in the working directory",
+	InitCauseUserMismatch:        "the Steam client is running as a different user",
+	InitCauseLibraryUnreadable:   "cannot read Steam library",
+	InitCauseWrongArchitecture:   "Steam library has the wrong architecture",
+	InitCausePortInUse:           "port is already in use",
+	InitCauseAppIDUnreadable:     "cannot read steam_appid.txt",
+}
+
+func (c InitCause) String() string {
+	if c >= 0 && int(c) < len(initCauseText) {
+		return initCauseText[c]
+	}
+	return "InitCause(" + strconv.Itoa(int(c)) + ")"
+}
+
+// InitError is returned by InitClient and InitServer when the Steamworks API
+// cannot be initialized.
+//
+// The Steamworks SDK does not report why initialization failed, so Cause is
+// a diagnosis made by checking the most common problems afterwards.
+type InitError struct {
+	// Cause is the likely reason initialization failed.
+	Cause InitCause
+
+	// Path is the file involved, such as the steam_appid.txt that could
+	// not be parsed or the library that could not be read, if any.
+	Path string
+
+	// AppID is the app ID that was found, if any. If Cause is
+	// InitCauseUnknown and AppID is set, InitClient found no problems with
+	// the setup. The account may not own the app, but Steam does not
+	// report that, so it is not diagnosed.
+	AppID AppID
+
+	// Port and PortName identify the port that could not be bound, for
+	// InitCausePortInUse. PortName is "steam" or "query".
+	Port     uint16
+	PortName string
+
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (err *InitError) Error() string {
+	s := "steamworks: " + err.Cause.String()
+	switch {
+	case err.Cause == InitCausePortInUse:
+		s = "steamworks: cannot bind " + err.PortName + " port " + strconv.FormatUint(uint64(err.Port), 10)
+	case err.Cause == InitCauseUnknown && err.AppID != 0:
+		s = "steamworks: failed to initialize app " + strconv.FormatUint(uint64(err.AppID), 10)
+	case err.Path != "":
+		s += " (" + err.Path + ")"
+	}
+	if err.Err != nil {
+		s += ": " + err.Err.Error()
+	}
+	return s
+}
+
+// Unwrap returns Err, or ErrSteamNotRunning for InitCauseSteamNotRunning.
+func (err *InitError) Unwrap() error {
+	if err.Err == nil && err.Cause == InitCauseSteamNotRunning {
+		return ErrSteamNotRunning
+	}
+	return err.Err
+}
+
+// Remediation returns a suggestion for fixing the problem, suitable for
+// showing to a developer or in a log.
+func (err *InitError) Remediation() string {
+	switch err.Cause {
+	case InitCauseSteamNotRunning:
+		return "Start the Steam client and log in, then start the program again."
+	case InitCauseAppIDMissing:
+		return "Launch the program from the Steam client, or create a file named steam_appid.txt containing only the app ID in the working directory."
+	case InitCauseAppIDMalformed:
+		return "Make sure steam_appid.txt contains only the app ID, such as 480, and no other text."
+	case InitCauseAppIDWrongDirectory:
+		return "Steam looks for steam_appid.txt in the working directory. Run the program from the directory containing it, or copy it to " + workingDirectory() + "."
+	case InitCauseUserMismatch:
+		return "Run the program as the same OS user as the Steam client, with the same administrator access level."
+	case InitCauseLibraryUnreadable:
+		return "Check that " + err.Path + " exists and is readable by this user. Reinstalling the Steam client may fix it."
+	case InitCauseWrongArchitecture:
+		return "Build the program for the same architecture as " + err.Path + ", or install the matching Steam runtime libraries."
+	case InitCauseAppIDUnreadable:
+		return "Check that " + err.Path + " is a file that is readable by this user."
+	case InitCausePortInUse:
+		return "Stop the other program using port " + strconv.FormatUint(uint64(err.Port), 10) + ", or pass a different " + err.PortName + " port to InitServer."
+	case InitCauseUnknown:
+		if err.AppID != 0 {
+			return "None of the common problems were found. Steam does not say whether the logged in account owns the app, but that is the most likely cause: make sure app " + strconv.FormatUint(uint64(err.AppID), 10) + " appears in the account's Steam library. For unreleased apps, check that the app has a default package and is not in the Unavailable release state."
+		}
+		return "See the list of common problems in the documentation for InitClient."
+	default:
+		return "See the list of common problems in the documentation for InitClient."
+	}
+}
+
+// diagnoseClientInit works out why SteamAPI_Init failed.
+func diagnoseClientInit(steamRunning bool) error {
+	if !steamRunning {
+		return &InitError{Cause: InitCauseSteamNotRunning}
+	}
+	if err := diagnoseUserContext(); err != nil {
+		return err
+	}
+	if err := diagnoseLibraries(false); err != nil {
+		return err
+	}
+
+	appID, err := diagnoseAppID()
+	if err != nil {
+		return err
+	}
+
+	// Everything that can be checked is set up correctly. Ownership cannot
+	// be checked without an initialized API, so don't guess.
+	return &InitError{Cause: InitCauseUnknown, AppID: appID}
+}
+
+// diagnoseServerInit works out why SteamGameServer_Init failed.
+func diagnoseServerInit(ip uint32, steamPort, queryPort uint16) error {
+	if err := diagnosePort(ip, steamPort, "steam"); err != nil {
+		return err
+	}
+	if queryPort != UseGameSocketShare {
+		if err := diagnosePort(ip, queryPort, "query"); err != nil {
+			return err
+		}
+	}
+	if err := diagnoseLibraries(true); err != nil {
+		return err
+	}
+	if _, err := diagnoseAppID(); err != nil {
+		return err
+	}
+
+	return &InitError{Cause: InitCauseUnknown}
+}
+
+// diagnoseAppID finds the app ID the same way Steam does: from the SteamAppId
+// environment variable set by the Steam client, or from steam_appid.txt in
+// the working directory.
+func diagnoseAppID() (AppID, error) {
+	if env := os.Getenv("SteamAppId"); env != "" {
+		if id, err := strconv.ParseUint(env, 10, 32); err == nil && id != 0 {
+			return AppID(id), nil
+		}
+	}
+
+	const name = "steam_appid.txt"
+
+	b, err := os.ReadFile(name)
+	if os.IsNotExist(err) {
+		if exe, exeErr := os.Executable(); exeErr == nil {
+			path := filepath.Join(filepath.Dir(exe), name)
+			if _, statErr := os.Stat(path); statErr == nil {
+				return 0, &InitError{Cause: InitCauseAppIDWrongDirectory, Path: path}
+			}
+		}
+		return 0, &InitError{Cause: InitCauseAppIDMissing}
+	}
+	path := filepath.Join(workingDirectory(), name)
+	if err != nil {
+		return 0, &InitError{Cause: InitCauseAppIDUnreadable, Path: path, Err: err}
+	}
+
+	id, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 32)
+	if err != nil || id == 0 {
+		return 0, &InitError{Cause: InitCauseAppIDMalformed, Path: path}
+	}
+
+	return AppID(id), nil
+}
+
+// diagnosePort checks whether the UDP port Steam tried to bind is available.
+// Port 0 picks any available port, so it is not checked.
+func diagnosePort(ip uint32, port uint16, name string) error {
+	if port == 0 {
+		return nil
+	}
+
+	addr := &net.UDPAddr{
+		IP:   net.IPv4(byte(ip>>24), byte(ip>>16), byte(ip>>8), byte(ip)),
+		Port: int(port),
+	}
+
+	conn, err := net.ListenUDP("udp4", addr)
+	if err != nil {
+		return &InitError{Cause: InitCausePortInUse, Port: port, PortName: name, Err: err}
+	}
+
+	_ = conn.Close()
+
+	return nil
+}
+
+func workingDirectory() string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "."
+	}
+	return wd
+}
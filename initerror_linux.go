@@ -0,0 +1,139 @@
+package steamworks
+
+import (
+	"bufio"
+	"debug/elf"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// diagnoseUserContext checks that the Steam client process recorded in
+// ~/.steam/steam.pid is owned by the same user as this process.
+func diagnoseUserContext() error {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil
+	}
+
+	b, err := os.ReadFile(filepath.Join(home, ".steam", "steam.pid"))
+	if err != nil {
+		return nil
+	}
+
+	pid, err := strconv.Atoi(strings.TrimSpace(string(b)))
+	if err != nil {
+		return nil
+	}
+
+	fi, err := os.Stat("/proc/" + strconv.Itoa(pid))
+	if err != nil {
+		return nil
+	}
+
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok && int(st.Uid) != os.Getuid() {
+		return &InitError{
+			Cause: InitCauseUserMismatch,
+			Err:   errors.New("Steam client is running as uid " + strconv.FormatUint(uint64(st.Uid), 10) + ", but this process is uid " + strconv.Itoa(os.Getuid())),
+		}
+	}
+
+	return nil
+}
+
+// diagnoseLibraries checks that libsteam_api.so, as loaded into this process,
+// and the steamclient.so it loads from the Steam client are readable and
+// built for the same architecture as this process.
+//
+// Game servers, such as those installed with steamcmd, load steamclient.so
+// from their own directory rather than from the Steam client, so only the
+// copy already loaded into the process is checked for them.
+func diagnoseLibraries(gameServer bool) error {
+	var paths []string
+	if path := loadedLibrary("libsteam_api.so"); path != "" {
+		paths = append(paths, path)
+	}
+	if gameServer {
+		if path := loadedLibrary("steamclient.so"); path != "" {
+			paths = append(paths, path)
+		}
+	} else if home, err := os.UserHomeDir(); err == nil {
+		sdk := "sdk64"
+		if runtime.GOARCH == "386" {
+			sdk = "sdk32"
+		}
+		paths = append(paths, filepath.Join(home, ".steam", sdk, "steamclient.so"))
+	}
+
+	for _, path := range paths {
+		if err := checkLibrary(path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadedLibrary returns the path of the first mapped file in this process
+// with the given name, or the empty string if there is none.
+func loadedLibrary(name string) string {
+	f, err := os.Open("/proc/self/maps")
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 6 && filepath.Base(fields[5]) == name {
+			return fields[5]
+		}
+	}
+
+	return ""
+}
+
+// checkLibrary checks that the library at path can be read and was built for
+// the same architecture as this process. A library that does not exist is not
+// diagnosed, as Steam may have found it somewhere else.
+func checkLibrary(path string) error {
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err == nil && !fi.Mode().IsRegular() {
+		err = errors.New("not a regular file")
+	}
+	if err != nil {
+		return &InitError{Cause: InitCauseLibraryUnreadable, Path: path, Err: err}
+	}
+
+	f, err := elf.Open(path)
+	if err != nil {
+		if errors.As(err, new(*elf.FormatError)) {
+			return &InitError{Cause: InitCauseWrongArchitecture, Path: path, Err: err}
+		}
+		return &InitError{Cause: InitCauseLibraryUnreadable, Path: path, Err: err}
+	}
+	defer f.Close()
+
+	want := elf.EM_X86_64
+	if runtime.GOARCH == "386" {
+		want = elf.EM_386
+	}
+
+	if f.Machine != want {
+		return &InitError{
+			Cause: InitCauseWrongArchitecture,
+			Path:  path,
+			Err:   errors.New("library is " + f.Machine.String() + ", but this process is " + runtime.GOARCH),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package steamworks
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckLibrary(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := checkLibrary(filepath.Join(dir, "missing.so")); err != nil {
+		t.Errorf("missing library: got %v, expected nil", err)
+	}
+
+	notELF := filepath.Join(dir, "text.so")
+	if err := os.WriteFile(notELF, bytes.Repeat([]byte("not a library\n"), 16), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	var initErr *InitError
+	if err := checkLibrary(notELF); !errors.As(err, &initErr) || initErr.Cause != InitCauseWrongArchitecture {
+		t.Errorf("not an ELF file: got %v, expected InitCauseWrongArchitecture", err)
+	}
+
+	if err := checkLibrary(dir); !errors.As(err, &initErr) || initErr.Cause != InitCauseLibraryUnreadable {
+		t.Errorf("directory: got %v, expected InitCauseLibraryUnreadable", err)
+	}
+
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skip(err)
+	}
+	if err := checkLibrary(exe); err != nil {
+		t.Errorf("test executable: got %v, expected nil", err)
+	}
+}
+
+// TestDiagnoseLibrariesNoSteam checks that a missing ~/.steam, as on a
+// dedicated server installed with steamcmd, is not reported as a problem.
+func TestDiagnoseLibrariesNoSteam(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	for _, gameServer := range []bool{false, true} {
+		if err := diagnoseLibraries(gameServer); err != nil {
+			t.Errorf("gameServer=%v: got %v, expected nil", gameServer, err)
+		}
+	}
+}
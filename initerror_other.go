@@ -0,0 +1,14 @@
+//go:build !linux
+// +build !linux
+
+package steamworks
+
+// diagnoseUserContext only detects problems on Linux.
+func diagnoseUserContext() error {
+	return nil
+}
+
+// diagnoseLibraries only detects problems on Linux.
+func diagnoseLibraries(gameServer bool) error {
+	return nil
+}
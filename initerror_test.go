@@ -0,0 +1,174 @@
+package steamworks
+
+import (
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// chdir changes the working directory to dir until the test ends.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+func TestDiagnoseAppID(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		env     string
+		file    string // contents of steam_appid.txt, or "" for none
+		dir     bool   // steam_appid.txt is a directory
+		exeFile bool   // steam_appid.txt is next to the executable
+		appID   AppID
+		cause   InitCause // ignored if appID is set
+	}{
+		{name: "valid", file: "480", appID: 480},
+		{name: "valid with newline", file: "480\r\n", appID: 480},
+		{name: "environment", env: "570", appID: 570},
+		{name: "environment overrides file", env: "570", file: "480", appID: 570},
+		{name: "invalid environment", env: "dota", file: "480", appID: 480},
+		{name: "missing", cause: InitCauseAppIDMissing},
+		{name: "wrong directory", exeFile: true, cause: InitCauseAppIDWrongDirectory},
+		{name: "malformed", file: "app 480", cause: InitCauseAppIDMalformed},
+		{name: "zero", file: "0", cause: InitCauseAppIDMalformed},
+		{name: "too large", file: "4294967296", cause: InitCauseAppIDMalformed},
+		{name: "empty", file: "\n", cause: InitCauseAppIDMalformed},
+		{name: "unreadable", dir: true, cause: InitCauseAppIDUnreadable},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			chdir(t, dir)
+			t.Setenv("SteamAppId", tt.env)
+
+			switch {
+			case tt.file != "":
+				if err := os.WriteFile("steam_appid.txt", []byte(tt.file), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			case tt.dir:
+				if err := os.Mkdir("steam_appid.txt", 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tt.exeFile {
+				exe, err := os.Executable()
+				if err != nil {
+					t.Skip(err)
+				}
+				path := filepath.Join(filepath.Dir(exe), "steam_appid.txt")
+				if _, err := os.Stat(path); err == nil {
+					t.Skip(path + " already exists")
+				}
+				if err := os.WriteFile(path, []byte("480"), 0o644); err != nil {
+					t.Skip(err)
+				}
+				defer os.Remove(path)
+			}
+
+			appID, err := diagnoseAppID()
+			if tt.appID != 0 {
+				if err != nil || appID != tt.appID {
+					t.Errorf("got (%d, %v), expected %d", appID, err, tt.appID)
+				}
+				return
+			}
+
+			var initErr *InitError
+			if !errors.As(err, &initErr) {
+				t.Fatalf("got (%d, %v), expected an *InitError", appID, err)
+			}
+			if initErr.Cause != tt.cause {
+				t.Errorf("Cause = %v, expected %v", initErr.Cause, tt.cause)
+			}
+			if (tt.file != "" || tt.dir) && initErr.Path != filepath.Join(workingDirectory(), "steam_appid.txt") {
+				t.Errorf("Path = %q, expected steam_appid.txt in %q", initErr.Path, workingDirectory())
+			}
+		})
+	}
+}
+
+func TestDiagnoseClientInitSteamNotRunning(t *testing.T) {
+	err := diagnoseClientInit(false)
+	if !errors.Is(err, ErrSteamNotRunning) {
+		t.Errorf("got %v, expected it to wrap ErrSteamNotRunning", err)
+	}
+
+	cause := errors.New("cause")
+	err = &InitError{Cause: InitCauseSteamNotRunning, Err: cause}
+	if !errors.Is(err, cause) || errors.Is(err, ErrSteamNotRunning) {
+		t.Errorf("Unwrap = %v, expected Err", errors.Unwrap(err))
+	}
+}
+
+func TestDiagnosePort(t *testing.T) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skip(err)
+	}
+	defer conn.Close()
+
+	port := uint16(conn.LocalAddr().(*net.UDPAddr).Port)
+	const localhost = 127<<24 | 1
+
+	err = diagnosePort(localhost, port, "query")
+	var initErr *InitError
+	if !errors.As(err, &initErr) {
+		t.Fatalf("bound port: got %v, expected an *InitError", err)
+	}
+	if initErr.Cause != InitCausePortInUse || initErr.Port != port || initErr.PortName != "query" {
+		t.Errorf("bound port: got %+v, expected InitCausePortInUse for query port %d", initErr, port)
+	}
+
+	if err := diagnosePort(localhost, 0, "steam"); err != nil {
+		t.Errorf("port 0: got %v, expected nil", err)
+	}
+
+	_ = conn.Close()
+	if err := diagnosePort(localhost, port, "query"); err != nil {
+		t.Errorf("free port: got %v, expected nil", err)
+	}
+}
+
+func TestInitErrorText(t *testing.T) {
+	for _, tt := range []struct {
+		err         *InitError
+		text        string
+		remediation string // a substring of Remediation
+	}{
+		{&InitError{Cause: InitCauseUnknown}, "steamworks: failed to initialize", "documentation for InitClient"},
+		{&InitError{Cause: InitCauseUnknown, AppID: 480}, "steamworks: failed to initialize app 480", "app 480 appears in the account's Steam library"},
+		{&InitError{Cause: InitCauseSteamNotRunning}, "steamworks: the Steam client is not running", "Start the Steam client"},
+		{&InitError{Cause: InitCauseAppIDMissing}, "steamworks: could not determine the app ID", "create a file named steam_appid.txt"},
+		{&InitError{Cause: InitCauseAppIDMalformed, Path: "/game/steam_appid.txt"}, "steamworks: steam_appid.txt does not contain a valid app ID (/game/steam_appid.txt)", "contains only the app ID"},
+		{&InitError{Cause: InitCauseAppIDWrongDirectory, Path: "/game/steam_appid.txt"}, "steamworks: steam_appid.txt is not in the working directory (/game/steam_appid.txt)", "copy it to " + workingDirectory()},
+		{&InitError{Cause: InitCauseAppIDUnreadable, Path: "/game/steam_appid.txt", Err: errors.New("permission denied")}, "steamworks: cannot read steam_appid.txt (/game/steam_appid.txt): permission denied", "/game/steam_appid.txt is a file that is readable"},
+		{&InitError{Cause: InitCauseUserMismatch, Err: errors.New("uid 1000")}, "steamworks: the Steam client is running as a different user: uid 1000", "same OS user"},
+		{&InitError{Cause: InitCauseLibraryUnreadable, Path: "/lib/steamclient.so"}, "steamworks: cannot read Steam library (/lib/steamclient.so)", "/lib/steamclient.so exists and is readable"},
+		{&InitError{Cause: InitCauseWrongArchitecture, Path: "/lib/steamclient.so"}, "steamworks: Steam library has the wrong architecture (/lib/steamclient.so)", "same architecture as /lib/steamclient.so"},
+		{&InitError{Cause: InitCausePortInUse, Port: 27016, PortName: "query"}, "steamworks: cannot bind query port 27016", "pass a different query port"},
+		{&InitError{Cause: 100}, "steamworks: InitCause(100)", "documentation for InitClient"},
+	} {
+		if text := tt.err.Error(); text != tt.text {
+			t.Errorf("%v: Error() = %q, expected %q", tt.err.Cause, text, tt.text)
+		}
+		if remediation := tt.err.Remediation(); !strings.Contains(remediation, tt.remediation) {
+			t.Errorf("%v: Remediation() = %q, expected it to contain %q", tt.err.Cause, remediation, tt.remediation)
+		}
+	}
+}
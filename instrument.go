@@ -0,0 +1,813 @@
+package steamworks
+
+import (
+	"sync"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// Instrumentation receives measurements of calls into the Steamworks API,
+// for exporting to a metrics or tracing system such as Prometheus or
+// OpenTelemetry. Install it by wrapping the Backend with Instrument.
+//
+// Metrics is an Instrumentation that keeps call counts, error counts, and
+// latency histograms in memory.
+type Instrumentation interface {
+	// StartCall is called before each call through an instrumented
+	// Backend. api is the name of the Backend method, prefixed with the
+	// name of the interface it belongs to if it is not Backend itself,
+	// such as "Networking.SendP2PPacket" or "InitClient".
+	//
+	// The returned function is called when the call returns, with nil if
+	// the call succeeded, or with the error it returned or a *CallError
+	// if it reported a failure in some other way.
+	//
+	// StartCall may be called concurrently from any goroutine, including
+	// from within callback handlers.
+	StartCall(api string) func(err error)
+}
+
+// CallError describes a call that failed without returning an error, for
+// example by returning false or a result code other than OK. It is only
+// passed to Instrumentation; the caller of the Backend method sees the
+// original return value.
+type CallError struct {
+	// API is the name of the call, as passed to StartCall.
+	API string
+	// Result is the type of failure: "false" for a method that returned
+	// false, or the name of the result code, such as "NoData" for
+	// EVoiceResult_NoData.
+	Result string
+}
+
+func (err *CallError) Error() string {
+	return "steamworks: " + err.API + " failed: " + err.Result
+}
+
+// APILockOSThread is the api passed to StartCall for the time an OS thread is
+// locked for a call into the Steamworks SDK, until the thread's Steam memory
+// is released and the thread is unlocked. It is only reported by the default
+// Backend, and only to an Instrumentation passed to TraceLockOSThread.
+//
+// While the worker thread started by WithWorkerThread is running, its OS
+// thread stays locked, so APILockOSThread instead measures each call the
+// worker makes, including releasing the thread's Steam memory when that is
+// due.
+const APILockOSThread = "LockOSThread"
+
+// Instrument returns a Backend that reports every call through b to i. It
+// should be installed with SetBackend before InitClient or InitServer is
+// called:
+//
+//    steamworks.SetBackend(steamworks.Instrument(steamworks.GetBackend(), metrics))
+//
+// Registering callbacks and the accessors for the parts of the Backend, such
+// as Networking, are not reported. Use TraceLockOSThread to also report the
+// time the default Backend spends with an OS thread locked.
+//
+// If Instrument is never called, the Steamworks API is not instrumented and
+// there is no overhead.
+func Instrument(b Backend, i Instrumentation) Backend {
+	return instrumentedBackend{b: b, i: i}
+}
+
+var (
+	lockTraceLock sync.Mutex
+	lockTraces    []*lockTrace
+)
+
+type lockTrace struct {
+	i Instrumentation
+}
+
+// TraceLockOSThread reports APILockOSThread to i each time the default
+// Backend locks an OS thread or runs a call on its worker thread, until the returned Registration is
+// unregistered. If TraceLockOSThread is called again before then, the most
+// recent Instrumentation that is still registered receives the reports.
+func TraceLockOSThread(i Instrumentation) Registration {
+	t := &lockTrace{i: i}
+
+	lockTraceLock.Lock()
+	defer lockTraceLock.Unlock()
+
+	lockTraces = append(lockTraces, t)
+	updateLockTraceLocked()
+
+	return t
+}
+
+func (t *lockTrace) Unregister() {
+	lockTraceLock.Lock()
+	defer lockTraceLock.Unlock()
+
+	for i, other := range lockTraces {
+		if other == t {
+			lockTraces = append(lockTraces[:i:i], lockTraces[i+1:]...)
+			updateLockTraceLocked()
+			return
+		}
+	}
+}
+
+func updateLockTraceLocked() {
+	if len(lockTraces) == 0 {
+		internal.SetTraceCleanup(nil)
+		return
+	}
+
+	i := lockTraces[len(lockTraces)-1].i
+	internal.SetTraceCleanup(func() func() {
+		done := i.StartCall(APILockOSThread)
+		return func() {
+			done(nil)
+		}
+	})
+}
+
+// instrumentedCall is a call in progress.
+type instrumentedCall struct {
+	api  string
+	done func(error)
+}
+
+func start(i Instrumentation, api string) instrumentedCall {
+	return instrumentedCall{api: api, done: i.StartCall(api)}
+}
+
+func (c instrumentedCall) end() {
+	c.done(nil)
+}
+
+func (c instrumentedCall) err(err error) {
+	c.done(err)
+}
+
+func (c instrumentedCall) ok(ok bool) {
+	if ok {
+		c.done(nil)
+	} else {
+		c.done(&CallError{API: c.api, Result: "false"})
+	}
+}
+
+func (c instrumentedCall) result(ok bool, result string) {
+	if ok {
+		c.done(nil)
+	} else {
+		c.done(&CallError{API: c.api, Result: result})
+	}
+}
+
+type instrumentedBackend struct {
+	b Backend
+	i Instrumentation
+}
+
+func (ib instrumentedBackend) RestartAppIfNecessary(ownAppID AppID) bool {
+	c := start(ib.i, "RestartAppIfNecessary")
+	restart := ib.b.RestartAppIfNecessary(ownAppID)
+	c.end()
+	return restart
+}
+
+func (ib instrumentedBackend) InitClient() error {
+	c := start(ib.i, "InitClient")
+	err := ib.b.InitClient()
+	c.err(err)
+	return err
+}
+
+func (ib instrumentedBackend) InitServer(ip uint32, steamPort, gamePort, queryPort uint16, serverMode ServerMode, version string) error {
+	c := start(ib.i, "InitServer")
+	err := ib.b.InitServer(ip, steamPort, gamePort, queryPort, serverMode, version)
+	c.err(err)
+	return err
+}
+
+func (ib instrumentedBackend) Shutdown() {
+	c := start(ib.i, "Shutdown")
+	ib.b.Shutdown()
+	c.end()
+}
+
+func (ib instrumentedBackend) RunCallbacks() {
+	c := start(ib.i, "RunCallbacks")
+	ib.b.RunCallbacks()
+	c.end()
+}
+
+func (ib instrumentedBackend) IsGameServer() bool {
+	return ib.b.IsGameServer()
+}
+
+func (ib instrumentedBackend) Side(gameServer bool) Backend {
+	return instrumentedBackend{b: ib.b.Side(gameServer), i: ib.i}
+}
+
+func (ib instrumentedBackend) AppID() AppID {
+	c := start(ib.i, "AppID")
+	id := ib.b.AppID()
+	c.end()
+	return id
+}
+
+func (ib instrumentedBackend) SteamID() SteamID {
+	c := start(ib.i, "SteamID")
+	id := ib.b.SteamID()
+	c.end()
+	return id
+}
+
+func (ib instrumentedBackend) APICallFailureReason(call APICall) APICallFailure {
+	c := start(ib.i, "APICallFailureReason")
+	reason := ib.b.APICallFailureReason(call)
+	c.end()
+	return reason
+}
+
+func (ib instrumentedBackend) OnEvent(id CallbackID, f func(Event)) Registration {
+	return ib.b.OnEvent(id, f)
+}
+
+func (ib instrumentedBackend) Registrations() []RegistrationInfo {
+	return ib.b.Registrations()
+}
+
+func (ib instrumentedBackend) Auth() AuthBackend {
+	return instrumentedAuth{b: ib.b.Auth(), i: ib.i}
+}
+
+func (ib instrumentedBackend) Networking() NetworkingBackend {
+	return instrumentedNetworking{b: ib.b.Networking(), i: ib.i}
+}
+
+func (ib instrumentedBackend) Utils() UtilsBackend {
+	return instrumentedUtils{b: ib.b.Utils(), i: ib.i}
+}
+
+func (ib instrumentedBackend) Controller() ControllerBackend {
+	return instrumentedController{b: ib.b.Controller(), i: ib.i}
+}
+
+func (ib instrumentedBackend) Voice() VoiceBackend {
+	return instrumentedVoice{b: ib.b.Voice(), i: ib.i}
+}
+
+func (ib instrumentedBackend) ParentalSettings() ParentalSettingsBackend {
+	return instrumentedParentalSettings{b: ib.b.ParentalSettings(), i: ib.i}
+}
+
+func (ib instrumentedBackend) UserStats() UserStatsBackend {
+	return instrumentedUserStats{b: ib.b.UserStats(), i: ib.i}
+}
+
+func (ib instrumentedBackend) GameServer() GameServerBackend {
+	return instrumentedGameServer{b: ib.b.GameServer(), i: ib.i}
+}
+
+type instrumentedAuth struct {
+	b AuthBackend
+	i Instrumentation
+}
+
+func (a instrumentedAuth) GetAuthSessionTicket(ticket []byte) (uint32, int) {
+	c := start(a.i, "Auth.GetAuthSessionTicket")
+	handle, length := a.b.GetAuthSessionTicket(ticket)
+	c.ok(handle != 0)
+	return handle, length
+}
+
+func (a instrumentedAuth) CancelAuthTicket(handle uint32) {
+	c := start(a.i, "Auth.CancelAuthTicket")
+	a.b.CancelAuthTicket(handle)
+	c.end()
+}
+
+func (a instrumentedAuth) BeginAuthSession(ticket []byte, steamID SteamID) internal.EBeginAuthSessionResult {
+	c := start(a.i, "Auth.BeginAuthSession")
+	result := a.b.BeginAuthSession(ticket, steamID)
+	c.result(result == internal.EBeginAuthSessionResult_OK, result.String())
+	return result
+}
+
+func (a instrumentedAuth) EndAuthSession(steamID SteamID) {
+	c := start(a.i, "Auth.EndAuthSession")
+	a.b.EndAuthSession(steamID)
+	c.end()
+}
+
+func (a instrumentedAuth) UserHasLicenseForApp(steamID SteamID, appID AppID) internal.EUserHasLicenseForAppResult {
+	c := start(a.i, "Auth.UserHasLicenseForApp")
+	result := a.b.UserHasLicenseForApp(steamID, appID)
+	c.end()
+	return result
+}
+
+func (a instrumentedAuth) OnValidateAuthTicketResponse(f func(steamID, ownerID SteamID, response internal.EAuthSessionResponse)) Registration {
+	return a.b.OnValidateAuthTicketResponse(f)
+}
+
+type instrumentedNetworking struct {
+	b NetworkingBackend
+	i Instrumentation
+}
+
+func (n instrumentedNetworking) SendP2PPacket(remote SteamID, data []byte, sendType internal.EP2PSend, channel int32) bool {
+	c := start(n.i, "Networking.SendP2PPacket")
+	ok := n.b.SendP2PPacket(remote, data, sendType, channel)
+	c.ok(ok)
+	return ok
+}
+
+func (n instrumentedNetworking) IsP2PPacketAvailable(channel int32) (uint32, bool) {
+	c := start(n.i, "Networking.IsP2PPacketAvailable")
+	size, ok := n.b.IsP2PPacketAvailable(channel)
+	c.end()
+	return size, ok
+}
+
+func (n instrumentedNetworking) ReadP2PPacket(buffer []byte, channel int32) (uint32, SteamID, bool) {
+	c := start(n.i, "Networking.ReadP2PPacket")
+	size, remote, ok := n.b.ReadP2PPacket(buffer, channel)
+	c.ok(ok)
+	return size, remote, ok
+}
+
+func (n instrumentedNetworking) AcceptP2PSessionWithUser(remote SteamID) bool {
+	c := start(n.i, "Networking.AcceptP2PSessionWithUser")
+	ok := n.b.AcceptP2PSessionWithUser(remote)
+	c.ok(ok)
+	return ok
+}
+
+func (n instrumentedNetworking) CloseP2PSessionWithUser(remote SteamID) bool {
+	c := start(n.i, "Networking.CloseP2PSessionWithUser")
+	ok := n.b.CloseP2PSessionWithUser(remote)
+	c.ok(ok)
+	return ok
+}
+
+func (n instrumentedNetworking) CloseP2PChannelWithUser(remote SteamID, channel int32) bool {
+	c := start(n.i, "Networking.CloseP2PChannelWithUser")
+	ok := n.b.CloseP2PChannelWithUser(remote, channel)
+	c.ok(ok)
+	return ok
+}
+
+func (n instrumentedNetworking) GetP2PSessionState(remote SteamID) (P2PSessionState, bool) {
+	c := start(n.i, "Networking.GetP2PSessionState")
+	state, ok := n.b.GetP2PSessionState(remote)
+	c.end()
+	return state, ok
+}
+
+func (n instrumentedNetworking) AllowP2PPacketRelay(allow bool) bool {
+	c := start(n.i, "Networking.AllowP2PPacketRelay")
+	ok := n.b.AllowP2PPacketRelay(allow)
+	c.ok(ok)
+	return ok
+}
+
+func (n instrumentedNetworking) OnP2PSessionRequest(f func(remote SteamID)) Registration {
+	return n.b.OnP2PSessionRequest(f)
+}
+
+func (n instrumentedNetworking) OnP2PSessionConnectFail(f func(remote SteamID, sessionError internal.EP2PSessionError)) Registration {
+	return n.b.OnP2PSessionConnectFail(f)
+}
+
+type instrumentedUtils struct {
+	b UtilsBackend
+	i Instrumentation
+}
+
+func (u instrumentedUtils) CurrentBatteryPower() uint8 {
+	c := start(u.i, "Utils.CurrentBatteryPower")
+	power := u.b.CurrentBatteryPower()
+	c.end()
+	return power
+}
+
+func (u instrumentedUtils) IPCountry() string {
+	c := start(u.i, "Utils.IPCountry")
+	country := u.b.IPCountry()
+	c.end()
+	return country
+}
+
+func (u instrumentedUtils) SecondsSinceAppActive() uint32 {
+	c := start(u.i, "Utils.SecondsSinceAppActive")
+	seconds := u.b.SecondsSinceAppActive()
+	c.end()
+	return seconds
+}
+
+func (u instrumentedUtils) SecondsSinceComputerActive() uint32 {
+	c := start(u.i, "Utils.SecondsSinceComputerActive")
+	seconds := u.b.SecondsSinceComputerActive()
+	c.end()
+	return seconds
+}
+
+func (u instrumentedUtils) ServerRealTime() uint32 {
+	c := start(u.i, "Utils.ServerRealTime")
+	t := u.b.ServerRealTime()
+	c.end()
+	return t
+}
+
+func (u instrumentedUtils) OverlayNeedsPresent() bool {
+	c := start(u.i, "Utils.OverlayNeedsPresent")
+	needsPresent := u.b.OverlayNeedsPresent()
+	c.end()
+	return needsPresent
+}
+
+func (u instrumentedUtils) IsOverlayEnabled() bool {
+	c := start(u.i, "Utils.IsOverlayEnabled")
+	enabled := u.b.IsOverlayEnabled()
+	c.end()
+	return enabled
+}
+
+func (u instrumentedUtils) IsSteamInBigPictureMode() bool {
+	c := start(u.i, "Utils.IsSteamInBigPictureMode")
+	bigPicture := u.b.IsSteamInBigPictureMode()
+	c.end()
+	return bigPicture
+}
+
+func (u instrumentedUtils) SetOverlayNotificationInset(horizontal, vertical int32) {
+	c := start(u.i, "Utils.SetOverlayNotificationInset")
+	u.b.SetOverlayNotificationInset(horizontal, vertical)
+	c.end()
+}
+
+func (u instrumentedUtils) SetOverlayNotificationPosition(position internal.ENotificationPosition) {
+	c := start(u.i, "Utils.SetOverlayNotificationPosition")
+	u.b.SetOverlayNotificationPosition(position)
+	c.end()
+}
+
+func (u instrumentedUtils) ShowGamepadTextInput(inputMode internal.EGamepadTextInputMode, lineInputMode internal.EGamepadTextInputLineMode, description string, maxLength uint32, existingText string) bool {
+	c := start(u.i, "Utils.ShowGamepadTextInput")
+	ok := u.b.ShowGamepadTextInput(inputMode, lineInputMode, description, maxLength, existingText)
+	c.ok(ok)
+	return ok
+}
+
+func (u instrumentedUtils) GetEnteredGamepadTextInput(length uint32) (string, bool) {
+	c := start(u.i, "Utils.GetEnteredGamepadTextInput")
+	text, ok := u.b.GetEnteredGamepadTextInput(length)
+	c.ok(ok)
+	return text, ok
+}
+
+func (u instrumentedUtils) IsSteamRunningInVR() bool {
+	c := start(u.i, "Utils.IsSteamRunningInVR")
+	vr := u.b.IsSteamRunningInVR()
+	c.end()
+	return vr
+}
+
+func (u instrumentedUtils) StartVRDashboard() {
+	c := start(u.i, "Utils.StartVRDashboard")
+	u.b.StartVRDashboard()
+	c.end()
+}
+
+func (u instrumentedUtils) IsVRHeadsetStreamingEnabled() bool {
+	c := start(u.i, "Utils.IsVRHeadsetStreamingEnabled")
+	enabled := u.b.IsVRHeadsetStreamingEnabled()
+	c.end()
+	return enabled
+}
+
+func (u instrumentedUtils) SetVRHeadsetStreamingEnabled(enabled bool) {
+	c := start(u.i, "Utils.SetVRHeadsetStreamingEnabled")
+	u.b.SetVRHeadsetStreamingEnabled(enabled)
+	c.end()
+}
+
+func (u instrumentedUtils) SetWarningMessageHook(debug, warning func(string)) {
+	u.b.SetWarningMessageHook(debug, warning)
+}
+
+func (u instrumentedUtils) OnLowBatteryPower(f func(minutesLeft uint8)) Registration {
+	return u.b.OnLowBatteryPower(f)
+}
+
+func (u instrumentedUtils) OnIPCountryChanged(f func()) Registration {
+	return u.b.OnIPCountryChanged(f)
+}
+
+func (u instrumentedUtils) OnSteamShutdown(f func()) Registration {
+	return u.b.OnSteamShutdown(f)
+}
+
+func (u instrumentedUtils) OnGamepadTextInputDismissed(f func(submitted bool, length uint32)) Registration {
+	return u.b.OnGamepadTextInputDismissed(f)
+}
+
+type instrumentedController struct {
+	b ControllerBackend
+	i Instrumentation
+}
+
+func (ct instrumentedController) Init() bool {
+	c := start(ct.i, "Controller.Init")
+	ok := ct.b.Init()
+	c.ok(ok)
+	return ok
+}
+
+func (ct instrumentedController) Shutdown() bool {
+	c := start(ct.i, "Controller.Shutdown")
+	ok := ct.b.Shutdown()
+	c.ok(ok)
+	return ok
+}
+
+func (ct instrumentedController) RunFrame() {
+	c := start(ct.i, "Controller.RunFrame")
+	ct.b.RunFrame()
+	c.end()
+}
+
+func (ct instrumentedController) GetConnectedControllers(handles []internal.ControllerHandle) int {
+	c := start(ct.i, "Controller.GetConnectedControllers")
+	n := ct.b.GetConnectedControllers(handles)
+	c.end()
+	return n
+}
+
+func (ct instrumentedController) GetControllerForGamepadIndex(index int32) internal.ControllerHandle {
+	c := start(ct.i, "Controller.GetControllerForGamepadIndex")
+	controller := ct.b.GetControllerForGamepadIndex(index)
+	c.end()
+	return controller
+}
+
+func (ct instrumentedController) GetGamepadIndexForController(controller internal.ControllerHandle) int32 {
+	c := start(ct.i, "Controller.GetGamepadIndexForController")
+	index := ct.b.GetGamepadIndexForController(controller)
+	c.end()
+	return index
+}
+
+func (ct instrumentedController) GetMotionData(controller internal.ControllerHandle) ([4]float32, [3]float32, [3]float32) {
+	c := start(ct.i, "Controller.GetMotionData")
+	rotQuat, posAccel, rotVel := ct.b.GetMotionData(controller)
+	c.end()
+	return rotQuat, posAccel, rotVel
+}
+
+func (ct instrumentedController) SetLEDColor(controller internal.ControllerHandle, r, g, b uint8, flags internal.ESteamControllerLEDFlag) {
+	c := start(ct.i, "Controller.SetLEDColor")
+	ct.b.SetLEDColor(controller, r, g, b, flags)
+	c.end()
+}
+
+func (ct instrumentedController) ShowBindingPanel(controller internal.ControllerHandle) bool {
+	c := start(ct.i, "Controller.ShowBindingPanel")
+	ok := ct.b.ShowBindingPanel(controller)
+	c.ok(ok)
+	return ok
+}
+
+func (ct instrumentedController) GetActionSetHandle(name string) internal.ControllerActionSetHandle {
+	c := start(ct.i, "Controller.GetActionSetHandle")
+	handle := ct.b.GetActionSetHandle(name)
+	c.end()
+	return handle
+}
+
+func (ct instrumentedController) ActivateActionSet(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle) {
+	c := start(ct.i, "Controller.ActivateActionSet")
+	ct.b.ActivateActionSet(controller, actionSet)
+	c.end()
+}
+
+func (ct instrumentedController) GetCurrentActionSet(controller internal.ControllerHandle) internal.ControllerActionSetHandle {
+	c := start(ct.i, "Controller.GetCurrentActionSet")
+	actionSet := ct.b.GetCurrentActionSet(controller)
+	c.end()
+	return actionSet
+}
+
+func (ct instrumentedController) GetDigitalActionHandle(name string) internal.ControllerDigitalActionHandle {
+	c := start(ct.i, "Controller.GetDigitalActionHandle")
+	handle := ct.b.GetDigitalActionHandle(name)
+	c.end()
+	return handle
+}
+
+func (ct instrumentedController) GetDigitalActionData(controller internal.ControllerHandle, action internal.ControllerDigitalActionHandle) (bool, bool) {
+	c := start(ct.i, "Controller.GetDigitalActionData")
+	state, active := ct.b.GetDigitalActionData(controller, action)
+	c.end()
+	return state, active
+}
+
+func (ct instrumentedController) GetDigitalActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerDigitalActionHandle, origins []internal.EControllerActionOrigin) int {
+	c := start(ct.i, "Controller.GetDigitalActionOrigins")
+	n := ct.b.GetDigitalActionOrigins(controller, actionSet, action, origins)
+	c.end()
+	return n
+}
+
+func (ct instrumentedController) GetAnalogActionHandle(name string) internal.ControllerAnalogActionHandle {
+	c := start(ct.i, "Controller.GetAnalogActionHandle")
+	handle := ct.b.GetAnalogActionHandle(name)
+	c.end()
+	return handle
+}
+
+func (ct instrumentedController) GetAnalogActionData(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) (float32, float32, internal.EControllerSourceMode, bool) {
+	c := start(ct.i, "Controller.GetAnalogActionData")
+	x, y, mode, active := ct.b.GetAnalogActionData(controller, action)
+	c.end()
+	return x, y, mode, active
+}
+
+func (ct instrumentedController) GetAnalogActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerAnalogActionHandle, origins []internal.EControllerActionOrigin) int {
+	c := start(ct.i, "Controller.GetAnalogActionOrigins")
+	n := ct.b.GetAnalogActionOrigins(controller, actionSet, action, origins)
+	c.end()
+	return n
+}
+
+func (ct instrumentedController) StopAnalogActionMomentum(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) {
+	c := start(ct.i, "Controller.StopAnalogActionMomentum")
+	ct.b.StopAnalogActionMomentum(controller, action)
+	c.end()
+}
+
+func (ct instrumentedController) TriggerHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec uint16) {
+	c := start(ct.i, "Controller.TriggerHapticPulse")
+	ct.b.TriggerHapticPulse(controller, targetPad, durationMicroSec)
+	c.end()
+}
+
+func (ct instrumentedController) TriggerRepeatedHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec, offMicroSec, repeat uint16) {
+	c := start(ct.i, "Controller.TriggerRepeatedHapticPulse")
+	ct.b.TriggerRepeatedHapticPulse(controller, targetPad, durationMicroSec, offMicroSec, repeat)
+	c.end()
+}
+
+func (ct instrumentedController) TriggerVibration(controller internal.ControllerHandle, leftSpeed, rightSpeed uint16) {
+	c := start(ct.i, "Controller.TriggerVibration")
+	ct.b.TriggerVibration(controller, leftSpeed, rightSpeed)
+	c.end()
+}
+
+func (ct instrumentedController) GetGlyphForActionOrigin(origin internal.EControllerActionOrigin) string {
+	c := start(ct.i, "Controller.GetGlyphForActionOrigin")
+	glyph := ct.b.GetGlyphForActionOrigin(origin)
+	c.end()
+	return glyph
+}
+
+func (ct instrumentedController) GetStringForActionOrigin(origin internal.EControllerActionOrigin) string {
+	c := start(ct.i, "Controller.GetStringForActionOrigin")
+	s := ct.b.GetStringForActionOrigin(origin)
+	c.end()
+	return s
+}
+
+type instrumentedVoice struct {
+	b VoiceBackend
+	i Instrumentation
+}
+
+func (v instrumentedVoice) StartVoiceRecording() {
+	c := start(v.i, "Voice.StartVoiceRecording")
+	v.b.StartVoiceRecording()
+	c.end()
+}
+
+func (v instrumentedVoice) StopVoiceRecording() {
+	c := start(v.i, "Voice.StopVoiceRecording")
+	v.b.StopVoiceRecording()
+	c.end()
+}
+
+func (v instrumentedVoice) SetInGameVoiceSpeaking(speaking bool) {
+	c := start(v.i, "Voice.SetInGameVoiceSpeaking")
+	v.b.SetInGameVoiceSpeaking(speaking)
+	c.end()
+}
+
+func (v instrumentedVoice) GetAvailableVoice() (uint32, internal.EVoiceResult) {
+	c := start(v.i, "Voice.GetAvailableVoice")
+	size, result := v.b.GetAvailableVoice()
+	c.result(result == internal.EVoiceResult_OK, result.String())
+	return size, result
+}
+
+func (v instrumentedVoice) GetVoice(buffer []byte) (uint32, internal.EVoiceResult) {
+	c := start(v.i, "Voice.GetVoice")
+	size, result := v.b.GetVoice(buffer)
+	c.result(result == internal.EVoiceResult_OK, result.String())
+	return size, result
+}
+
+func (v instrumentedVoice) DecompressVoice(compressed []byte, buffer []uint16, sampleRate uint32) (uint32, internal.EVoiceResult) {
+	c := start(v.i, "Voice.DecompressVoice")
+	samples, result := v.b.DecompressVoice(compressed, buffer, sampleRate)
+	c.result(result == internal.EVoiceResult_OK, result.String())
+	return samples, result
+}
+
+func (v instrumentedVoice) GetVoiceOptimalSampleRate() uint32 {
+	c := start(v.i, "Voice.GetVoiceOptimalSampleRate")
+	rate := v.b.GetVoiceOptimalSampleRate()
+	c.end()
+	return rate
+}
+
+type instrumentedParentalSettings struct {
+	b ParentalSettingsBackend
+	i Instrumentation
+}
+
+func (p instrumentedParentalSettings) IsParentalLockEnabled() bool {
+	c := start(p.i, "ParentalSettings.IsParentalLockEnabled")
+	enabled := p.b.IsParentalLockEnabled()
+	c.end()
+	return enabled
+}
+
+func (p instrumentedParentalSettings) IsParentalLockLocked() bool {
+	c := start(p.i, "ParentalSettings.IsParentalLockLocked")
+	locked := p.b.IsParentalLockLocked()
+	c.end()
+	return locked
+}
+
+func (p instrumentedParentalSettings) IsAppBlocked(appID AppID) bool {
+	c := start(p.i, "ParentalSettings.IsAppBlocked")
+	blocked := p.b.IsAppBlocked(appID)
+	c.end()
+	return blocked
+}
+
+func (p instrumentedParentalSettings) IsAppInBlockList(appID AppID) bool {
+	c := start(p.i, "ParentalSettings.IsAppInBlockList")
+	blocked := p.b.IsAppInBlockList(appID)
+	c.end()
+	return blocked
+}
+
+func (p instrumentedParentalSettings) IsFeatureBlocked(feature internal.EParentalFeature) bool {
+	c := start(p.i, "ParentalSettings.IsFeatureBlocked")
+	blocked := p.b.IsFeatureBlocked(feature)
+	c.end()
+	return blocked
+}
+
+func (p instrumentedParentalSettings) IsFeatureInBlockList(feature internal.EParentalFeature) bool {
+	c := start(p.i, "ParentalSettings.IsFeatureInBlockList")
+	blocked := p.b.IsFeatureInBlockList(feature)
+	c.end()
+	return blocked
+}
+
+func (p instrumentedParentalSettings) OnParentalSettingsChanged(f func()) Registration {
+	return p.b.OnParentalSettingsChanged(f)
+}
+
+type instrumentedUserStats struct {
+	b UserStatsBackend
+	i Instrumentation
+}
+
+func (u instrumentedUserStats) FindLeaderboard(name string) APICall {
+	c := start(u.i, "UserStats.FindLeaderboard")
+	call := u.b.FindLeaderboard(name)
+	c.ok(call != 0)
+	return call
+}
+
+func (u instrumentedUserStats) OnLeaderboardFindResult(call APICall, f func(leaderboard uint64, found, ioFailure bool)) Registration {
+	return u.b.OnLeaderboardFindResult(call, f)
+}
+
+type instrumentedGameServer struct {
+	b GameServerBackend
+	i Instrumentation
+}
+
+func (g instrumentedGameServer) HandleIncomingPacket(data []byte, srcIP uint32, srcPort uint16) bool {
+	c := start(g.i, "GameServer.HandleIncomingPacket")
+	ok := g.b.HandleIncomingPacket(data, srcIP, srcPort)
+	c.end()
+	return ok
+}
+
+func (g instrumentedGameServer) GetNextOutgoingPacket(buffer []byte) (int, uint32, uint16) {
+	c := start(g.i, "GameServer.GetNextOutgoingPacket")
+	size, ip, port := g.b.GetNextOutgoingPacket(buffer)
+	c.end()
+	return size, ip, port
+}
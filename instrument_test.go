@@ -0,0 +1,128 @@
+package steamworks_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+// recorder is an Instrumentation that records each call and its result.
+type recorder struct {
+	lock  sync.Mutex
+	calls []string
+	errs  []error
+}
+
+func (r *recorder) StartCall(api string) func(err error) {
+	return func(err error) {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		r.calls = append(r.calls, api)
+		r.errs = append(r.errs, err)
+	}
+}
+
+func TestInstrument(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	fakeErr := errors.New("init failed")
+	fake.SetInitError(fakeErr)
+
+	var rec recorder
+	b := steamworks.Instrument(fake, &rec)
+
+	if err := b.InitClient(); err != fakeErr {
+		t.Errorf("InitClient returned %v, expected %v", err, fakeErr)
+	}
+	fake.SetInitError(nil)
+	if err := b.InitClient(); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Shutdown()
+
+	// The caller sees the original results.
+	if ok := b.Networking().SendP2PPacket(0, []byte("x"), internal.EP2PSend_Reliable, 0); ok {
+		t.Error("SendP2PPacket to SteamID 0 returned true")
+	}
+	if _, result := b.Voice().GetAvailableVoice(); result != internal.EVoiceResult_NotRecording {
+		t.Errorf("GetAvailableVoice returned %v, expected %v", result, internal.EVoiceResult_NotRecording)
+	}
+	if id := b.AppID(); id != 480 {
+		t.Errorf("AppID returned %d, expected 480", id)
+	}
+
+	// Registrations are not reported.
+	b.Networking().OnP2PSessionRequest(func(steamworks.SteamID) {}).Unregister()
+
+	for i, expected := range []struct {
+		api string
+		err error
+	}{
+		{"InitClient", fakeErr},
+		{"InitClient", nil},
+		{"Networking.SendP2PPacket", &steamworks.CallError{API: "Networking.SendP2PPacket", Result: "false"}},
+		{"Voice.GetAvailableVoice", &steamworks.CallError{API: "Voice.GetAvailableVoice", Result: "NotRecording"}},
+		{"AppID", nil},
+	} {
+		if i >= len(rec.calls) {
+			t.Errorf("call %d: missing, expected %s", i, expected.api)
+			continue
+		}
+		if rec.calls[i] != expected.api {
+			t.Errorf("call %d: got %s, expected %s", i, rec.calls[i], expected.api)
+		}
+
+		var callErr, expectedCallErr *steamworks.CallError
+		if errors.As(expected.err, &expectedCallErr) {
+			if !errors.As(rec.errs[i], &callErr) || *callErr != *expectedCallErr {
+				t.Errorf("call %d: got error %v, expected %v", i, rec.errs[i], expected.err)
+			}
+		} else if rec.errs[i] != expected.err {
+			t.Errorf("call %d: got error %v, expected %v", i, rec.errs[i], expected.err)
+		}
+	}
+	if len(rec.calls) > 5 {
+		t.Errorf("unexpected calls: %v", rec.calls[5:])
+	}
+}
+
+func TestPendingCallResults(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	stats := fake.UserStats()
+	first := stats.FindLeaderboard("first")
+	second := stats.FindLeaderboard("second")
+
+	// Registered out of call order, with a broadcast callback in between.
+	defer stats.OnLeaderboardFindResult(second, func(uint64, bool, bool) {}).Unregister()
+	time.Sleep(time.Millisecond)
+	defer fake.Networking().OnP2PSessionRequest(func(steamworks.SteamID) {}).Unregister()
+	defer stats.OnLeaderboardFindResult(first, func(uint64, bool, bool) {}).Unregister()
+
+	pending := steamworks.PendingCallResults()
+	if len(pending) != 2 {
+		t.Fatalf("got %d pending call results, expected 2: %v", len(pending), pending)
+	}
+	if pending[0].Call != second || pending[1].Call != first {
+		t.Errorf("got calls %d, %d, expected %d, %d", pending[0].Call, pending[1].Call, second, first)
+	}
+	for _, p := range pending {
+		if p.CallbackID != (steamworks.LeaderboardFindResult{}).CallbackID() {
+			t.Errorf("call %d: CallbackID = %v, expected LeaderboardFindResult", p.Call, p.CallbackID)
+		}
+	}
+	if pending[0].Age < pending[1].Age {
+		t.Errorf("ages %v, %v are not oldest first", pending[0].Age, pending[1].Age)
+	}
+}
@@ -1,6 +1,8 @@
 // Code generated by "go generate"; DO NOT EDIT.
+//go:build (windows || linux || darwin) && (386 || amd64)
 // +build windows linux darwin
 // +build 386 amd64
+
 //go:generate go run generate.go
 
 package internal
@@ -270,1413 +272,6 @@ type (
 	GameID                                                 = C.CGameID
 )
 
-type EUniverse int32
-
-const (
-	EUniverse_Invalid  EUniverse = 0
-	EUniverse_Public   EUniverse = 1
-	EUniverse_Beta     EUniverse = 2
-	EUniverse_Internal EUniverse = 3
-	EUniverse_Dev      EUniverse = 4
-	EUniverse_Max      EUniverse = 5
-)
-
-type EResult int32
-
-const (
-	EResult_OK                                      EResult = 1
-	EResult_Fail                                    EResult = 2
-	EResult_NoConnection                            EResult = 3
-	EResult_InvalidPassword                         EResult = 5
-	EResult_LoggedInElsewhere                       EResult = 6
-	EResult_InvalidProtocolVer                      EResult = 7
-	EResult_InvalidParam                            EResult = 8
-	EResult_FileNotFound                            EResult = 9
-	EResult_Busy                                    EResult = 10
-	EResult_InvalidState                            EResult = 11
-	EResult_InvalidName                             EResult = 12
-	EResult_InvalidEmail                            EResult = 13
-	EResult_DuplicateName                           EResult = 14
-	EResult_AccessDenied                            EResult = 15
-	EResult_Timeout                                 EResult = 16
-	EResult_Banned                                  EResult = 17
-	EResult_AccountNotFound                         EResult = 18
-	EResult_InvalidSteamID                          EResult = 19
-	EResult_ServiceUnavailable                      EResult = 20
-	EResult_NotLoggedOn                             EResult = 21
-	EResult_Pending                                 EResult = 22
-	EResult_EncryptionFailure                       EResult = 23
-	EResult_InsufficientPrivilege                   EResult = 24
-	EResult_LimitExceeded                           EResult = 25
-	EResult_Revoked                                 EResult = 26
-	EResult_Expired                                 EResult = 27
-	EResult_AlreadyRedeemed                         EResult = 28
-	EResult_DuplicateRequest                        EResult = 29
-	EResult_AlreadyOwned                            EResult = 30
-	EResult_IPNotFound                              EResult = 31
-	EResult_PersistFailed                           EResult = 32
-	EResult_LockingFailed                           EResult = 33
-	EResult_LogonSessionReplaced                    EResult = 34
-	EResult_ConnectFailed                           EResult = 35
-	EResult_HandshakeFailed                         EResult = 36
-	EResult_IOFailure                               EResult = 37
-	EResult_RemoteDisconnect                        EResult = 38
-	EResult_ShoppingCartNotFound                    EResult = 39
-	EResult_Blocked                                 EResult = 40
-	EResult_Ignored                                 EResult = 41
-	EResult_NoMatch                                 EResult = 42
-	EResult_AccountDisabled                         EResult = 43
-	EResult_ServiceReadOnly                         EResult = 44
-	EResult_AccountNotFeatured                      EResult = 45
-	EResult_AdministratorOK                         EResult = 46
-	EResult_ContentVersion                          EResult = 47
-	EResult_TryAnotherCM                            EResult = 48
-	EResult_PasswordRequiredToKickSession           EResult = 49
-	EResult_AlreadyLoggedInElsewhere                EResult = 50
-	EResult_Suspended                               EResult = 51
-	EResult_Cancelled                               EResult = 52
-	EResult_DataCorruption                          EResult = 53
-	EResult_DiskFull                                EResult = 54
-	EResult_RemoteCallFailed                        EResult = 55
-	EResult_PasswordUnset                           EResult = 56
-	EResult_ExternalAccountUnlinked                 EResult = 57
-	EResult_PSNTicketInvalid                        EResult = 58
-	EResult_ExternalAccountAlreadyLinked            EResult = 59
-	EResult_RemoteFileConflict                      EResult = 60
-	EResult_IllegalPassword                         EResult = 61
-	EResult_SameAsPreviousValue                     EResult = 62
-	EResult_AccountLogonDenied                      EResult = 63
-	EResult_CannotUseOldPassword                    EResult = 64
-	EResult_InvalidLoginAuthCode                    EResult = 65
-	EResult_AccountLogonDeniedNoMail                EResult = 66
-	EResult_HardwareNotCapableOfIPT                 EResult = 67
-	EResult_IPTInitError                            EResult = 68
-	EResult_ParentalControlRestricted               EResult = 69
-	EResult_FacebookQueryError                      EResult = 70
-	EResult_ExpiredLoginAuthCode                    EResult = 71
-	EResult_IPLoginRestrictionFailed                EResult = 72
-	EResult_AccountLockedDown                       EResult = 73
-	EResult_AccountLogonDeniedVerifiedEmailRequired EResult = 74
-	EResult_NoMatchingURL                           EResult = 75
-	EResult_BadResponse                             EResult = 76
-	EResult_RequirePasswordReEntry                  EResult = 77
-	EResult_ValueOutOfRange                         EResult = 78
-	EResult_UnexpectedError                         EResult = 79
-	EResult_Disabled                                EResult = 80
-	EResult_InvalidCEGSubmission                    EResult = 81
-	EResult_RestrictedDevice                        EResult = 82
-	EResult_RegionLocked                            EResult = 83
-	EResult_RateLimitExceeded                       EResult = 84
-	EResult_AccountLoginDeniedNeedTwoFactor         EResult = 85
-	EResult_ItemDeleted                             EResult = 86
-	EResult_AccountLoginDeniedThrottle              EResult = 87
-	EResult_TwoFactorCodeMismatch                   EResult = 88
-	EResult_TwoFactorActivationCodeMismatch         EResult = 89
-	EResult_AccountAssociatedToMultiplePartners     EResult = 90
-	EResult_NotModified                             EResult = 91
-	EResult_NoMobileDevice                          EResult = 92
-	EResult_TimeNotSynced                           EResult = 93
-	EResult_SmsCodeFailed                           EResult = 94
-	EResult_AccountLimitExceeded                    EResult = 95
-	EResult_AccountActivityLimitExceeded            EResult = 96
-	EResult_PhoneActivityLimitExceeded              EResult = 97
-	EResult_RefundToWallet                          EResult = 98
-	EResult_EmailSendFailure                        EResult = 99
-	EResult_NotSettled                              EResult = 100
-	EResult_NeedCaptcha                             EResult = 101
-	EResult_GSLTDenied                              EResult = 102
-	EResult_GSOwnerDenied                           EResult = 103
-	EResult_InvalidItemType                         EResult = 104
-	EResult_IPBanned                                EResult = 105
-	EResult_GSLTExpired                             EResult = 106
-	EResult_InsufficientFunds                       EResult = 107
-	EResult_TooManyPending                          EResult = 108
-	EResult_NoSiteLicensesFound                     EResult = 109
-	EResult_WGNetworkSendExceeded                   EResult = 110
-	EResult_AccountNotFriends                       EResult = 111
-	EResult_LimitedUserAccount                      EResult = 112
-)
-
-type EVoiceResult int32
-
-const (
-	EVoiceResult_OK                   EVoiceResult = 0
-	EVoiceResult_NotInitialized       EVoiceResult = 1
-	EVoiceResult_NotRecording         EVoiceResult = 2
-	EVoiceResult_NoData               EVoiceResult = 3
-	EVoiceResult_BufferTooSmall       EVoiceResult = 4
-	EVoiceResult_DataCorrupted        EVoiceResult = 5
-	EVoiceResult_Restricted           EVoiceResult = 6
-	EVoiceResult_UnsupportedCodec     EVoiceResult = 7
-	EVoiceResult_ReceiverOutOfDate    EVoiceResult = 8
-	EVoiceResult_ReceiverDidNotAnswer EVoiceResult = 9
-)
-
-type EDenyReason int32
-
-const (
-	EDenyReason_EDenyInvalid                 EDenyReason = 0
-	EDenyReason_EDenyInvalidVersion          EDenyReason = 1
-	EDenyReason_EDenyGeneric                 EDenyReason = 2
-	EDenyReason_EDenyNotLoggedOn             EDenyReason = 3
-	EDenyReason_EDenyNoLicense               EDenyReason = 4
-	EDenyReason_EDenyCheater                 EDenyReason = 5
-	EDenyReason_EDenyLoggedInElseWhere       EDenyReason = 6
-	EDenyReason_EDenyUnknownText             EDenyReason = 7
-	EDenyReason_EDenyIncompatibleAnticheat   EDenyReason = 8
-	EDenyReason_EDenyMemoryCorruption        EDenyReason = 9
-	EDenyReason_EDenyIncompatibleSoftware    EDenyReason = 10
-	EDenyReason_EDenySteamConnectionLost     EDenyReason = 11
-	EDenyReason_EDenySteamConnectionError    EDenyReason = 12
-	EDenyReason_EDenySteamResponseTimedOut   EDenyReason = 13
-	EDenyReason_EDenySteamValidationStalled  EDenyReason = 14
-	EDenyReason_EDenySteamOwnerLeftGuestUser EDenyReason = 15
-)
-
-type EBeginAuthSessionResult int32
-
-const (
-	EBeginAuthSessionResult_OK               EBeginAuthSessionResult = 0
-	EBeginAuthSessionResult_InvalidTicket    EBeginAuthSessionResult = 1
-	EBeginAuthSessionResult_DuplicateRequest EBeginAuthSessionResult = 2
-	EBeginAuthSessionResult_InvalidVersion   EBeginAuthSessionResult = 3
-	EBeginAuthSessionResult_GameMismatch     EBeginAuthSessionResult = 4
-	EBeginAuthSessionResult_ExpiredTicket    EBeginAuthSessionResult = 5
-)
-
-type EAuthSessionResponse int32
-
-const (
-	EAuthSessionResponse_OK                           EAuthSessionResponse = 0
-	EAuthSessionResponse_UserNotConnectedToSteam      EAuthSessionResponse = 1
-	EAuthSessionResponse_NoLicenseOrExpired           EAuthSessionResponse = 2
-	EAuthSessionResponse_VACBanned                    EAuthSessionResponse = 3
-	EAuthSessionResponse_LoggedInElseWhere            EAuthSessionResponse = 4
-	EAuthSessionResponse_VACCheckTimedOut             EAuthSessionResponse = 5
-	EAuthSessionResponse_AuthTicketCanceled           EAuthSessionResponse = 6
-	EAuthSessionResponse_AuthTicketInvalidAlreadyUsed EAuthSessionResponse = 7
-	EAuthSessionResponse_AuthTicketInvalid            EAuthSessionResponse = 8
-	EAuthSessionResponse_PublisherIssuedBan           EAuthSessionResponse = 9
-)
-
-type EUserHasLicenseForAppResult int32
-
-const (
-	EUserHasLicenseForAppResult_EUserHasLicenseResultHasLicense         EUserHasLicenseForAppResult = 0
-	EUserHasLicenseForAppResult_EUserHasLicenseResultDoesNotHaveLicense EUserHasLicenseForAppResult = 1
-	EUserHasLicenseForAppResult_EUserHasLicenseResultNoAuth             EUserHasLicenseForAppResult = 2
-)
-
-type EAccountType int32
-
-const (
-	EAccountType_Invalid        EAccountType = 0
-	EAccountType_Individual     EAccountType = 1
-	EAccountType_Multiseat      EAccountType = 2
-	EAccountType_GameServer     EAccountType = 3
-	EAccountType_AnonGameServer EAccountType = 4
-	EAccountType_Pending        EAccountType = 5
-	EAccountType_ContentServer  EAccountType = 6
-	EAccountType_Clan           EAccountType = 7
-	EAccountType_Chat           EAccountType = 8
-	EAccountType_ConsoleUser    EAccountType = 9
-	EAccountType_AnonUser       EAccountType = 10
-	EAccountType_Max            EAccountType = 11
-)
-
-type EAppReleaseState int32
-
-const (
-	EAppReleaseState_Unknown     EAppReleaseState = 0
-	EAppReleaseState_Unavailable EAppReleaseState = 1
-	EAppReleaseState_Prerelease  EAppReleaseState = 2
-	EAppReleaseState_PreloadOnly EAppReleaseState = 3
-	EAppReleaseState_Released    EAppReleaseState = 4
-)
-
-type EAppOwnershipFlags int32
-
-const (
-	EAppOwnershipFlags_None               EAppOwnershipFlags = 0
-	EAppOwnershipFlags_OwnsLicense        EAppOwnershipFlags = 1
-	EAppOwnershipFlags_FreeLicense        EAppOwnershipFlags = 2
-	EAppOwnershipFlags_RegionRestricted   EAppOwnershipFlags = 4
-	EAppOwnershipFlags_LowViolence        EAppOwnershipFlags = 8
-	EAppOwnershipFlags_InvalidPlatform    EAppOwnershipFlags = 16
-	EAppOwnershipFlags_SharedLicense      EAppOwnershipFlags = 32
-	EAppOwnershipFlags_FreeWeekend        EAppOwnershipFlags = 64
-	EAppOwnershipFlags_RetailLicense      EAppOwnershipFlags = 128
-	EAppOwnershipFlags_LicenseLocked      EAppOwnershipFlags = 256
-	EAppOwnershipFlags_LicensePending     EAppOwnershipFlags = 512
-	EAppOwnershipFlags_LicenseExpired     EAppOwnershipFlags = 1024
-	EAppOwnershipFlags_LicensePermanent   EAppOwnershipFlags = 2048
-	EAppOwnershipFlags_LicenseRecurring   EAppOwnershipFlags = 4096
-	EAppOwnershipFlags_LicenseCanceled    EAppOwnershipFlags = 8192
-	EAppOwnershipFlags_AutoGrant          EAppOwnershipFlags = 16384
-	EAppOwnershipFlags_PendingGift        EAppOwnershipFlags = 32768
-	EAppOwnershipFlags_RentalNotActivated EAppOwnershipFlags = 65536
-	EAppOwnershipFlags_Rental             EAppOwnershipFlags = 131072
-	EAppOwnershipFlags_SiteLicense        EAppOwnershipFlags = 262144
-)
-
-type EAppType int32
-
-const (
-	EAppType_Invalid          EAppType = 0
-	EAppType_Game             EAppType = 1
-	EAppType_Application      EAppType = 2
-	EAppType_Tool             EAppType = 4
-	EAppType_Demo             EAppType = 8
-	EAppType_Media_DEPRECATED EAppType = 16
-	EAppType_DLC              EAppType = 32
-	EAppType_Guide            EAppType = 64
-	EAppType_Driver           EAppType = 128
-	EAppType_Config           EAppType = 256
-	EAppType_Hardware         EAppType = 512
-	EAppType_Franchise        EAppType = 1024
-	EAppType_Video            EAppType = 2048
-	EAppType_Plugin           EAppType = 4096
-	EAppType_Music            EAppType = 8192
-	EAppType_Series           EAppType = 16384
-	EAppType_Comic            EAppType = 32768
-	EAppType_Shortcut         EAppType = 1073741824
-	EAppType_DepotOnly        EAppType = -2147483648
-)
-
-type ESteamUserStatType int32
-
-const (
-	ESteamUserStatType_INVALID           ESteamUserStatType = 0
-	ESteamUserStatType_INT               ESteamUserStatType = 1
-	ESteamUserStatType_FLOAT             ESteamUserStatType = 2
-	ESteamUserStatType_AVGRATE           ESteamUserStatType = 3
-	ESteamUserStatType_ACHIEVEMENTS      ESteamUserStatType = 4
-	ESteamUserStatType_GROUPACHIEVEMENTS ESteamUserStatType = 5
-	ESteamUserStatType_MAX               ESteamUserStatType = 6
-)
-
-type EChatEntryType int32
-
-const (
-	EChatEntryType_Invalid          EChatEntryType = 0
-	EChatEntryType_ChatMsg          EChatEntryType = 1
-	EChatEntryType_Typing           EChatEntryType = 2
-	EChatEntryType_InviteGame       EChatEntryType = 3
-	EChatEntryType_Emote            EChatEntryType = 4
-	EChatEntryType_LeftConversation EChatEntryType = 6
-	EChatEntryType_Entered          EChatEntryType = 7
-	EChatEntryType_WasKicked        EChatEntryType = 8
-	EChatEntryType_WasBanned        EChatEntryType = 9
-	EChatEntryType_Disconnected     EChatEntryType = 10
-	EChatEntryType_HistoricalChat   EChatEntryType = 11
-	EChatEntryType_LinkBlocked      EChatEntryType = 14
-)
-
-type EChatRoomEnterResponse int32
-
-const (
-	EChatRoomEnterResponse_Success           EChatRoomEnterResponse = 1
-	EChatRoomEnterResponse_DoesntExist       EChatRoomEnterResponse = 2
-	EChatRoomEnterResponse_NotAllowed        EChatRoomEnterResponse = 3
-	EChatRoomEnterResponse_Full              EChatRoomEnterResponse = 4
-	EChatRoomEnterResponse_Error             EChatRoomEnterResponse = 5
-	EChatRoomEnterResponse_Banned            EChatRoomEnterResponse = 6
-	EChatRoomEnterResponse_Limited           EChatRoomEnterResponse = 7
-	EChatRoomEnterResponse_ClanDisabled      EChatRoomEnterResponse = 8
-	EChatRoomEnterResponse_CommunityBan      EChatRoomEnterResponse = 9
-	EChatRoomEnterResponse_MemberBlockedYou  EChatRoomEnterResponse = 10
-	EChatRoomEnterResponse_YouBlockedMember  EChatRoomEnterResponse = 11
-	EChatRoomEnterResponse_RatelimitExceeded EChatRoomEnterResponse = 15
-)
-
-type EChatSteamIDInstanceFlags int32
-
-const (
-	EChatSteamIDInstanceFlags_EChatAccountInstanceMask  EChatSteamIDInstanceFlags = 4095
-	EChatSteamIDInstanceFlags_EChatInstanceFlagClan     EChatSteamIDInstanceFlags = 524288
-	EChatSteamIDInstanceFlags_EChatInstanceFlagLobby    EChatSteamIDInstanceFlags = 262144
-	EChatSteamIDInstanceFlags_EChatInstanceFlagMMSLobby EChatSteamIDInstanceFlags = 131072
-)
-
-type EMarketingMessageFlags int32
-
-const (
-	EMarketingMessageFlags_None                 EMarketingMessageFlags = 0
-	EMarketingMessageFlags_HighPriority         EMarketingMessageFlags = 1
-	EMarketingMessageFlags_PlatformWindows      EMarketingMessageFlags = 2
-	EMarketingMessageFlags_PlatformMac          EMarketingMessageFlags = 4
-	EMarketingMessageFlags_PlatformLinux        EMarketingMessageFlags = 8
-	EMarketingMessageFlags_PlatformRestrictions EMarketingMessageFlags = 14
-)
-
-type ENotificationPosition int32
-
-const (
-	ENotificationPosition_EPositionTopLeft     ENotificationPosition = 0
-	ENotificationPosition_EPositionTopRight    ENotificationPosition = 1
-	ENotificationPosition_EPositionBottomLeft  ENotificationPosition = 2
-	ENotificationPosition_EPositionBottomRight ENotificationPosition = 3
-)
-
-type EBroadcastUploadResult int32
-
-const (
-	EBroadcastUploadResult_None              EBroadcastUploadResult = 0
-	EBroadcastUploadResult_OK                EBroadcastUploadResult = 1
-	EBroadcastUploadResult_InitFailed        EBroadcastUploadResult = 2
-	EBroadcastUploadResult_FrameFailed       EBroadcastUploadResult = 3
-	EBroadcastUploadResult_Timeout           EBroadcastUploadResult = 4
-	EBroadcastUploadResult_BandwidthExceeded EBroadcastUploadResult = 5
-	EBroadcastUploadResult_LowFPS            EBroadcastUploadResult = 6
-	EBroadcastUploadResult_MissingKeyFrames  EBroadcastUploadResult = 7
-	EBroadcastUploadResult_NoConnection      EBroadcastUploadResult = 8
-	EBroadcastUploadResult_RelayFailed       EBroadcastUploadResult = 9
-	EBroadcastUploadResult_SettingsChanged   EBroadcastUploadResult = 10
-	EBroadcastUploadResult_MissingAudio      EBroadcastUploadResult = 11
-	EBroadcastUploadResult_TooFarBehind      EBroadcastUploadResult = 12
-	EBroadcastUploadResult_TranscodeBehind   EBroadcastUploadResult = 13
-)
-
-type ELaunchOptionType int32
-
-const (
-	ELaunchOptionType_None          ELaunchOptionType = 0
-	ELaunchOptionType_Default       ELaunchOptionType = 1
-	ELaunchOptionType_SafeMode      ELaunchOptionType = 2
-	ELaunchOptionType_Multiplayer   ELaunchOptionType = 3
-	ELaunchOptionType_Config        ELaunchOptionType = 4
-	ELaunchOptionType_OpenVR        ELaunchOptionType = 5
-	ELaunchOptionType_Server        ELaunchOptionType = 6
-	ELaunchOptionType_Editor        ELaunchOptionType = 7
-	ELaunchOptionType_Manual        ELaunchOptionType = 8
-	ELaunchOptionType_Benchmark     ELaunchOptionType = 9
-	ELaunchOptionType_Option1       ELaunchOptionType = 10
-	ELaunchOptionType_Option2       ELaunchOptionType = 11
-	ELaunchOptionType_Option3       ELaunchOptionType = 12
-	ELaunchOptionType_OculusVR      ELaunchOptionType = 13
-	ELaunchOptionType_OpenVROverlay ELaunchOptionType = 14
-	ELaunchOptionType_OSVR          ELaunchOptionType = 15
-	ELaunchOptionType_Dialog        ELaunchOptionType = 1000
-)
-
-type EVRHMDType int32
-
-const (
-	EVRHMDType_None                  EVRHMDType = -1
-	EVRHMDType_Unknown               EVRHMDType = 0
-	EVRHMDType_HTC_Dev               EVRHMDType = 1
-	EVRHMDType_HTC_VivePre           EVRHMDType = 2
-	EVRHMDType_HTC_Vive              EVRHMDType = 3
-	EVRHMDType_HTC_Unknown           EVRHMDType = 20
-	EVRHMDType_Oculus_DK1            EVRHMDType = 21
-	EVRHMDType_Oculus_DK2            EVRHMDType = 22
-	EVRHMDType_Oculus_Rift           EVRHMDType = 23
-	EVRHMDType_Oculus_Unknown        EVRHMDType = 40
-	EVRHMDType_Acer_Unknown          EVRHMDType = 50
-	EVRHMDType_Acer_WindowsMR        EVRHMDType = 51
-	EVRHMDType_Dell_Unknown          EVRHMDType = 60
-	EVRHMDType_Dell_Visor            EVRHMDType = 61
-	EVRHMDType_Lenovo_Unknown        EVRHMDType = 70
-	EVRHMDType_Lenovo_Explorer       EVRHMDType = 71
-	EVRHMDType_HP_Unknown            EVRHMDType = 80
-	EVRHMDType_HP_WindowsMR          EVRHMDType = 81
-	EVRHMDType_Samsung_Unknown       EVRHMDType = 90
-	EVRHMDType_Samsung_Odyssey       EVRHMDType = 91
-	EVRHMDType_Unannounced_Unknown   EVRHMDType = 100
-	EVRHMDType_Unannounced_WindowsMR EVRHMDType = 101
-)
-
-type EGameIDType int32
-
-const (
-	EGameIDType_App      EGameIDType = 0
-	EGameIDType_GameMod  EGameIDType = 1
-	EGameIDType_Shortcut EGameIDType = 2
-	EGameIDType_P2P      EGameIDType = 3
-)
-
-type EFailureType int32
-
-const (
-	EFailureType_EFailureFlushedCallbackQueue EFailureType = 0
-	EFailureType_EFailurePipeFail             EFailureType = 1
-)
-
-type EFriendRelationship int32
-
-const (
-	EFriendRelationship_None                 EFriendRelationship = 0
-	EFriendRelationship_Blocked              EFriendRelationship = 1
-	EFriendRelationship_RequestRecipient     EFriendRelationship = 2
-	EFriendRelationship_Friend               EFriendRelationship = 3
-	EFriendRelationship_RequestInitiator     EFriendRelationship = 4
-	EFriendRelationship_Ignored              EFriendRelationship = 5
-	EFriendRelationship_IgnoredFriend        EFriendRelationship = 6
-	EFriendRelationship_Suggested_DEPRECATED EFriendRelationship = 7
-	EFriendRelationship_Max                  EFriendRelationship = 8
-)
-
-type EPersonaState int32
-
-const (
-	EPersonaState_Offline        EPersonaState = 0
-	EPersonaState_Online         EPersonaState = 1
-	EPersonaState_Busy           EPersonaState = 2
-	EPersonaState_Away           EPersonaState = 3
-	EPersonaState_Snooze         EPersonaState = 4
-	EPersonaState_LookingToTrade EPersonaState = 5
-	EPersonaState_LookingToPlay  EPersonaState = 6
-	EPersonaState_Max            EPersonaState = 7
-)
-
-type EFriendFlags int32
-
-const (
-	EFriendFlags_EFriendFlagNone                 EFriendFlags = 0
-	EFriendFlags_EFriendFlagBlocked              EFriendFlags = 1
-	EFriendFlags_EFriendFlagFriendshipRequested  EFriendFlags = 2
-	EFriendFlags_EFriendFlagImmediate            EFriendFlags = 4
-	EFriendFlags_EFriendFlagClanMember           EFriendFlags = 8
-	EFriendFlags_EFriendFlagOnGameServer         EFriendFlags = 16
-	EFriendFlags_EFriendFlagRequestingFriendship EFriendFlags = 128
-	EFriendFlags_EFriendFlagRequestingInfo       EFriendFlags = 256
-	EFriendFlags_EFriendFlagIgnored              EFriendFlags = 512
-	EFriendFlags_EFriendFlagIgnoredFriend        EFriendFlags = 1024
-	EFriendFlags_EFriendFlagChatMember           EFriendFlags = 4096
-	EFriendFlags_EFriendFlagAll                  EFriendFlags = 65535
-)
-
-type EUserRestriction int32
-
-const (
-	EUserRestriction_nUserRestrictionNone        EUserRestriction = 0
-	EUserRestriction_nUserRestrictionUnknown     EUserRestriction = 1
-	EUserRestriction_nUserRestrictionAnyChat     EUserRestriction = 2
-	EUserRestriction_nUserRestrictionVoiceChat   EUserRestriction = 4
-	EUserRestriction_nUserRestrictionGroupChat   EUserRestriction = 8
-	EUserRestriction_nUserRestrictionRating      EUserRestriction = 16
-	EUserRestriction_nUserRestrictionGameInvites EUserRestriction = 32
-	EUserRestriction_nUserRestrictionTrading     EUserRestriction = 64
-)
-
-type EOverlayToStoreFlag int32
-
-const (
-	EOverlayToStoreFlag_None             EOverlayToStoreFlag = 0
-	EOverlayToStoreFlag_AddToCart        EOverlayToStoreFlag = 1
-	EOverlayToStoreFlag_AddToCartAndShow EOverlayToStoreFlag = 2
-)
-
-type EPersonaChange int32
-
-const (
-	EPersonaChange_Name                EPersonaChange = 1
-	EPersonaChange_Status              EPersonaChange = 2
-	EPersonaChange_ComeOnline          EPersonaChange = 4
-	EPersonaChange_GoneOffline         EPersonaChange = 8
-	EPersonaChange_GamePlayed          EPersonaChange = 16
-	EPersonaChange_GameServer          EPersonaChange = 32
-	EPersonaChange_Avatar              EPersonaChange = 64
-	EPersonaChange_JoinedSource        EPersonaChange = 128
-	EPersonaChange_LeftSource          EPersonaChange = 256
-	EPersonaChange_RelationshipChanged EPersonaChange = 512
-	EPersonaChange_NameFirstSet        EPersonaChange = 1024
-	EPersonaChange_FacebookInfo        EPersonaChange = 2048
-	EPersonaChange_Nickname            EPersonaChange = 4096
-	EPersonaChange_SteamLevel          EPersonaChange = 8192
-)
-
-type ESteamAPICallFailure int32
-
-const (
-	ESteamAPICallFailure_None               ESteamAPICallFailure = -1
-	ESteamAPICallFailure_SteamGone          ESteamAPICallFailure = 0
-	ESteamAPICallFailure_NetworkFailure     ESteamAPICallFailure = 1
-	ESteamAPICallFailure_InvalidHandle      ESteamAPICallFailure = 2
-	ESteamAPICallFailure_MismatchedCallback ESteamAPICallFailure = 3
-)
-
-type EGamepadTextInputMode int32
-
-const (
-	EGamepadTextInputMode_Normal   EGamepadTextInputMode = 0
-	EGamepadTextInputMode_Password EGamepadTextInputMode = 1
-)
-
-type EGamepadTextInputLineMode int32
-
-const (
-	EGamepadTextInputLineMode_SingleLine    EGamepadTextInputLineMode = 0
-	EGamepadTextInputLineMode_MultipleLines EGamepadTextInputLineMode = 1
-)
-
-type ECheckFileSignature int32
-
-const (
-	ECheckFileSignature_InvalidSignature             ECheckFileSignature = 0
-	ECheckFileSignature_ValidSignature               ECheckFileSignature = 1
-	ECheckFileSignature_FileNotFound                 ECheckFileSignature = 2
-	ECheckFileSignature_NoSignaturesFoundForThisApp  ECheckFileSignature = 3
-	ECheckFileSignature_NoSignaturesFoundForThisFile ECheckFileSignature = 4
-)
-
-type EMatchMakingServerResponse int32
-
-const (
-	EMatchMakingServerResponse_ServerResponded               EMatchMakingServerResponse = 0
-	EMatchMakingServerResponse_ServerFailedToRespond         EMatchMakingServerResponse = 1
-	EMatchMakingServerResponse_NoServersListedOnMasterServer EMatchMakingServerResponse = 2
-)
-
-type ELobbyType int32
-
-const (
-	ELobbyType_Private     ELobbyType = 0
-	ELobbyType_FriendsOnly ELobbyType = 1
-	ELobbyType_Public      ELobbyType = 2
-	ELobbyType_Invisible   ELobbyType = 3
-)
-
-type ELobbyComparison int32
-
-const (
-	ELobbyComparison_EqualToOrLessThan    ELobbyComparison = -2
-	ELobbyComparison_LessThan             ELobbyComparison = -1
-	ELobbyComparison_Equal                ELobbyComparison = 0
-	ELobbyComparison_GreaterThan          ELobbyComparison = 1
-	ELobbyComparison_EqualToOrGreaterThan ELobbyComparison = 2
-	ELobbyComparison_NotEqual             ELobbyComparison = 3
-)
-
-type ELobbyDistanceFilter int32
-
-const (
-	ELobbyDistanceFilter_Close     ELobbyDistanceFilter = 0
-	ELobbyDistanceFilter_Default   ELobbyDistanceFilter = 1
-	ELobbyDistanceFilter_Far       ELobbyDistanceFilter = 2
-	ELobbyDistanceFilter_Worldwide ELobbyDistanceFilter = 3
-)
-
-type EChatMemberStateChange int32
-
-const (
-	EChatMemberStateChange_Entered      EChatMemberStateChange = 1
-	EChatMemberStateChange_Left         EChatMemberStateChange = 2
-	EChatMemberStateChange_Disconnected EChatMemberStateChange = 4
-	EChatMemberStateChange_Kicked       EChatMemberStateChange = 8
-	EChatMemberStateChange_Banned       EChatMemberStateChange = 16
-)
-
-type ERemoteStoragePlatform int32
-
-const (
-	ERemoteStoragePlatform_None      ERemoteStoragePlatform = 0
-	ERemoteStoragePlatform_Windows   ERemoteStoragePlatform = 1
-	ERemoteStoragePlatform_OSX       ERemoteStoragePlatform = 2
-	ERemoteStoragePlatform_PS3       ERemoteStoragePlatform = 4
-	ERemoteStoragePlatform_Linux     ERemoteStoragePlatform = 8
-	ERemoteStoragePlatform_Reserved2 ERemoteStoragePlatform = 16
-	ERemoteStoragePlatform_All       ERemoteStoragePlatform = -1
-)
-
-type ERemoteStoragePublishedFileVisibility int32
-
-const (
-	ERemoteStoragePublishedFileVisibility_Public      ERemoteStoragePublishedFileVisibility = 0
-	ERemoteStoragePublishedFileVisibility_FriendsOnly ERemoteStoragePublishedFileVisibility = 1
-	ERemoteStoragePublishedFileVisibility_Private     ERemoteStoragePublishedFileVisibility = 2
-)
-
-type EWorkshopFileType int32
-
-const (
-	EWorkshopFileType_First                  EWorkshopFileType = 0
-	EWorkshopFileType_Community              EWorkshopFileType = 0
-	EWorkshopFileType_Microtransaction       EWorkshopFileType = 1
-	EWorkshopFileType_Collection             EWorkshopFileType = 2
-	EWorkshopFileType_Art                    EWorkshopFileType = 3
-	EWorkshopFileType_Video                  EWorkshopFileType = 4
-	EWorkshopFileType_Screenshot             EWorkshopFileType = 5
-	EWorkshopFileType_Game                   EWorkshopFileType = 6
-	EWorkshopFileType_Software               EWorkshopFileType = 7
-	EWorkshopFileType_Concept                EWorkshopFileType = 8
-	EWorkshopFileType_WebGuide               EWorkshopFileType = 9
-	EWorkshopFileType_IntegratedGuide        EWorkshopFileType = 10
-	EWorkshopFileType_Merch                  EWorkshopFileType = 11
-	EWorkshopFileType_ControllerBinding      EWorkshopFileType = 12
-	EWorkshopFileType_SteamworksAccessInvite EWorkshopFileType = 13
-	EWorkshopFileType_SteamVideo             EWorkshopFileType = 14
-	EWorkshopFileType_GameManagedItem        EWorkshopFileType = 15
-	EWorkshopFileType_Max                    EWorkshopFileType = 16
-)
-
-type EWorkshopVote int32
-
-const (
-	EWorkshopVote_Unvoted EWorkshopVote = 0
-	EWorkshopVote_For     EWorkshopVote = 1
-	EWorkshopVote_Against EWorkshopVote = 2
-	EWorkshopVote_Later   EWorkshopVote = 3
-)
-
-type EWorkshopFileAction int32
-
-const (
-	EWorkshopFileAction_Played    EWorkshopFileAction = 0
-	EWorkshopFileAction_Completed EWorkshopFileAction = 1
-)
-
-type EWorkshopEnumerationType int32
-
-const (
-	EWorkshopEnumerationType_RankedByVote            EWorkshopEnumerationType = 0
-	EWorkshopEnumerationType_Recent                  EWorkshopEnumerationType = 1
-	EWorkshopEnumerationType_Trending                EWorkshopEnumerationType = 2
-	EWorkshopEnumerationType_FavoritesOfFriends      EWorkshopEnumerationType = 3
-	EWorkshopEnumerationType_VotedByFriends          EWorkshopEnumerationType = 4
-	EWorkshopEnumerationType_ContentByFriends        EWorkshopEnumerationType = 5
-	EWorkshopEnumerationType_RecentFromFollowedUsers EWorkshopEnumerationType = 6
-)
-
-type EWorkshopVideoProvider int32
-
-const (
-	EWorkshopVideoProvider_None    EWorkshopVideoProvider = 0
-	EWorkshopVideoProvider_Youtube EWorkshopVideoProvider = 1
-)
-
-type EUGCReadAction int32
-
-const (
-	EUGCReadAction_EUGCRead_ContinueReadingUntilFinished EUGCReadAction = 0
-	EUGCReadAction_EUGCRead_ContinueReading              EUGCReadAction = 1
-	EUGCReadAction_EUGCRead_Close                        EUGCReadAction = 2
-)
-
-type ELeaderboardDataRequest int32
-
-const (
-	ELeaderboardDataRequest_Global           ELeaderboardDataRequest = 0
-	ELeaderboardDataRequest_GlobalAroundUser ELeaderboardDataRequest = 1
-	ELeaderboardDataRequest_Friends          ELeaderboardDataRequest = 2
-	ELeaderboardDataRequest_Users            ELeaderboardDataRequest = 3
-)
-
-type ELeaderboardSortMethod int32
-
-const (
-	ELeaderboardSortMethod_None       ELeaderboardSortMethod = 0
-	ELeaderboardSortMethod_Ascending  ELeaderboardSortMethod = 1
-	ELeaderboardSortMethod_Descending ELeaderboardSortMethod = 2
-)
-
-type ELeaderboardDisplayType int32
-
-const (
-	ELeaderboardDisplayType_None             ELeaderboardDisplayType = 0
-	ELeaderboardDisplayType_Numeric          ELeaderboardDisplayType = 1
-	ELeaderboardDisplayType_TimeSeconds      ELeaderboardDisplayType = 2
-	ELeaderboardDisplayType_TimeMilliSeconds ELeaderboardDisplayType = 3
-)
-
-type ELeaderboardUploadScoreMethod int32
-
-const (
-	ELeaderboardUploadScoreMethod_None        ELeaderboardUploadScoreMethod = 0
-	ELeaderboardUploadScoreMethod_KeepBest    ELeaderboardUploadScoreMethod = 1
-	ELeaderboardUploadScoreMethod_ForceUpdate ELeaderboardUploadScoreMethod = 2
-)
-
-type ERegisterActivationCodeResult int32
-
-const (
-	ERegisterActivationCodeResult_OK                                  ERegisterActivationCodeResult = 0
-	ERegisterActivationCodeResult_Fail                                ERegisterActivationCodeResult = 1
-	ERegisterActivationCodeResult_AlreadyRegistered                   ERegisterActivationCodeResult = 2
-	ERegisterActivationCodeResult_Timeout                             ERegisterActivationCodeResult = 3
-	ERegisterActivationCodeResult_ERegisterActivationCodeAlreadyOwned ERegisterActivationCodeResult = 4
-)
-
-type EP2PSessionError int32
-
-const (
-	EP2PSessionError_None                   EP2PSessionError = 0
-	EP2PSessionError_NotRunningApp          EP2PSessionError = 1
-	EP2PSessionError_NoRightsToApp          EP2PSessionError = 2
-	EP2PSessionError_DestinationNotLoggedIn EP2PSessionError = 3
-	EP2PSessionError_Timeout                EP2PSessionError = 4
-	EP2PSessionError_Max                    EP2PSessionError = 5
-)
-
-type EP2PSend int32
-
-const (
-	EP2PSend_Unreliable            EP2PSend = 0
-	EP2PSend_UnreliableNoDelay     EP2PSend = 1
-	EP2PSend_Reliable              EP2PSend = 2
-	EP2PSend_ReliableWithBuffering EP2PSend = 3
-)
-
-type ESNetSocketState int32
-
-const (
-	ESNetSocketState_Invalid                  ESNetSocketState = 0
-	ESNetSocketState_Connected                ESNetSocketState = 1
-	ESNetSocketState_Initiated                ESNetSocketState = 10
-	ESNetSocketState_LocalCandidatesFound     ESNetSocketState = 11
-	ESNetSocketState_ReceivedRemoteCandidates ESNetSocketState = 12
-	ESNetSocketState_ChallengeHandshake       ESNetSocketState = 15
-	ESNetSocketState_Disconnecting            ESNetSocketState = 21
-	ESNetSocketState_LocalDisconnect          ESNetSocketState = 22
-	ESNetSocketState_TimeoutDuringConnect     ESNetSocketState = 23
-	ESNetSocketState_RemoteEndDisconnected    ESNetSocketState = 24
-	ESNetSocketState_ConnectionBroken         ESNetSocketState = 25
-)
-
-type ESNetSocketConnectionType int32
-
-const (
-	ESNetSocketConnectionType_NotConnected ESNetSocketConnectionType = 0
-	ESNetSocketConnectionType_UDP          ESNetSocketConnectionType = 1
-	ESNetSocketConnectionType_UDPRelay     ESNetSocketConnectionType = 2
-)
-
-type EVRScreenshotType int32
-
-const (
-	EVRScreenshotType_None           EVRScreenshotType = 0
-	EVRScreenshotType_Mono           EVRScreenshotType = 1
-	EVRScreenshotType_Stereo         EVRScreenshotType = 2
-	EVRScreenshotType_MonoCubemap    EVRScreenshotType = 3
-	EVRScreenshotType_MonoPanorama   EVRScreenshotType = 4
-	EVRScreenshotType_StereoPanorama EVRScreenshotType = 5
-)
-
-type EAudioPlayback int32
-
-const (
-	EAudioPlayback_Undefined EAudioPlayback = 0
-	EAudioPlayback_Playing   EAudioPlayback = 1
-	EAudioPlayback_Paused    EAudioPlayback = 2
-	EAudioPlayback_Idle      EAudioPlayback = 3
-)
-
-type EHTTPMethod int32
-
-const (
-	EHTTPMethod_Invalid EHTTPMethod = 0
-	EHTTPMethod_GET     EHTTPMethod = 1
-	EHTTPMethod_HEAD    EHTTPMethod = 2
-	EHTTPMethod_POST    EHTTPMethod = 3
-	EHTTPMethod_PUT     EHTTPMethod = 4
-	EHTTPMethod_DELETE  EHTTPMethod = 5
-	EHTTPMethod_OPTIONS EHTTPMethod = 6
-	EHTTPMethod_PATCH   EHTTPMethod = 7
-)
-
-type EHTTPStatusCode int32
-
-const (
-	EHTTPStatusCode_Invalid                         EHTTPStatusCode = 0
-	EHTTPStatusCode_100Continue                     EHTTPStatusCode = 100
-	EHTTPStatusCode_101SwitchingProtocols           EHTTPStatusCode = 101
-	EHTTPStatusCode_200OK                           EHTTPStatusCode = 200
-	EHTTPStatusCode_201Created                      EHTTPStatusCode = 201
-	EHTTPStatusCode_202Accepted                     EHTTPStatusCode = 202
-	EHTTPStatusCode_203NonAuthoritative             EHTTPStatusCode = 203
-	EHTTPStatusCode_204NoContent                    EHTTPStatusCode = 204
-	EHTTPStatusCode_205ResetContent                 EHTTPStatusCode = 205
-	EHTTPStatusCode_206PartialContent               EHTTPStatusCode = 206
-	EHTTPStatusCode_300MultipleChoices              EHTTPStatusCode = 300
-	EHTTPStatusCode_301MovedPermanently             EHTTPStatusCode = 301
-	EHTTPStatusCode_302Found                        EHTTPStatusCode = 302
-	EHTTPStatusCode_303SeeOther                     EHTTPStatusCode = 303
-	EHTTPStatusCode_304NotModified                  EHTTPStatusCode = 304
-	EHTTPStatusCode_305UseProxy                     EHTTPStatusCode = 305
-	EHTTPStatusCode_307TemporaryRedirect            EHTTPStatusCode = 307
-	EHTTPStatusCode_400BadRequest                   EHTTPStatusCode = 400
-	EHTTPStatusCode_401Unauthorized                 EHTTPStatusCode = 401
-	EHTTPStatusCode_402PaymentRequired              EHTTPStatusCode = 402
-	EHTTPStatusCode_403Forbidden                    EHTTPStatusCode = 403
-	EHTTPStatusCode_404NotFound                     EHTTPStatusCode = 404
-	EHTTPStatusCode_405MethodNotAllowed             EHTTPStatusCode = 405
-	EHTTPStatusCode_406NotAcceptable                EHTTPStatusCode = 406
-	EHTTPStatusCode_407ProxyAuthRequired            EHTTPStatusCode = 407
-	EHTTPStatusCode_408RequestTimeout               EHTTPStatusCode = 408
-	EHTTPStatusCode_409Conflict                     EHTTPStatusCode = 409
-	EHTTPStatusCode_410Gone                         EHTTPStatusCode = 410
-	EHTTPStatusCode_411LengthRequired               EHTTPStatusCode = 411
-	EHTTPStatusCode_412PreconditionFailed           EHTTPStatusCode = 412
-	EHTTPStatusCode_413RequestEntityTooLarge        EHTTPStatusCode = 413
-	EHTTPStatusCode_414RequestURITooLong            EHTTPStatusCode = 414
-	EHTTPStatusCode_415UnsupportedMediaType         EHTTPStatusCode = 415
-	EHTTPStatusCode_416RequestedRangeNotSatisfiable EHTTPStatusCode = 416
-	EHTTPStatusCode_417ExpectationFailed            EHTTPStatusCode = 417
-	EHTTPStatusCode_4xxUnknown                      EHTTPStatusCode = 418
-	EHTTPStatusCode_429TooManyRequests              EHTTPStatusCode = 429
-	EHTTPStatusCode_500InternalServerError          EHTTPStatusCode = 500
-	EHTTPStatusCode_501NotImplemented               EHTTPStatusCode = 501
-	EHTTPStatusCode_502BadGateway                   EHTTPStatusCode = 502
-	EHTTPStatusCode_503ServiceUnavailable           EHTTPStatusCode = 503
-	EHTTPStatusCode_504GatewayTimeout               EHTTPStatusCode = 504
-	EHTTPStatusCode_505HTTPVersionNotSupported      EHTTPStatusCode = 505
-	EHTTPStatusCode_5xxUnknown                      EHTTPStatusCode = 599
-)
-
-type ESteamControllerPad int32
-
-const (
-	ESteamControllerPad_Left  ESteamControllerPad = 0
-	ESteamControllerPad_Right ESteamControllerPad = 1
-)
-
-type EControllerSource int32
-
-const (
-	EControllerSource_None           EControllerSource = 0
-	EControllerSource_LeftTrackpad   EControllerSource = 1
-	EControllerSource_RightTrackpad  EControllerSource = 2
-	EControllerSource_Joystick       EControllerSource = 3
-	EControllerSource_ABXY           EControllerSource = 4
-	EControllerSource_Switch         EControllerSource = 5
-	EControllerSource_LeftTrigger    EControllerSource = 6
-	EControllerSource_RightTrigger   EControllerSource = 7
-	EControllerSource_Gyro           EControllerSource = 8
-	EControllerSource_CenterTrackpad EControllerSource = 9
-	EControllerSource_RightJoystick  EControllerSource = 10
-	EControllerSource_DPad           EControllerSource = 11
-	EControllerSource_Key            EControllerSource = 12
-	EControllerSource_Mouse          EControllerSource = 13
-	EControllerSource_Count          EControllerSource = 14
-)
-
-type EControllerSourceMode int32
-
-const (
-	EControllerSourceMode_None           EControllerSourceMode = 0
-	EControllerSourceMode_Dpad           EControllerSourceMode = 1
-	EControllerSourceMode_Buttons        EControllerSourceMode = 2
-	EControllerSourceMode_FourButtons    EControllerSourceMode = 3
-	EControllerSourceMode_AbsoluteMouse  EControllerSourceMode = 4
-	EControllerSourceMode_RelativeMouse  EControllerSourceMode = 5
-	EControllerSourceMode_JoystickMove   EControllerSourceMode = 6
-	EControllerSourceMode_JoystickMouse  EControllerSourceMode = 7
-	EControllerSourceMode_JoystickCamera EControllerSourceMode = 8
-	EControllerSourceMode_ScrollWheel    EControllerSourceMode = 9
-	EControllerSourceMode_Trigger        EControllerSourceMode = 10
-	EControllerSourceMode_TouchMenu      EControllerSourceMode = 11
-	EControllerSourceMode_MouseJoystick  EControllerSourceMode = 12
-	EControllerSourceMode_MouseRegion    EControllerSourceMode = 13
-	EControllerSourceMode_RadialMenu     EControllerSourceMode = 14
-	EControllerSourceMode_SingleButton   EControllerSourceMode = 15
-	EControllerSourceMode_Switches       EControllerSourceMode = 16
-)
-
-type EControllerActionOrigin int32
-
-const (
-	EControllerActionOrigin_None                             EControllerActionOrigin = 0
-	EControllerActionOrigin_A                                EControllerActionOrigin = 1
-	EControllerActionOrigin_B                                EControllerActionOrigin = 2
-	EControllerActionOrigin_X                                EControllerActionOrigin = 3
-	EControllerActionOrigin_Y                                EControllerActionOrigin = 4
-	EControllerActionOrigin_LeftBumper                       EControllerActionOrigin = 5
-	EControllerActionOrigin_RightBumper                      EControllerActionOrigin = 6
-	EControllerActionOrigin_LeftGrip                         EControllerActionOrigin = 7
-	EControllerActionOrigin_RightGrip                        EControllerActionOrigin = 8
-	EControllerActionOrigin_Start                            EControllerActionOrigin = 9
-	EControllerActionOrigin_Back                             EControllerActionOrigin = 10
-	EControllerActionOrigin_LeftPad_Touch                    EControllerActionOrigin = 11
-	EControllerActionOrigin_LeftPad_Swipe                    EControllerActionOrigin = 12
-	EControllerActionOrigin_LeftPad_Click                    EControllerActionOrigin = 13
-	EControllerActionOrigin_LeftPad_DPadNorth                EControllerActionOrigin = 14
-	EControllerActionOrigin_LeftPad_DPadSouth                EControllerActionOrigin = 15
-	EControllerActionOrigin_LeftPad_DPadWest                 EControllerActionOrigin = 16
-	EControllerActionOrigin_LeftPad_DPadEast                 EControllerActionOrigin = 17
-	EControllerActionOrigin_RightPad_Touch                   EControllerActionOrigin = 18
-	EControllerActionOrigin_RightPad_Swipe                   EControllerActionOrigin = 19
-	EControllerActionOrigin_RightPad_Click                   EControllerActionOrigin = 20
-	EControllerActionOrigin_RightPad_DPadNorth               EControllerActionOrigin = 21
-	EControllerActionOrigin_RightPad_DPadSouth               EControllerActionOrigin = 22
-	EControllerActionOrigin_RightPad_DPadWest                EControllerActionOrigin = 23
-	EControllerActionOrigin_RightPad_DPadEast                EControllerActionOrigin = 24
-	EControllerActionOrigin_LeftTrigger_Pull                 EControllerActionOrigin = 25
-	EControllerActionOrigin_LeftTrigger_Click                EControllerActionOrigin = 26
-	EControllerActionOrigin_RightTrigger_Pull                EControllerActionOrigin = 27
-	EControllerActionOrigin_RightTrigger_Click               EControllerActionOrigin = 28
-	EControllerActionOrigin_LeftStick_Move                   EControllerActionOrigin = 29
-	EControllerActionOrigin_LeftStick_Click                  EControllerActionOrigin = 30
-	EControllerActionOrigin_LeftStick_DPadNorth              EControllerActionOrigin = 31
-	EControllerActionOrigin_LeftStick_DPadSouth              EControllerActionOrigin = 32
-	EControllerActionOrigin_LeftStick_DPadWest               EControllerActionOrigin = 33
-	EControllerActionOrigin_LeftStick_DPadEast               EControllerActionOrigin = 34
-	EControllerActionOrigin_Gyro_Move                        EControllerActionOrigin = 35
-	EControllerActionOrigin_Gyro_Pitch                       EControllerActionOrigin = 36
-	EControllerActionOrigin_Gyro_Yaw                         EControllerActionOrigin = 37
-	EControllerActionOrigin_Gyro_Roll                        EControllerActionOrigin = 38
-	EControllerActionOrigin_PS4_X                            EControllerActionOrigin = 39
-	EControllerActionOrigin_PS4_Circle                       EControllerActionOrigin = 40
-	EControllerActionOrigin_PS4_Triangle                     EControllerActionOrigin = 41
-	EControllerActionOrigin_PS4_Square                       EControllerActionOrigin = 42
-	EControllerActionOrigin_PS4_LeftBumper                   EControllerActionOrigin = 43
-	EControllerActionOrigin_PS4_RightBumper                  EControllerActionOrigin = 44
-	EControllerActionOrigin_PS4_Options                      EControllerActionOrigin = 45
-	EControllerActionOrigin_PS4_Share                        EControllerActionOrigin = 46
-	EControllerActionOrigin_PS4_LeftPad_Touch                EControllerActionOrigin = 47
-	EControllerActionOrigin_PS4_LeftPad_Swipe                EControllerActionOrigin = 48
-	EControllerActionOrigin_PS4_LeftPad_Click                EControllerActionOrigin = 49
-	EControllerActionOrigin_PS4_LeftPad_DPadNorth            EControllerActionOrigin = 50
-	EControllerActionOrigin_PS4_LeftPad_DPadSouth            EControllerActionOrigin = 51
-	EControllerActionOrigin_PS4_LeftPad_DPadWest             EControllerActionOrigin = 52
-	EControllerActionOrigin_PS4_LeftPad_DPadEast             EControllerActionOrigin = 53
-	EControllerActionOrigin_PS4_RightPad_Touch               EControllerActionOrigin = 54
-	EControllerActionOrigin_PS4_RightPad_Swipe               EControllerActionOrigin = 55
-	EControllerActionOrigin_PS4_RightPad_Click               EControllerActionOrigin = 56
-	EControllerActionOrigin_PS4_RightPad_DPadNorth           EControllerActionOrigin = 57
-	EControllerActionOrigin_PS4_RightPad_DPadSouth           EControllerActionOrigin = 58
-	EControllerActionOrigin_PS4_RightPad_DPadWest            EControllerActionOrigin = 59
-	EControllerActionOrigin_PS4_RightPad_DPadEast            EControllerActionOrigin = 60
-	EControllerActionOrigin_PS4_CenterPad_Touch              EControllerActionOrigin = 61
-	EControllerActionOrigin_PS4_CenterPad_Swipe              EControllerActionOrigin = 62
-	EControllerActionOrigin_PS4_CenterPad_Click              EControllerActionOrigin = 63
-	EControllerActionOrigin_PS4_CenterPad_DPadNorth          EControllerActionOrigin = 64
-	EControllerActionOrigin_PS4_CenterPad_DPadSouth          EControllerActionOrigin = 65
-	EControllerActionOrigin_PS4_CenterPad_DPadWest           EControllerActionOrigin = 66
-	EControllerActionOrigin_PS4_CenterPad_DPadEast           EControllerActionOrigin = 67
-	EControllerActionOrigin_PS4_LeftTrigger_Pull             EControllerActionOrigin = 68
-	EControllerActionOrigin_PS4_LeftTrigger_Click            EControllerActionOrigin = 69
-	EControllerActionOrigin_PS4_RightTrigger_Pull            EControllerActionOrigin = 70
-	EControllerActionOrigin_PS4_RightTrigger_Click           EControllerActionOrigin = 71
-	EControllerActionOrigin_PS4_LeftStick_Move               EControllerActionOrigin = 72
-	EControllerActionOrigin_PS4_LeftStick_Click              EControllerActionOrigin = 73
-	EControllerActionOrigin_PS4_LeftStick_DPadNorth          EControllerActionOrigin = 74
-	EControllerActionOrigin_PS4_LeftStick_DPadSouth          EControllerActionOrigin = 75
-	EControllerActionOrigin_PS4_LeftStick_DPadWest           EControllerActionOrigin = 76
-	EControllerActionOrigin_PS4_LeftStick_DPadEast           EControllerActionOrigin = 77
-	EControllerActionOrigin_PS4_RightStick_Move              EControllerActionOrigin = 78
-	EControllerActionOrigin_PS4_RightStick_Click             EControllerActionOrigin = 79
-	EControllerActionOrigin_PS4_RightStick_DPadNorth         EControllerActionOrigin = 80
-	EControllerActionOrigin_PS4_RightStick_DPadSouth         EControllerActionOrigin = 81
-	EControllerActionOrigin_PS4_RightStick_DPadWest          EControllerActionOrigin = 82
-	EControllerActionOrigin_PS4_RightStick_DPadEast          EControllerActionOrigin = 83
-	EControllerActionOrigin_PS4_DPad_North                   EControllerActionOrigin = 84
-	EControllerActionOrigin_PS4_DPad_South                   EControllerActionOrigin = 85
-	EControllerActionOrigin_PS4_DPad_West                    EControllerActionOrigin = 86
-	EControllerActionOrigin_PS4_DPad_East                    EControllerActionOrigin = 87
-	EControllerActionOrigin_PS4_Gyro_Move                    EControllerActionOrigin = 88
-	EControllerActionOrigin_PS4_Gyro_Pitch                   EControllerActionOrigin = 89
-	EControllerActionOrigin_PS4_Gyro_Yaw                     EControllerActionOrigin = 90
-	EControllerActionOrigin_PS4_Gyro_Roll                    EControllerActionOrigin = 91
-	EControllerActionOrigin_XBoxOne_A                        EControllerActionOrigin = 92
-	EControllerActionOrigin_XBoxOne_B                        EControllerActionOrigin = 93
-	EControllerActionOrigin_XBoxOne_X                        EControllerActionOrigin = 94
-	EControllerActionOrigin_XBoxOne_Y                        EControllerActionOrigin = 95
-	EControllerActionOrigin_XBoxOne_LeftBumper               EControllerActionOrigin = 96
-	EControllerActionOrigin_XBoxOne_RightBumper              EControllerActionOrigin = 97
-	EControllerActionOrigin_XBoxOne_Menu                     EControllerActionOrigin = 98
-	EControllerActionOrigin_XBoxOne_View                     EControllerActionOrigin = 99
-	EControllerActionOrigin_XBoxOne_LeftTrigger_Pull         EControllerActionOrigin = 100
-	EControllerActionOrigin_XBoxOne_LeftTrigger_Click        EControllerActionOrigin = 101
-	EControllerActionOrigin_XBoxOne_RightTrigger_Pull        EControllerActionOrigin = 102
-	EControllerActionOrigin_XBoxOne_RightTrigger_Click       EControllerActionOrigin = 103
-	EControllerActionOrigin_XBoxOne_LeftStick_Move           EControllerActionOrigin = 104
-	EControllerActionOrigin_XBoxOne_LeftStick_Click          EControllerActionOrigin = 105
-	EControllerActionOrigin_XBoxOne_LeftStick_DPadNorth      EControllerActionOrigin = 106
-	EControllerActionOrigin_XBoxOne_LeftStick_DPadSouth      EControllerActionOrigin = 107
-	EControllerActionOrigin_XBoxOne_LeftStick_DPadWest       EControllerActionOrigin = 108
-	EControllerActionOrigin_XBoxOne_LeftStick_DPadEast       EControllerActionOrigin = 109
-	EControllerActionOrigin_XBoxOne_RightStick_Move          EControllerActionOrigin = 110
-	EControllerActionOrigin_XBoxOne_RightStick_Click         EControllerActionOrigin = 111
-	EControllerActionOrigin_XBoxOne_RightStick_DPadNorth     EControllerActionOrigin = 112
-	EControllerActionOrigin_XBoxOne_RightStick_DPadSouth     EControllerActionOrigin = 113
-	EControllerActionOrigin_XBoxOne_RightStick_DPadWest      EControllerActionOrigin = 114
-	EControllerActionOrigin_XBoxOne_RightStick_DPadEast      EControllerActionOrigin = 115
-	EControllerActionOrigin_XBoxOne_DPad_North               EControllerActionOrigin = 116
-	EControllerActionOrigin_XBoxOne_DPad_South               EControllerActionOrigin = 117
-	EControllerActionOrigin_XBoxOne_DPad_West                EControllerActionOrigin = 118
-	EControllerActionOrigin_XBoxOne_DPad_East                EControllerActionOrigin = 119
-	EControllerActionOrigin_XBox360_A                        EControllerActionOrigin = 120
-	EControllerActionOrigin_XBox360_B                        EControllerActionOrigin = 121
-	EControllerActionOrigin_XBox360_X                        EControllerActionOrigin = 122
-	EControllerActionOrigin_XBox360_Y                        EControllerActionOrigin = 123
-	EControllerActionOrigin_XBox360_LeftBumper               EControllerActionOrigin = 124
-	EControllerActionOrigin_XBox360_RightBumper              EControllerActionOrigin = 125
-	EControllerActionOrigin_XBox360_Start                    EControllerActionOrigin = 126
-	EControllerActionOrigin_XBox360_Back                     EControllerActionOrigin = 127
-	EControllerActionOrigin_XBox360_LeftTrigger_Pull         EControllerActionOrigin = 128
-	EControllerActionOrigin_XBox360_LeftTrigger_Click        EControllerActionOrigin = 129
-	EControllerActionOrigin_XBox360_RightTrigger_Pull        EControllerActionOrigin = 130
-	EControllerActionOrigin_XBox360_RightTrigger_Click       EControllerActionOrigin = 131
-	EControllerActionOrigin_XBox360_LeftStick_Move           EControllerActionOrigin = 132
-	EControllerActionOrigin_XBox360_LeftStick_Click          EControllerActionOrigin = 133
-	EControllerActionOrigin_XBox360_LeftStick_DPadNorth      EControllerActionOrigin = 134
-	EControllerActionOrigin_XBox360_LeftStick_DPadSouth      EControllerActionOrigin = 135
-	EControllerActionOrigin_XBox360_LeftStick_DPadWest       EControllerActionOrigin = 136
-	EControllerActionOrigin_XBox360_LeftStick_DPadEast       EControllerActionOrigin = 137
-	EControllerActionOrigin_XBox360_RightStick_Move          EControllerActionOrigin = 138
-	EControllerActionOrigin_XBox360_RightStick_Click         EControllerActionOrigin = 139
-	EControllerActionOrigin_XBox360_RightStick_DPadNorth     EControllerActionOrigin = 140
-	EControllerActionOrigin_XBox360_RightStick_DPadSouth     EControllerActionOrigin = 141
-	EControllerActionOrigin_XBox360_RightStick_DPadWest      EControllerActionOrigin = 142
-	EControllerActionOrigin_XBox360_RightStick_DPadEast      EControllerActionOrigin = 143
-	EControllerActionOrigin_XBox360_DPad_North               EControllerActionOrigin = 144
-	EControllerActionOrigin_XBox360_DPad_South               EControllerActionOrigin = 145
-	EControllerActionOrigin_XBox360_DPad_West                EControllerActionOrigin = 146
-	EControllerActionOrigin_XBox360_DPad_East                EControllerActionOrigin = 147
-	EControllerActionOrigin_SteamV2_A                        EControllerActionOrigin = 148
-	EControllerActionOrigin_SteamV2_B                        EControllerActionOrigin = 149
-	EControllerActionOrigin_SteamV2_X                        EControllerActionOrigin = 150
-	EControllerActionOrigin_SteamV2_Y                        EControllerActionOrigin = 151
-	EControllerActionOrigin_SteamV2_LeftBumper               EControllerActionOrigin = 152
-	EControllerActionOrigin_SteamV2_RightBumper              EControllerActionOrigin = 153
-	EControllerActionOrigin_SteamV2_LeftGrip                 EControllerActionOrigin = 154
-	EControllerActionOrigin_SteamV2_RightGrip                EControllerActionOrigin = 155
-	EControllerActionOrigin_SteamV2_LeftGrip_Upper           EControllerActionOrigin = 156
-	EControllerActionOrigin_SteamV2_RightGrip_Upper          EControllerActionOrigin = 157
-	EControllerActionOrigin_SteamV2_LeftBumper_Pressure      EControllerActionOrigin = 158
-	EControllerActionOrigin_SteamV2_RightBumper_Pressure     EControllerActionOrigin = 159
-	EControllerActionOrigin_SteamV2_LeftGrip_Pressure        EControllerActionOrigin = 160
-	EControllerActionOrigin_SteamV2_RightGrip_Pressure       EControllerActionOrigin = 161
-	EControllerActionOrigin_SteamV2_LeftGrip_Upper_Pressure  EControllerActionOrigin = 162
-	EControllerActionOrigin_SteamV2_RightGrip_Upper_Pressure EControllerActionOrigin = 163
-	EControllerActionOrigin_SteamV2_Start                    EControllerActionOrigin = 164
-	EControllerActionOrigin_SteamV2_Back                     EControllerActionOrigin = 165
-	EControllerActionOrigin_SteamV2_LeftPad_Touch            EControllerActionOrigin = 166
-	EControllerActionOrigin_SteamV2_LeftPad_Swipe            EControllerActionOrigin = 167
-	EControllerActionOrigin_SteamV2_LeftPad_Click            EControllerActionOrigin = 168
-	EControllerActionOrigin_SteamV2_LeftPad_Pressure         EControllerActionOrigin = 169
-	EControllerActionOrigin_SteamV2_LeftPad_DPadNorth        EControllerActionOrigin = 170
-	EControllerActionOrigin_SteamV2_LeftPad_DPadSouth        EControllerActionOrigin = 171
-	EControllerActionOrigin_SteamV2_LeftPad_DPadWest         EControllerActionOrigin = 172
-	EControllerActionOrigin_SteamV2_LeftPad_DPadEast         EControllerActionOrigin = 173
-	EControllerActionOrigin_SteamV2_RightPad_Touch           EControllerActionOrigin = 174
-	EControllerActionOrigin_SteamV2_RightPad_Swipe           EControllerActionOrigin = 175
-	EControllerActionOrigin_SteamV2_RightPad_Click           EControllerActionOrigin = 176
-	EControllerActionOrigin_SteamV2_RightPad_Pressure        EControllerActionOrigin = 177
-	EControllerActionOrigin_SteamV2_RightPad_DPadNorth       EControllerActionOrigin = 178
-	EControllerActionOrigin_SteamV2_RightPad_DPadSouth       EControllerActionOrigin = 179
-	EControllerActionOrigin_SteamV2_RightPad_DPadWest        EControllerActionOrigin = 180
-	EControllerActionOrigin_SteamV2_RightPad_DPadEast        EControllerActionOrigin = 181
-	EControllerActionOrigin_SteamV2_LeftTrigger_Pull         EControllerActionOrigin = 182
-	EControllerActionOrigin_SteamV2_LeftTrigger_Click        EControllerActionOrigin = 183
-	EControllerActionOrigin_SteamV2_RightTrigger_Pull        EControllerActionOrigin = 184
-	EControllerActionOrigin_SteamV2_RightTrigger_Click       EControllerActionOrigin = 185
-	EControllerActionOrigin_SteamV2_LeftStick_Move           EControllerActionOrigin = 186
-	EControllerActionOrigin_SteamV2_LeftStick_Click          EControllerActionOrigin = 187
-	EControllerActionOrigin_SteamV2_LeftStick_DPadNorth      EControllerActionOrigin = 188
-	EControllerActionOrigin_SteamV2_LeftStick_DPadSouth      EControllerActionOrigin = 189
-	EControllerActionOrigin_SteamV2_LeftStick_DPadWest       EControllerActionOrigin = 190
-	EControllerActionOrigin_SteamV2_LeftStick_DPadEast       EControllerActionOrigin = 191
-	EControllerActionOrigin_SteamV2_Gyro_Move                EControllerActionOrigin = 192
-	EControllerActionOrigin_SteamV2_Gyro_Pitch               EControllerActionOrigin = 193
-	EControllerActionOrigin_SteamV2_Gyro_Yaw                 EControllerActionOrigin = 194
-	EControllerActionOrigin_SteamV2_Gyro_Roll                EControllerActionOrigin = 195
-	EControllerActionOrigin_Count                            EControllerActionOrigin = 196
-)
-
-type ESteamControllerLEDFlag int32
-
-const (
-	ESteamControllerLEDFlag_SetColor           ESteamControllerLEDFlag = 0
-	ESteamControllerLEDFlag_RestoreUserDefault ESteamControllerLEDFlag = 1
-)
-
-type ESteamInputType int32
-
-const (
-	ESteamInputType_Unknown           ESteamInputType = 0
-	ESteamInputType_SteamController   ESteamInputType = 1
-	ESteamInputType_XBox360Controller ESteamInputType = 2
-	ESteamInputType_XBoxOneController ESteamInputType = 3
-	ESteamInputType_GenericXInput     ESteamInputType = 4
-	ESteamInputType_PS4Controller     ESteamInputType = 5
-)
-
-type EUGCMatchingUGCType int32
-
-const (
-	EUGCMatchingUGCType_Items              EUGCMatchingUGCType = 0
-	EUGCMatchingUGCType_Items_Mtx          EUGCMatchingUGCType = 1
-	EUGCMatchingUGCType_Items_ReadyToUse   EUGCMatchingUGCType = 2
-	EUGCMatchingUGCType_Collections        EUGCMatchingUGCType = 3
-	EUGCMatchingUGCType_Artwork            EUGCMatchingUGCType = 4
-	EUGCMatchingUGCType_Videos             EUGCMatchingUGCType = 5
-	EUGCMatchingUGCType_Screenshots        EUGCMatchingUGCType = 6
-	EUGCMatchingUGCType_AllGuides          EUGCMatchingUGCType = 7
-	EUGCMatchingUGCType_WebGuides          EUGCMatchingUGCType = 8
-	EUGCMatchingUGCType_IntegratedGuides   EUGCMatchingUGCType = 9
-	EUGCMatchingUGCType_UsableInGame       EUGCMatchingUGCType = 10
-	EUGCMatchingUGCType_ControllerBindings EUGCMatchingUGCType = 11
-	EUGCMatchingUGCType_GameManagedItems   EUGCMatchingUGCType = 12
-	EUGCMatchingUGCType_All                EUGCMatchingUGCType = -1
-)
-
-type EUserUGCList int32
-
-const (
-	EUserUGCList_Published     EUserUGCList = 0
-	EUserUGCList_VotedOn       EUserUGCList = 1
-	EUserUGCList_VotedUp       EUserUGCList = 2
-	EUserUGCList_VotedDown     EUserUGCList = 3
-	EUserUGCList_WillVoteLater EUserUGCList = 4
-	EUserUGCList_Favorited     EUserUGCList = 5
-	EUserUGCList_Subscribed    EUserUGCList = 6
-	EUserUGCList_UsedOrPlayed  EUserUGCList = 7
-	EUserUGCList_Followed      EUserUGCList = 8
-)
-
-type EUserUGCListSortOrder int32
-
-const (
-	EUserUGCListSortOrder_CreationOrderDesc    EUserUGCListSortOrder = 0
-	EUserUGCListSortOrder_CreationOrderAsc     EUserUGCListSortOrder = 1
-	EUserUGCListSortOrder_TitleAsc             EUserUGCListSortOrder = 2
-	EUserUGCListSortOrder_LastUpdatedDesc      EUserUGCListSortOrder = 3
-	EUserUGCListSortOrder_SubscriptionDateDesc EUserUGCListSortOrder = 4
-	EUserUGCListSortOrder_VoteScoreDesc        EUserUGCListSortOrder = 5
-	EUserUGCListSortOrder_ForModeration        EUserUGCListSortOrder = 6
-)
-
-type EUGCQuery int32
-
-const (
-	EUGCQuery_RankedByVote                                  EUGCQuery = 0
-	EUGCQuery_RankedByPublicationDate                       EUGCQuery = 1
-	EUGCQuery_AcceptedForGameRankedByAcceptanceDate         EUGCQuery = 2
-	EUGCQuery_RankedByTrend                                 EUGCQuery = 3
-	EUGCQuery_FavoritedByFriendsRankedByPublicationDate     EUGCQuery = 4
-	EUGCQuery_CreatedByFriendsRankedByPublicationDate       EUGCQuery = 5
-	EUGCQuery_RankedByNumTimesReported                      EUGCQuery = 6
-	EUGCQuery_CreatedByFollowedUsersRankedByPublicationDate EUGCQuery = 7
-	EUGCQuery_NotYetRated                                   EUGCQuery = 8
-	EUGCQuery_RankedByTotalVotesAsc                         EUGCQuery = 9
-	EUGCQuery_RankedByVotesUp                               EUGCQuery = 10
-	EUGCQuery_RankedByTextSearch                            EUGCQuery = 11
-	EUGCQuery_RankedByTotalUniqueSubscriptions              EUGCQuery = 12
-	EUGCQuery_RankedByPlaytimeTrend                         EUGCQuery = 13
-	EUGCQuery_RankedByTotalPlaytime                         EUGCQuery = 14
-	EUGCQuery_RankedByAveragePlaytimeTrend                  EUGCQuery = 15
-	EUGCQuery_RankedByLifetimeAveragePlaytime               EUGCQuery = 16
-	EUGCQuery_RankedByPlaytimeSessionsTrend                 EUGCQuery = 17
-	EUGCQuery_RankedByLifetimePlaytimeSessions              EUGCQuery = 18
-)
-
-type EItemUpdateStatus int32
-
-const (
-	EItemUpdateStatus_Invalid              EItemUpdateStatus = 0
-	EItemUpdateStatus_PreparingConfig      EItemUpdateStatus = 1
-	EItemUpdateStatus_PreparingContent     EItemUpdateStatus = 2
-	EItemUpdateStatus_UploadingContent     EItemUpdateStatus = 3
-	EItemUpdateStatus_UploadingPreviewFile EItemUpdateStatus = 4
-	EItemUpdateStatus_CommittingChanges    EItemUpdateStatus = 5
-)
-
-type EItemState int32
-
-const (
-	EItemState_None            EItemState = 0
-	EItemState_Subscribed      EItemState = 1
-	EItemState_LegacyItem      EItemState = 2
-	EItemState_Installed       EItemState = 4
-	EItemState_NeedsUpdate     EItemState = 8
-	EItemState_Downloading     EItemState = 16
-	EItemState_DownloadPending EItemState = 32
-)
-
-type EItemStatistic int32
-
-const (
-	EItemStatistic_NumSubscriptions                    EItemStatistic = 0
-	EItemStatistic_NumFavorites                        EItemStatistic = 1
-	EItemStatistic_NumFollowers                        EItemStatistic = 2
-	EItemStatistic_NumUniqueSubscriptions              EItemStatistic = 3
-	EItemStatistic_NumUniqueFavorites                  EItemStatistic = 4
-	EItemStatistic_NumUniqueFollowers                  EItemStatistic = 5
-	EItemStatistic_NumUniqueWebsiteViews               EItemStatistic = 6
-	EItemStatistic_ReportScore                         EItemStatistic = 7
-	EItemStatistic_NumSecondsPlayed                    EItemStatistic = 8
-	EItemStatistic_NumPlaytimeSessions                 EItemStatistic = 9
-	EItemStatistic_NumComments                         EItemStatistic = 10
-	EItemStatistic_NumSecondsPlayedDuringTimePeriod    EItemStatistic = 11
-	EItemStatistic_NumPlaytimeSessionsDuringTimePeriod EItemStatistic = 12
-)
-
-type EItemPreviewType int32
-
-const (
-	EItemPreviewType_Image                          EItemPreviewType = 0
-	EItemPreviewType_YouTubeVideo                   EItemPreviewType = 1
-	EItemPreviewType_Sketchfab                      EItemPreviewType = 2
-	EItemPreviewType_EnvironmentMap_HorizontalCross EItemPreviewType = 3
-	EItemPreviewType_EnvironmentMap_LatLong         EItemPreviewType = 4
-	EItemPreviewType_ReservedMax                    EItemPreviewType = 255
-)
-
-type EHTMLMouseButton int32
-
-const (
-	EHTMLMouseButton_Left   EHTMLMouseButton = 0
-	EHTMLMouseButton_Right  EHTMLMouseButton = 1
-	EHTMLMouseButton_Middle EHTMLMouseButton = 2
-)
-
-type EMouseCursor int32
-
-const (
-	EMouseCursor_user           EMouseCursor = 0
-	EMouseCursor_none           EMouseCursor = 1
-	EMouseCursor_arrow          EMouseCursor = 2
-	EMouseCursor_ibeam          EMouseCursor = 3
-	EMouseCursor_hourglass      EMouseCursor = 4
-	EMouseCursor_waitarrow      EMouseCursor = 5
-	EMouseCursor_crosshair      EMouseCursor = 6
-	EMouseCursor_up             EMouseCursor = 7
-	EMouseCursor_sizenw         EMouseCursor = 8
-	EMouseCursor_sizese         EMouseCursor = 9
-	EMouseCursor_sizene         EMouseCursor = 10
-	EMouseCursor_sizesw         EMouseCursor = 11
-	EMouseCursor_sizew          EMouseCursor = 12
-	EMouseCursor_sizee          EMouseCursor = 13
-	EMouseCursor_sizen          EMouseCursor = 14
-	EMouseCursor_sizes          EMouseCursor = 15
-	EMouseCursor_sizewe         EMouseCursor = 16
-	EMouseCursor_sizens         EMouseCursor = 17
-	EMouseCursor_sizeall        EMouseCursor = 18
-	EMouseCursor_no             EMouseCursor = 19
-	EMouseCursor_hand           EMouseCursor = 20
-	EMouseCursor_blank          EMouseCursor = 21
-	EMouseCursor_middle_pan     EMouseCursor = 22
-	EMouseCursor_north_pan      EMouseCursor = 23
-	EMouseCursor_north_east_pan EMouseCursor = 24
-	EMouseCursor_ast_pan        EMouseCursor = 25
-	EMouseCursor_south_east_pan EMouseCursor = 26
-	EMouseCursor_south_pan      EMouseCursor = 27
-	EMouseCursor_south_west_pan EMouseCursor = 28
-	EMouseCursor_west_pan       EMouseCursor = 29
-	EMouseCursor_north_west_pan EMouseCursor = 30
-	EMouseCursor_alias          EMouseCursor = 31
-	EMouseCursor_cell           EMouseCursor = 32
-	EMouseCursor_colresize      EMouseCursor = 33
-	EMouseCursor_copycur        EMouseCursor = 34
-	EMouseCursor_verticaltext   EMouseCursor = 35
-	EMouseCursor_rowresize      EMouseCursor = 36
-	EMouseCursor_zoomin         EMouseCursor = 37
-	EMouseCursor_zoomout        EMouseCursor = 38
-	EMouseCursor_help           EMouseCursor = 39
-	EMouseCursor_custom         EMouseCursor = 40
-	EMouseCursor_last           EMouseCursor = 41
-)
-
-type EHTMLKeyModifiers int32
-
-const (
-	EHTMLKeyModifiers_HTMLKeyModifier_None      EHTMLKeyModifiers = 0
-	EHTMLKeyModifiers_HTMLKeyModifier_AltDown   EHTMLKeyModifiers = 1
-	EHTMLKeyModifiers_HTMLKeyModifier_CtrlDown  EHTMLKeyModifiers = 2
-	EHTMLKeyModifiers_HTMLKeyModifier_ShiftDown EHTMLKeyModifiers = 4
-)
-
-type ESteamItemFlags int32
-
-const (
-	ESteamItemFlags_ESteamItemNoTrade  ESteamItemFlags = 1
-	ESteamItemFlags_ESteamItemRemoved  ESteamItemFlags = 256
-	ESteamItemFlags_ESteamItemConsumed ESteamItemFlags = 512
-)
-
-type EParentalFeature int32
-
-const (
-	EParentalFeature_Invalid       EParentalFeature = 0
-	EParentalFeature_Store         EParentalFeature = 1
-	EParentalFeature_Community     EParentalFeature = 2
-	EParentalFeature_Profile       EParentalFeature = 3
-	EParentalFeature_Friends       EParentalFeature = 4
-	EParentalFeature_News          EParentalFeature = 5
-	EParentalFeature_Trading       EParentalFeature = 6
-	EParentalFeature_Settings      EParentalFeature = 7
-	EParentalFeature_Console       EParentalFeature = 8
-	EParentalFeature_Browser       EParentalFeature = 9
-	EParentalFeature_ParentalSetup EParentalFeature = 10
-	EParentalFeature_Library       EParentalFeature = 11
-	EParentalFeature_Test          EParentalFeature = 12
-	EParentalFeature_Max           EParentalFeature = 13
-)
-
-type EServerMode int32
-
-const (
-	EServerMode_Invalid                 EServerMode = 0
-	EServerMode_NoAuthentication        EServerMode = 1
-	EServerMode_Authentication          EServerMode = 2
-	EServerMode_AuthenticationAndSecure EServerMode = 3
-)
-
-const (
-	SteamUserCallbacks                                       = 100
-	SteamGameServerCallbacks                                 = 200
-	SteamFriendsCallbacks                                    = 300
-	SteamBillingCallbacks                                    = 400
-	SteamMatchmakingCallbacks                                = 500
-	SteamContentServerCallbacks                              = 600
-	SteamUtilsCallbacks                                      = 700
-	ClientFriendsCallbacks                                   = 800
-	ClientUserCallbacks                                      = 900
-	SteamAppsCallbacks                                       = 1000
-	SteamUserStatsCallbacks                                  = 1100
-	SteamNetworkingCallbacks                                 = 1200
-	ClientRemoteStorageCallbacks                             = 1300
-	ClientDepotBuilderCallbacks                              = 1400
-	SteamGameServerItemsCallbacks                            = 1500
-	ClientUtilsCallbacks                                     = 1600
-	SteamGameCoordinatorCallbacks                            = 1700
-	SteamGameServerStatsCallbacks                            = 1800
-	Steam2AsyncCallbacks                                     = 1900
-	SteamGameStatsCallbacks                                  = 2000
-	ClientHTTPCallbacks                                      = 2100
-	ClientScreenshotsCallbacks                               = 2200
-	SteamScreenshotsCallbacks                                = 2300
-	ClientAudioCallbacks                                     = 2400
-	ClientUnifiedMessagesCallbacks                           = 2500
-	SteamStreamLauncherCallbacks                             = 2600
-	ClientControllerCallbacks                                = 2700
-	SteamControllerCallbacks                                 = 2800
-	ClientParentalSettingsCallbacks                          = 2900
-	ClientDeviceAuthCallbacks                                = 3000
-	ClientNetworkDeviceManagerCallbacks                      = 3100
-	ClientMusicCallbacks                                     = 3200
-	ClientRemoteClientManagerCallbacks                       = 3300
-	ClientUGCCallbacks                                       = 3400
-	SteamStreamClientCallbacks                               = 3500
-	ClientProductBuilderCallbacks                            = 3600
-	ClientShortcutsCallbacks                                 = 3700
-	ClientRemoteControlManagerCallbacks                      = 3800
-	SteamAppListCallbacks                                    = 3900
-	SteamMusicCallbacks                                      = 4000
-	SteamMusicRemoteCallbacks                                = 4100
-	ClientVRCallbacks                                        = 4200
-	ClientGameNotificationCallbacks                          = 4300
-	SteamGameNotificationCallbacks                           = 4400
-	SteamHTMLSurfaceCallbacks                                = 4500
-	ClientVideoCallbacks                                     = 4600
-	ClientInventoryCallbacks                                 = 4700
-	ClientBluetoothManagerCallbacks                          = 4800
-	ClientSharedConnectionCallbacks                          = 4900
-	SteamParentalSettingsCallbacks                           = 5000
-	ClientShaderCallbacks                                    = 5100
-	PersonaNameMaxBytes                                      = 128
-	PersonaNameMaxRunes                                      = 32
-	MaxRichPresenceKeys                                      = 20
-	MaxRichPresenceKeyLength                                 = 64
-	MaxRichPresenceValueLength                               = 256
-	StatNameMax                                              = 128
-	LeaderboardNameMax                                       = 128
-	LeaderboardDetailsMax                                    = 64
-	SteamItemInstanceIDInvalid          SteamItemInstanceID  = 18446744073709551615
-	SteamInventoryResultInvalid         SteamInventoryResult = -1
-)
-
 var IsGameClient bool
 var IsGameServer bool
 
@@ -1684,216 +279,201 @@ func SteamAPI_Init() bool {
 	if IsGameServer {
 		panic("steamworks: InitClient must be called before InitServer if both are called")
 	}
-	IsGameClient = true
-	return bool(C.SteamAPI_Init())
+	IsGameClient = bool(C.SteamAPI_Init())
+	return IsGameClient
 }
 func SteamGameServer_Init(ip uint32, steamPort, gamePort, queryPort uint16, serverMode EServerMode, versionString *C.char) bool {
-	IsGameServer = true
-	return bool(C.SteamInternal_GameServer_Init(C.uint32(ip), C.uint16(steamPort), C.uint16(gamePort), C.uint16(queryPort), C.EServerMode(serverMode), versionString))
+	IsGameServer = bool(C.SteamInternal_GameServer_Init(C.uint32(ip), C.uint16(steamPort), C.uint16(gamePort), C.uint16(queryPort), C.EServerMode(serverMode), versionString))
+	return IsGameServer
 }
-func SteamAPI_Shutdown() {
-	if IsGameServer {
-		SteamGameServer_Shutdown()
-		if !IsGameClient {
-			return
-		}
+func SteamAPI_Shutdown(side Side) {
+	if side != SideClient && IsGameServer {
+		C.SteamGameServer_Shutdown()
+		IsGameServer = false
+	}
+	if side != SideServer && IsGameClient {
+		C.SteamAPI_Shutdown()
+		IsGameClient = false
 	}
-	C.SteamAPI_Shutdown()
-	IsGameClient = false
 }
-func SteamGameServer_Shutdown() { C.SteamGameServer_Shutdown(); IsGameServer = false }
 func SteamAPI_RestartAppIfNecessary(unOwnAppID uint32) bool {
 	return bool(C.SteamAPI_RestartAppIfNecessary(C.uint32(unOwnAppID)))
 }
 func SteamAPI_ReleaseCurrentThreadMemory() { C.SteamAPI_ReleaseCurrentThreadMemory() }
-func SteamAPI_RunCallbacks() {
-	if IsGameServer {
+func SteamAPI_RunCallbacks(side Side) {
+	if side != SideClient && IsGameServer {
 		C.SteamGameServer_RunCallbacks()
 	}
-	if IsGameClient {
+	if side != SideServer && IsGameClient {
 		C.SteamAPI_RunCallbacks()
 	}
 }
 func SteamAPI_IsSteamRunning() bool   { return bool(C.SteamAPI_IsSteamRunning()) }
 func SteamID_IsValid(id SteamID) bool { return bool(C.SteamID_IsValid(id)) } // wrapper
-func getSteamClient() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerClient()
-		}
+func getSteamClient(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerClient()
 	}
 	return C.GetSteamClient()
 }
 func getSteamUser() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamUser interface is not available on dedicated servers")
 	}
 	return C.GetSteamUser()
 }
 func getSteamGameServer() C.intp {
-	if IsGameServer {
-		return C.GetSteamGameServer()
+	if !IsGameServer {
+		panic("steamworks/internal: the ISteamGameServer interface is not available on clients")
 	}
-	panic("steamworks/internal: the ISteamGameServer interface is not available on clients")
+	return C.GetSteamGameServer()
 }
 func getSteamFriends() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamFriends interface is not available on dedicated servers")
 	}
 	return C.GetSteamFriends()
 }
-func getSteamUtils() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerUtils()
-		}
+func getSteamUtils(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerUtils()
 	}
 	return C.GetSteamUtils()
 }
 func getSteamMatchmaking() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamMatchmaking interface is not available on dedicated servers")
 	}
 	return C.GetSteamMatchmaking()
 }
 func getSteamMatchmakingServers() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamMatchmakingServers interface is not available on dedicated servers")
 	}
 	return C.GetSteamMatchmakingServers()
 }
 func getSteamUserStats() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamUserStats interface is not available on dedicated servers")
 	}
 	return C.GetSteamUserStats()
 }
 func getSteamGameServerStats() C.intp {
-	if IsGameServer {
-		return C.GetSteamGameServerStats()
+	if !IsGameServer {
+		panic("steamworks/internal: the ISteamGameServerStats interface is not available on clients")
 	}
-	panic("steamworks/internal: the ISteamGameServerStats interface is not available on clients")
+	return C.GetSteamGameServerStats()
 }
-func getSteamApps() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerApps()
-		}
+func getSteamApps(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerApps()
 	}
 	return C.GetSteamApps()
 }
-func getSteamNetworking() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerNetworking()
-		}
+func getSteamNetworking(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerNetworking()
 	}
 	return C.GetSteamNetworking()
 }
 func getSteamRemoteStorage() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamRemoteStorage interface is not available on dedicated servers")
 	}
 	return C.GetSteamRemoteStorage()
 }
 func getSteamScreenshots() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamScreenshots interface is not available on dedicated servers")
 	}
 	return C.GetSteamScreenshots()
 }
-func getSteamHTTP() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerHTTP()
-		}
+func getSteamHTTP(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerHTTP()
 	}
 	return C.GetSteamHTTP()
 }
 func getSteamController() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamController interface is not available on dedicated servers")
 	}
 	return C.GetSteamController()
 }
-func getSteamUGC() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerUGC()
-		}
+func getSteamUGC(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerUGC()
 	}
 	return C.GetSteamUGC()
 }
 func getSteamAppList() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamAppList interface is not available on dedicated servers")
 	}
 	return C.GetSteamAppList()
 }
 func getSteamMusic() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamMusic interface is not available on dedicated servers")
 	}
 	return C.GetSteamMusic()
 }
 func getSteamMusicRemote() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamMusicRemote interface is not available on dedicated servers")
 	}
 	return C.GetSteamMusicRemote()
 }
 func getSteamHTMLSurface() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamHTMLSurface interface is not available on dedicated servers")
 	}
 	return C.GetSteamHTMLSurface()
 }
-func getSteamInventory() C.intp {
-	if IsGameServer {
-		if !IsGameClient {
-			return C.GetSteamGameServerInventory()
-		}
+func getSteamInventory(side Side) C.intp {
+	if side.GameServer() {
+		return C.GetSteamGameServerInventory()
 	}
 	return C.GetSteamInventory()
 }
 func getSteamVideo() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamVideo interface is not available on dedicated servers")
 	}
 	return C.GetSteamVideo()
 }
 func getSteamParentalSettings() C.intp {
-	if IsGameServer {
+	if IsGameServer && !IsGameClient {
 		panic("steamworks/internal: the ISteamParentalSettings interface is not available on dedicated servers")
 	}
 	return C.GetSteamParentalSettings()
 }
-func SteamAPI_ISteamClient_CreateSteamPipe() HSteamPipe {
-	return C.SteamAPI_ISteamClient_CreateSteamPipe(getSteamClient())
+func SteamAPI_ISteamClient_CreateSteamPipe(side Side) HSteamPipe {
+	return C.SteamAPI_ISteamClient_CreateSteamPipe(getSteamClient(side))
 }
-func SteamAPI_ISteamClient_BReleaseSteamPipe(hSteamPipe HSteamPipe) bool {
-	return bool(C.SteamAPI_ISteamClient_BReleaseSteamPipe(getSteamClient(), hSteamPipe))
+func SteamAPI_ISteamClient_BReleaseSteamPipe(side Side, hSteamPipe HSteamPipe) bool {
+	return bool(C.SteamAPI_ISteamClient_BReleaseSteamPipe(getSteamClient(side), hSteamPipe))
 }
-func SteamAPI_ISteamClient_ConnectToGlobalUser(hSteamPipe HSteamPipe) HSteamUser {
-	return C.SteamAPI_ISteamClient_ConnectToGlobalUser(getSteamClient(), hSteamPipe)
+func SteamAPI_ISteamClient_ConnectToGlobalUser(side Side, hSteamPipe HSteamPipe) HSteamUser {
+	return C.SteamAPI_ISteamClient_ConnectToGlobalUser(getSteamClient(side), hSteamPipe)
 }
-func SteamAPI_ISteamClient_CreateLocalUser(phSteamPipe *HSteamPipe, eAccountType EAccountType) HSteamUser {
-	return C.SteamAPI_ISteamClient_CreateLocalUser(getSteamClient(), phSteamPipe, C.EAccountType(eAccountType))
+func SteamAPI_ISteamClient_CreateLocalUser(side Side, phSteamPipe *HSteamPipe, eAccountType EAccountType) HSteamUser {
+	return C.SteamAPI_ISteamClient_CreateLocalUser(getSteamClient(side), phSteamPipe, C.EAccountType(eAccountType))
 }
-func SteamAPI_ISteamClient_ReleaseUser(hSteamPipe HSteamPipe, hUser HSteamUser) {
-	C.SteamAPI_ISteamClient_ReleaseUser(getSteamClient(), hSteamPipe, hUser)
+func SteamAPI_ISteamClient_ReleaseUser(side Side, hSteamPipe HSteamPipe, hUser HSteamUser) {
+	C.SteamAPI_ISteamClient_ReleaseUser(getSteamClient(side), hSteamPipe, hUser)
 }
-func SteamAPI_ISteamClient_SetLocalIPBinding(unIP uint32, usPort uint16) {
-	C.SteamAPI_ISteamClient_SetLocalIPBinding(getSteamClient(), C.uint32(unIP), C.uint16(usPort))
+func SteamAPI_ISteamClient_SetLocalIPBinding(side Side, unIP uint32, usPort uint16) {
+	C.SteamAPI_ISteamClient_SetLocalIPBinding(getSteamClient(side), C.uint32(unIP), C.uint16(usPort))
 }
-func SteamAPI_ISteamClient_GetISteamGenericInterface(hSteamUser HSteamUser, hSteamPipe HSteamPipe, pchVersion *C.char) unsafe.Pointer {
-	return C.SteamAPI_ISteamClient_GetISteamGenericInterface(getSteamClient(), hSteamUser, hSteamPipe, pchVersion)
+func SteamAPI_ISteamClient_GetISteamGenericInterface(side Side, hSteamUser HSteamUser, hSteamPipe HSteamPipe, pchVersion *C.char) unsafe.Pointer {
+	return C.SteamAPI_ISteamClient_GetISteamGenericInterface(getSteamClient(side), hSteamUser, hSteamPipe, pchVersion)
 }
-func SteamAPI_ISteamClient_GetIPCCallCount() uint32 {
-	return uint32(C.SteamAPI_ISteamClient_GetIPCCallCount(getSteamClient()))
+func SteamAPI_ISteamClient_GetIPCCallCount(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamClient_GetIPCCallCount(getSteamClient(side)))
 }
-func SteamAPI_ISteamClient_BShutdownIfAllPipesClosed() bool {
-	return bool(C.SteamAPI_ISteamClient_BShutdownIfAllPipesClosed(getSteamClient()))
+func SteamAPI_ISteamClient_BShutdownIfAllPipesClosed(side Side) bool {
+	return bool(C.SteamAPI_ISteamClient_BShutdownIfAllPipesClosed(getSteamClient(side)))
 }
 func SteamAPI_ISteamUser_GetHSteamUser() HSteamUser {
 	return C.SteamAPI_ISteamUser_GetHSteamUser(getSteamUser())
@@ -2198,89 +778,89 @@ func SteamAPI_ISteamFriends_IsClanPublic(steamIDClan SteamID) bool {
 func SteamAPI_ISteamFriends_IsClanOfficialGameGroup(steamIDClan SteamID) bool {
 	return bool(C.SteamAPI_ISteamFriends_IsClanOfficialGameGroup(getSteamFriends(), C.CSteamID(steamIDClan)))
 }
-func SteamAPI_ISteamUtils_GetSecondsSinceAppActive() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetSecondsSinceAppActive(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetSecondsSinceAppActive(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetSecondsSinceAppActive(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetSecondsSinceComputerActive() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetSecondsSinceComputerActive(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetSecondsSinceComputerActive(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetSecondsSinceComputerActive(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetConnectedUniverse() EUniverse {
-	return EUniverse(C.SteamAPI_ISteamUtils_GetConnectedUniverse(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetConnectedUniverse(side Side) EUniverse {
+	return EUniverse(C.SteamAPI_ISteamUtils_GetConnectedUniverse(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetServerRealTime() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetServerRealTime(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetServerRealTime(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetServerRealTime(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetIPCountry() *C.char {
-	return C.SteamAPI_ISteamUtils_GetIPCountry(getSteamUtils())
+func SteamAPI_ISteamUtils_GetIPCountry(side Side) *C.char {
+	return C.SteamAPI_ISteamUtils_GetIPCountry(getSteamUtils(side))
 }
-func SteamAPI_ISteamUtils_GetImageSize(iImage int32, pnWidth *uint32, pnHeight *uint32) bool {
-	return bool(C.SteamAPI_ISteamUtils_GetImageSize(getSteamUtils(), C.int32(iImage), (*C.uint32)(pnWidth), (*C.uint32)(pnHeight)))
+func SteamAPI_ISteamUtils_GetImageSize(side Side, iImage int32, pnWidth *uint32, pnHeight *uint32) bool {
+	return bool(C.SteamAPI_ISteamUtils_GetImageSize(getSteamUtils(side), C.int32(iImage), (*C.uint32)(pnWidth), (*C.uint32)(pnHeight)))
 }
-func SteamAPI_ISteamUtils_GetImageRGBA(iImage int32, pubDest *uint8, nDestBufferSize int32) bool {
-	return bool(C.SteamAPI_ISteamUtils_GetImageRGBA(getSteamUtils(), C.int32(iImage), (*C.uint8)(pubDest), C.int32(nDestBufferSize)))
+func SteamAPI_ISteamUtils_GetImageRGBA(side Side, iImage int32, pubDest *uint8, nDestBufferSize int32) bool {
+	return bool(C.SteamAPI_ISteamUtils_GetImageRGBA(getSteamUtils(side), C.int32(iImage), (*C.uint8)(pubDest), C.int32(nDestBufferSize)))
 }
-func SteamAPI_ISteamUtils_GetCSERIPPort(unIP *uint32, usPort *uint16) bool {
-	return bool(C.SteamAPI_ISteamUtils_GetCSERIPPort(getSteamUtils(), (*C.uint32)(unIP), (*C.uint16)(usPort)))
+func SteamAPI_ISteamUtils_GetCSERIPPort(side Side, unIP *uint32, usPort *uint16) bool {
+	return bool(C.SteamAPI_ISteamUtils_GetCSERIPPort(getSteamUtils(side), (*C.uint32)(unIP), (*C.uint16)(usPort)))
 }
-func SteamAPI_ISteamUtils_GetCurrentBatteryPower() uint8 {
-	return uint8(C.SteamAPI_ISteamUtils_GetCurrentBatteryPower(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetCurrentBatteryPower(side Side) uint8 {
+	return uint8(C.SteamAPI_ISteamUtils_GetCurrentBatteryPower(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetAppID() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetAppID(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetAppID(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetAppID(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_SetOverlayNotificationPosition(eNotificationPosition ENotificationPosition) {
-	C.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(getSteamUtils(), C.ENotificationPosition(eNotificationPosition))
+func SteamAPI_ISteamUtils_SetOverlayNotificationPosition(side Side, eNotificationPosition ENotificationPosition) {
+	C.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(getSteamUtils(side), C.ENotificationPosition(eNotificationPosition))
 }
-func SteamAPI_ISteamUtils_IsAPICallCompleted(hSteamAPICall SteamAPICall, pbFailed *bool) bool {
-	return bool(C.SteamAPI_ISteamUtils_IsAPICallCompleted(getSteamUtils(), hSteamAPICall, (*C.bool)(pbFailed)))
+func SteamAPI_ISteamUtils_IsAPICallCompleted(side Side, hSteamAPICall SteamAPICall, pbFailed *bool) bool {
+	return bool(C.SteamAPI_ISteamUtils_IsAPICallCompleted(getSteamUtils(side), hSteamAPICall, (*C.bool)(pbFailed)))
 }
-func SteamAPI_ISteamUtils_GetAPICallFailureReason(hSteamAPICall SteamAPICall) ESteamAPICallFailure {
-	return ESteamAPICallFailure(C.SteamAPI_ISteamUtils_GetAPICallFailureReason(getSteamUtils(), hSteamAPICall))
+func SteamAPI_ISteamUtils_GetAPICallFailureReason(side Side, hSteamAPICall SteamAPICall) ESteamAPICallFailure {
+	return ESteamAPICallFailure(C.SteamAPI_ISteamUtils_GetAPICallFailureReason(getSteamUtils(side), hSteamAPICall))
 }
-func SteamAPI_ISteamUtils_GetAPICallResult(hSteamAPICall SteamAPICall, pCallback unsafe.Pointer, cubCallback int32, iCallbackExpected int32, pbFailed *bool) bool {
-	return bool(C.SteamAPI_ISteamUtils_GetAPICallResult(getSteamUtils(), hSteamAPICall, pCallback, C.int32(cubCallback), C.int32(iCallbackExpected), (*C.bool)(pbFailed)))
+func SteamAPI_ISteamUtils_GetAPICallResult(side Side, hSteamAPICall SteamAPICall, pCallback unsafe.Pointer, cubCallback int32, iCallbackExpected int32, pbFailed *bool) bool {
+	return bool(C.SteamAPI_ISteamUtils_GetAPICallResult(getSteamUtils(side), hSteamAPICall, pCallback, C.int32(cubCallback), C.int32(iCallbackExpected), (*C.bool)(pbFailed)))
 }
-func SteamAPI_ISteamUtils_GetIPCCallCount() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetIPCCallCount(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetIPCCallCount(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetIPCCallCount(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_IsOverlayEnabled() bool {
-	return bool(C.SteamAPI_ISteamUtils_IsOverlayEnabled(getSteamUtils()))
+func SteamAPI_ISteamUtils_IsOverlayEnabled(side Side) bool {
+	return bool(C.SteamAPI_ISteamUtils_IsOverlayEnabled(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_BOverlayNeedsPresent() bool {
-	return bool(C.SteamAPI_ISteamUtils_BOverlayNeedsPresent(getSteamUtils()))
+func SteamAPI_ISteamUtils_BOverlayNeedsPresent(side Side) bool {
+	return bool(C.SteamAPI_ISteamUtils_BOverlayNeedsPresent(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_CheckFileSignature(szFileName *C.char) SteamAPICall {
-	return C.SteamAPI_ISteamUtils_CheckFileSignature(getSteamUtils(), szFileName)
+func SteamAPI_ISteamUtils_CheckFileSignature(side Side, szFileName *C.char) SteamAPICall {
+	return C.SteamAPI_ISteamUtils_CheckFileSignature(getSteamUtils(side), szFileName)
 }
-func SteamAPI_ISteamUtils_ShowGamepadTextInput(eInputMode EGamepadTextInputMode, eLineInputMode EGamepadTextInputLineMode, pchDescription *C.char, unCharMax uint32, pchExistingText *C.char) bool {
-	return bool(C.SteamAPI_ISteamUtils_ShowGamepadTextInput(getSteamUtils(), C.EGamepadTextInputMode(eInputMode), C.EGamepadTextInputLineMode(eLineInputMode), pchDescription, C.uint32(unCharMax), pchExistingText))
+func SteamAPI_ISteamUtils_ShowGamepadTextInput(side Side, eInputMode EGamepadTextInputMode, eLineInputMode EGamepadTextInputLineMode, pchDescription *C.char, unCharMax uint32, pchExistingText *C.char) bool {
+	return bool(C.SteamAPI_ISteamUtils_ShowGamepadTextInput(getSteamUtils(side), C.EGamepadTextInputMode(eInputMode), C.EGamepadTextInputLineMode(eLineInputMode), pchDescription, C.uint32(unCharMax), pchExistingText))
 }
-func SteamAPI_ISteamUtils_GetEnteredGamepadTextLength() uint32 {
-	return uint32(C.SteamAPI_ISteamUtils_GetEnteredGamepadTextLength(getSteamUtils()))
+func SteamAPI_ISteamUtils_GetEnteredGamepadTextLength(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUtils_GetEnteredGamepadTextLength(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(pchText *C.char, cchText uint32) bool {
-	return bool(C.SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(getSteamUtils(), pchText, C.uint32(cchText)))
+func SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(side Side, pchText *C.char, cchText uint32) bool {
+	return bool(C.SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(getSteamUtils(side), pchText, C.uint32(cchText)))
 }
-func SteamAPI_ISteamUtils_GetSteamUILanguage() *C.char {
-	return C.SteamAPI_ISteamUtils_GetSteamUILanguage(getSteamUtils())
+func SteamAPI_ISteamUtils_GetSteamUILanguage(side Side) *C.char {
+	return C.SteamAPI_ISteamUtils_GetSteamUILanguage(getSteamUtils(side))
 }
-func SteamAPI_ISteamUtils_IsSteamRunningInVR() bool {
-	return bool(C.SteamAPI_ISteamUtils_IsSteamRunningInVR(getSteamUtils()))
+func SteamAPI_ISteamUtils_IsSteamRunningInVR(side Side) bool {
+	return bool(C.SteamAPI_ISteamUtils_IsSteamRunningInVR(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_SetOverlayNotificationInset(nHorizontalInset int32, nVerticalInset int32) {
-	C.SteamAPI_ISteamUtils_SetOverlayNotificationInset(getSteamUtils(), C.int32(nHorizontalInset), C.int32(nVerticalInset))
+func SteamAPI_ISteamUtils_SetOverlayNotificationInset(side Side, nHorizontalInset int32, nVerticalInset int32) {
+	C.SteamAPI_ISteamUtils_SetOverlayNotificationInset(getSteamUtils(side), C.int32(nHorizontalInset), C.int32(nVerticalInset))
 }
-func SteamAPI_ISteamUtils_IsSteamInBigPictureMode() bool {
-	return bool(C.SteamAPI_ISteamUtils_IsSteamInBigPictureMode(getSteamUtils()))
+func SteamAPI_ISteamUtils_IsSteamInBigPictureMode(side Side) bool {
+	return bool(C.SteamAPI_ISteamUtils_IsSteamInBigPictureMode(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_StartVRDashboard() {
-	C.SteamAPI_ISteamUtils_StartVRDashboard(getSteamUtils())
+func SteamAPI_ISteamUtils_StartVRDashboard(side Side) {
+	C.SteamAPI_ISteamUtils_StartVRDashboard(getSteamUtils(side))
 }
-func SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled() bool {
-	return bool(C.SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled(getSteamUtils()))
+func SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled(side Side) bool {
+	return bool(C.SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled(getSteamUtils(side)))
 }
-func SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(bEnabled bool) {
-	C.SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(getSteamUtils(), C.bool(bEnabled))
+func SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(side Side, bEnabled bool) {
+	C.SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(getSteamUtils(side), C.bool(bEnabled))
 }
 func SteamAPI_ISteamMatchmaking_GetFavoriteGameCount() int32 {
 	return int32(C.SteamAPI_ISteamMatchmaking_GetFavoriteGameCount(getSteamMatchmaking()))
@@ -2714,149 +1294,149 @@ func SteamAPI_ISteamUserStats_GetGlobalStatHistory(pchStatName *C.char, pData *i
 func SteamAPI_ISteamUserStats_GetGlobalStatHistory0(pchStatName *C.char, pData *float64, cubData uint32) int32 {
 	return int32(C.SteamAPI_ISteamUserStats_GetGlobalStatHistory0(getSteamUserStats(), pchStatName, (*C.double)(pData), C.uint32(cubData)))
 }
-func SteamAPI_ISteamApps_BIsSubscribed() bool {
-	return bool(C.SteamAPI_ISteamApps_BIsSubscribed(getSteamApps()))
+func SteamAPI_ISteamApps_BIsSubscribed(side Side) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsSubscribed(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_BIsLowViolence() bool {
-	return bool(C.SteamAPI_ISteamApps_BIsLowViolence(getSteamApps()))
+func SteamAPI_ISteamApps_BIsLowViolence(side Side) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsLowViolence(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_BIsCybercafe() bool {
-	return bool(C.SteamAPI_ISteamApps_BIsCybercafe(getSteamApps()))
+func SteamAPI_ISteamApps_BIsCybercafe(side Side) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsCybercafe(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_BIsVACBanned() bool {
-	return bool(C.SteamAPI_ISteamApps_BIsVACBanned(getSteamApps()))
+func SteamAPI_ISteamApps_BIsVACBanned(side Side) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsVACBanned(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_GetCurrentGameLanguage() *C.char {
-	return C.SteamAPI_ISteamApps_GetCurrentGameLanguage(getSteamApps())
+func SteamAPI_ISteamApps_GetCurrentGameLanguage(side Side) *C.char {
+	return C.SteamAPI_ISteamApps_GetCurrentGameLanguage(getSteamApps(side))
 }
-func SteamAPI_ISteamApps_GetAvailableGameLanguages() *C.char {
-	return C.SteamAPI_ISteamApps_GetAvailableGameLanguages(getSteamApps())
+func SteamAPI_ISteamApps_GetAvailableGameLanguages(side Side) *C.char {
+	return C.SteamAPI_ISteamApps_GetAvailableGameLanguages(getSteamApps(side))
 }
-func SteamAPI_ISteamApps_BIsSubscribedApp(appID AppId) bool {
-	return bool(C.SteamAPI_ISteamApps_BIsSubscribedApp(getSteamApps(), appID))
+func SteamAPI_ISteamApps_BIsSubscribedApp(side Side, appID AppId) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsSubscribedApp(getSteamApps(side), appID))
 }
-func SteamAPI_ISteamApps_BIsDlcInstalled(appID AppId) bool {
-	return bool(C.SteamAPI_ISteamApps_BIsDlcInstalled(getSteamApps(), appID))
+func SteamAPI_ISteamApps_BIsDlcInstalled(side Side, appID AppId) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsDlcInstalled(getSteamApps(side), appID))
 }
-func SteamAPI_ISteamApps_GetEarliestPurchaseUnixTime(nAppID AppId) uint32 {
-	return uint32(C.SteamAPI_ISteamApps_GetEarliestPurchaseUnixTime(getSteamApps(), nAppID))
+func SteamAPI_ISteamApps_GetEarliestPurchaseUnixTime(side Side, nAppID AppId) uint32 {
+	return uint32(C.SteamAPI_ISteamApps_GetEarliestPurchaseUnixTime(getSteamApps(side), nAppID))
 }
-func SteamAPI_ISteamApps_BIsSubscribedFromFreeWeekend() bool {
-	return bool(C.SteamAPI_ISteamApps_BIsSubscribedFromFreeWeekend(getSteamApps()))
+func SteamAPI_ISteamApps_BIsSubscribedFromFreeWeekend(side Side) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsSubscribedFromFreeWeekend(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_GetDLCCount() int32 {
-	return int32(C.SteamAPI_ISteamApps_GetDLCCount(getSteamApps()))
+func SteamAPI_ISteamApps_GetDLCCount(side Side) int32 {
+	return int32(C.SteamAPI_ISteamApps_GetDLCCount(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_BGetDLCDataByIndex(iDLC int32, pAppID *AppId, pbAvailable *bool, pchName *C.char, cchNameBufferSize int32) bool {
-	return bool(C.SteamAPI_ISteamApps_BGetDLCDataByIndex(getSteamApps(), C.int32(iDLC), pAppID, (*C.bool)(pbAvailable), pchName, C.int32(cchNameBufferSize)))
+func SteamAPI_ISteamApps_BGetDLCDataByIndex(side Side, iDLC int32, pAppID *AppId, pbAvailable *bool, pchName *C.char, cchNameBufferSize int32) bool {
+	return bool(C.SteamAPI_ISteamApps_BGetDLCDataByIndex(getSteamApps(side), C.int32(iDLC), pAppID, (*C.bool)(pbAvailable), pchName, C.int32(cchNameBufferSize)))
 }
-func SteamAPI_ISteamApps_InstallDLC(nAppID AppId) {
-	C.SteamAPI_ISteamApps_InstallDLC(getSteamApps(), nAppID)
+func SteamAPI_ISteamApps_InstallDLC(side Side, nAppID AppId) {
+	C.SteamAPI_ISteamApps_InstallDLC(getSteamApps(side), nAppID)
 }
-func SteamAPI_ISteamApps_UninstallDLC(nAppID AppId) {
-	C.SteamAPI_ISteamApps_UninstallDLC(getSteamApps(), nAppID)
+func SteamAPI_ISteamApps_UninstallDLC(side Side, nAppID AppId) {
+	C.SteamAPI_ISteamApps_UninstallDLC(getSteamApps(side), nAppID)
 }
-func SteamAPI_ISteamApps_RequestAppProofOfPurchaseKey(nAppID AppId) {
-	C.SteamAPI_ISteamApps_RequestAppProofOfPurchaseKey(getSteamApps(), nAppID)
+func SteamAPI_ISteamApps_RequestAppProofOfPurchaseKey(side Side, nAppID AppId) {
+	C.SteamAPI_ISteamApps_RequestAppProofOfPurchaseKey(getSteamApps(side), nAppID)
 }
-func SteamAPI_ISteamApps_GetCurrentBetaName(pchName *C.char, cchNameBufferSize int32) bool {
-	return bool(C.SteamAPI_ISteamApps_GetCurrentBetaName(getSteamApps(), pchName, C.int32(cchNameBufferSize)))
+func SteamAPI_ISteamApps_GetCurrentBetaName(side Side, pchName *C.char, cchNameBufferSize int32) bool {
+	return bool(C.SteamAPI_ISteamApps_GetCurrentBetaName(getSteamApps(side), pchName, C.int32(cchNameBufferSize)))
 }
-func SteamAPI_ISteamApps_MarkContentCorrupt(bMissingFilesOnly bool) bool {
-	return bool(C.SteamAPI_ISteamApps_MarkContentCorrupt(getSteamApps(), C.bool(bMissingFilesOnly)))
+func SteamAPI_ISteamApps_MarkContentCorrupt(side Side, bMissingFilesOnly bool) bool {
+	return bool(C.SteamAPI_ISteamApps_MarkContentCorrupt(getSteamApps(side), C.bool(bMissingFilesOnly)))
 }
-func SteamAPI_ISteamApps_GetInstalledDepots(appID AppId, pvecDepots *DepotId, cMaxDepots uint32) uint32 {
-	return uint32(C.SteamAPI_ISteamApps_GetInstalledDepots(getSteamApps(), appID, pvecDepots, C.uint32(cMaxDepots)))
+func SteamAPI_ISteamApps_GetInstalledDepots(side Side, appID AppId, pvecDepots *DepotId, cMaxDepots uint32) uint32 {
+	return uint32(C.SteamAPI_ISteamApps_GetInstalledDepots(getSteamApps(side), appID, pvecDepots, C.uint32(cMaxDepots)))
 }
-func SteamAPI_ISteamApps_GetAppInstallDir(appID AppId, pchFolder *C.char, cchFolderBufferSize uint32) uint32 {
-	return uint32(C.SteamAPI_ISteamApps_GetAppInstallDir(getSteamApps(), appID, pchFolder, C.uint32(cchFolderBufferSize)))
+func SteamAPI_ISteamApps_GetAppInstallDir(side Side, appID AppId, pchFolder *C.char, cchFolderBufferSize uint32) uint32 {
+	return uint32(C.SteamAPI_ISteamApps_GetAppInstallDir(getSteamApps(side), appID, pchFolder, C.uint32(cchFolderBufferSize)))
 }
-func SteamAPI_ISteamApps_BIsAppInstalled(appID AppId) bool {
-	return bool(C.SteamAPI_ISteamApps_BIsAppInstalled(getSteamApps(), appID))
+func SteamAPI_ISteamApps_BIsAppInstalled(side Side, appID AppId) bool {
+	return bool(C.SteamAPI_ISteamApps_BIsAppInstalled(getSteamApps(side), appID))
 }
-func SteamAPI_ISteamApps_GetAppOwner() SteamID {
-	return SteamID(C.SteamAPI_ISteamApps_GetAppOwner(getSteamApps()))
+func SteamAPI_ISteamApps_GetAppOwner(side Side) SteamID {
+	return SteamID(C.SteamAPI_ISteamApps_GetAppOwner(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_GetLaunchQueryParam(pchKey *C.char) *C.char {
-	return C.SteamAPI_ISteamApps_GetLaunchQueryParam(getSteamApps(), pchKey)
+func SteamAPI_ISteamApps_GetLaunchQueryParam(side Side, pchKey *C.char) *C.char {
+	return C.SteamAPI_ISteamApps_GetLaunchQueryParam(getSteamApps(side), pchKey)
 }
-func SteamAPI_ISteamApps_GetDlcDownloadProgress(nAppID AppId, punBytesDownloaded *uint64, punBytesTotal *uint64) bool {
-	return bool(C.SteamAPI_ISteamApps_GetDlcDownloadProgress(getSteamApps(), nAppID, (*C.uint64)(punBytesDownloaded), (*C.uint64)(punBytesTotal)))
+func SteamAPI_ISteamApps_GetDlcDownloadProgress(side Side, nAppID AppId, punBytesDownloaded *uint64, punBytesTotal *uint64) bool {
+	return bool(C.SteamAPI_ISteamApps_GetDlcDownloadProgress(getSteamApps(side), nAppID, (*C.uint64)(punBytesDownloaded), (*C.uint64)(punBytesTotal)))
 }
-func SteamAPI_ISteamApps_GetAppBuildId() int32 {
-	return int32(C.SteamAPI_ISteamApps_GetAppBuildId(getSteamApps()))
+func SteamAPI_ISteamApps_GetAppBuildId(side Side) int32 {
+	return int32(C.SteamAPI_ISteamApps_GetAppBuildId(getSteamApps(side)))
 }
-func SteamAPI_ISteamApps_RequestAllProofOfPurchaseKeys() {
-	C.SteamAPI_ISteamApps_RequestAllProofOfPurchaseKeys(getSteamApps())
+func SteamAPI_ISteamApps_RequestAllProofOfPurchaseKeys(side Side) {
+	C.SteamAPI_ISteamApps_RequestAllProofOfPurchaseKeys(getSteamApps(side))
 }
-func SteamAPI_ISteamApps_GetFileDetails(pszFileName *C.char) SteamAPICall {
-	return C.SteamAPI_ISteamApps_GetFileDetails(getSteamApps(), pszFileName)
+func SteamAPI_ISteamApps_GetFileDetails(side Side, pszFileName *C.char) SteamAPICall {
+	return C.SteamAPI_ISteamApps_GetFileDetails(getSteamApps(side), pszFileName)
 }
-func SteamAPI_ISteamNetworking_SendP2PPacket(steamIDRemote SteamID, pubData unsafe.Pointer, cubData uint32, eP2PSendType EP2PSend, nChannel int32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_SendP2PPacket(getSteamNetworking(), C.CSteamID(steamIDRemote), pubData, C.uint32(cubData), C.EP2PSend(eP2PSendType), C.int32(nChannel)))
+func SteamAPI_ISteamNetworking_SendP2PPacket(side Side, steamIDRemote SteamID, pubData unsafe.Pointer, cubData uint32, eP2PSendType EP2PSend, nChannel int32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_SendP2PPacket(getSteamNetworking(side), C.CSteamID(steamIDRemote), pubData, C.uint32(cubData), C.EP2PSend(eP2PSendType), C.int32(nChannel)))
 }
-func SteamAPI_ISteamNetworking_IsP2PPacketAvailable(pcubMsgSize *uint32, nChannel int32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_IsP2PPacketAvailable(getSteamNetworking(), (*C.uint32)(pcubMsgSize), C.int32(nChannel)))
+func SteamAPI_ISteamNetworking_IsP2PPacketAvailable(side Side, pcubMsgSize *uint32, nChannel int32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_IsP2PPacketAvailable(getSteamNetworking(side), (*C.uint32)(pcubMsgSize), C.int32(nChannel)))
 }
-func SteamAPI_ISteamNetworking_ReadP2PPacket(pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32, psteamIDRemote *SteamID, nChannel int32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_ReadP2PPacket(getSteamNetworking(), pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize), (*C.CSteamID)(psteamIDRemote), C.int32(nChannel)))
+func SteamAPI_ISteamNetworking_ReadP2PPacket(side Side, pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32, psteamIDRemote *SteamID, nChannel int32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_ReadP2PPacket(getSteamNetworking(side), pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize), (*C.CSteamID)(psteamIDRemote), C.int32(nChannel)))
 }
-func SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(steamIDRemote SteamID) bool {
-	return bool(C.SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(getSteamNetworking(), C.CSteamID(steamIDRemote)))
+func SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(side Side, steamIDRemote SteamID) bool {
+	return bool(C.SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(getSteamNetworking(side), C.CSteamID(steamIDRemote)))
 }
-func SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(steamIDRemote SteamID) bool {
-	return bool(C.SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(getSteamNetworking(), C.CSteamID(steamIDRemote)))
+func SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(side Side, steamIDRemote SteamID) bool {
+	return bool(C.SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(getSteamNetworking(side), C.CSteamID(steamIDRemote)))
 }
-func SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(steamIDRemote SteamID, nChannel int32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(getSteamNetworking(), C.CSteamID(steamIDRemote), C.int32(nChannel)))
+func SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(side Side, steamIDRemote SteamID, nChannel int32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(getSteamNetworking(side), C.CSteamID(steamIDRemote), C.int32(nChannel)))
 }
-func SteamAPI_ISteamNetworking_GetP2PSessionState(steamIDRemote SteamID, pConnectionState *P2PSessionState) bool {
-	return bool(C.SteamAPI_ISteamNetworking_GetP2PSessionState(getSteamNetworking(), C.CSteamID(steamIDRemote), pConnectionState))
+func SteamAPI_ISteamNetworking_GetP2PSessionState(side Side, steamIDRemote SteamID, pConnectionState *P2PSessionState) bool {
+	return bool(C.SteamAPI_ISteamNetworking_GetP2PSessionState(getSteamNetworking(side), C.CSteamID(steamIDRemote), pConnectionState))
 }
-func SteamAPI_ISteamNetworking_AllowP2PPacketRelay(bAllow bool) bool {
-	return bool(C.SteamAPI_ISteamNetworking_AllowP2PPacketRelay(getSteamNetworking(), C.bool(bAllow)))
+func SteamAPI_ISteamNetworking_AllowP2PPacketRelay(side Side, bAllow bool) bool {
+	return bool(C.SteamAPI_ISteamNetworking_AllowP2PPacketRelay(getSteamNetworking(side), C.bool(bAllow)))
 }
-func SteamAPI_ISteamNetworking_CreateListenSocket(nVirtualP2PPort int32, nIP uint32, nPort uint16, bAllowUseOfPacketRelay bool) SNetListenSocket {
-	return C.SteamAPI_ISteamNetworking_CreateListenSocket(getSteamNetworking(), C.int32(nVirtualP2PPort), C.uint32(nIP), C.uint16(nPort), C.bool(bAllowUseOfPacketRelay))
+func SteamAPI_ISteamNetworking_CreateListenSocket(side Side, nVirtualP2PPort int32, nIP uint32, nPort uint16, bAllowUseOfPacketRelay bool) SNetListenSocket {
+	return C.SteamAPI_ISteamNetworking_CreateListenSocket(getSteamNetworking(side), C.int32(nVirtualP2PPort), C.uint32(nIP), C.uint16(nPort), C.bool(bAllowUseOfPacketRelay))
 }
-func SteamAPI_ISteamNetworking_CreateP2PConnectionSocket(steamIDTarget SteamID, nVirtualPort int32, nTimeoutSec int32, bAllowUseOfPacketRelay bool) SNetSocket {
-	return C.SteamAPI_ISteamNetworking_CreateP2PConnectionSocket(getSteamNetworking(), C.CSteamID(steamIDTarget), C.int32(nVirtualPort), C.int32(nTimeoutSec), C.bool(bAllowUseOfPacketRelay))
+func SteamAPI_ISteamNetworking_CreateP2PConnectionSocket(side Side, steamIDTarget SteamID, nVirtualPort int32, nTimeoutSec int32, bAllowUseOfPacketRelay bool) SNetSocket {
+	return C.SteamAPI_ISteamNetworking_CreateP2PConnectionSocket(getSteamNetworking(side), C.CSteamID(steamIDTarget), C.int32(nVirtualPort), C.int32(nTimeoutSec), C.bool(bAllowUseOfPacketRelay))
 }
-func SteamAPI_ISteamNetworking_CreateConnectionSocket(nIP uint32, nPort uint16, nTimeoutSec int32) SNetSocket {
-	return C.SteamAPI_ISteamNetworking_CreateConnectionSocket(getSteamNetworking(), C.uint32(nIP), C.uint16(nPort), C.int32(nTimeoutSec))
+func SteamAPI_ISteamNetworking_CreateConnectionSocket(side Side, nIP uint32, nPort uint16, nTimeoutSec int32) SNetSocket {
+	return C.SteamAPI_ISteamNetworking_CreateConnectionSocket(getSteamNetworking(side), C.uint32(nIP), C.uint16(nPort), C.int32(nTimeoutSec))
 }
-func SteamAPI_ISteamNetworking_DestroySocket(hSocket SNetSocket, bNotifyRemoteEnd bool) bool {
-	return bool(C.SteamAPI_ISteamNetworking_DestroySocket(getSteamNetworking(), hSocket, C.bool(bNotifyRemoteEnd)))
+func SteamAPI_ISteamNetworking_DestroySocket(side Side, hSocket SNetSocket, bNotifyRemoteEnd bool) bool {
+	return bool(C.SteamAPI_ISteamNetworking_DestroySocket(getSteamNetworking(side), hSocket, C.bool(bNotifyRemoteEnd)))
 }
-func SteamAPI_ISteamNetworking_DestroyListenSocket(hSocket SNetListenSocket, bNotifyRemoteEnd bool) bool {
-	return bool(C.SteamAPI_ISteamNetworking_DestroyListenSocket(getSteamNetworking(), hSocket, C.bool(bNotifyRemoteEnd)))
+func SteamAPI_ISteamNetworking_DestroyListenSocket(side Side, hSocket SNetListenSocket, bNotifyRemoteEnd bool) bool {
+	return bool(C.SteamAPI_ISteamNetworking_DestroyListenSocket(getSteamNetworking(side), hSocket, C.bool(bNotifyRemoteEnd)))
 }
-func SteamAPI_ISteamNetworking_SendDataOnSocket(hSocket SNetSocket, pubData unsafe.Pointer, cubData uint32, bReliable bool) bool {
-	return bool(C.SteamAPI_ISteamNetworking_SendDataOnSocket(getSteamNetworking(), hSocket, pubData, C.uint32(cubData), C.bool(bReliable)))
+func SteamAPI_ISteamNetworking_SendDataOnSocket(side Side, hSocket SNetSocket, pubData unsafe.Pointer, cubData uint32, bReliable bool) bool {
+	return bool(C.SteamAPI_ISteamNetworking_SendDataOnSocket(getSteamNetworking(side), hSocket, pubData, C.uint32(cubData), C.bool(bReliable)))
 }
-func SteamAPI_ISteamNetworking_IsDataAvailableOnSocket(hSocket SNetSocket, pcubMsgSize *uint32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_IsDataAvailableOnSocket(getSteamNetworking(), hSocket, (*C.uint32)(pcubMsgSize)))
+func SteamAPI_ISteamNetworking_IsDataAvailableOnSocket(side Side, hSocket SNetSocket, pcubMsgSize *uint32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_IsDataAvailableOnSocket(getSteamNetworking(side), hSocket, (*C.uint32)(pcubMsgSize)))
 }
-func SteamAPI_ISteamNetworking_RetrieveDataFromSocket(hSocket SNetSocket, pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32) bool {
-	return bool(C.SteamAPI_ISteamNetworking_RetrieveDataFromSocket(getSteamNetworking(), hSocket, pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize)))
+func SteamAPI_ISteamNetworking_RetrieveDataFromSocket(side Side, hSocket SNetSocket, pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32) bool {
+	return bool(C.SteamAPI_ISteamNetworking_RetrieveDataFromSocket(getSteamNetworking(side), hSocket, pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize)))
 }
-func SteamAPI_ISteamNetworking_IsDataAvailable(hListenSocket SNetListenSocket, pcubMsgSize *uint32, phSocket *SNetSocket) bool {
-	return bool(C.SteamAPI_ISteamNetworking_IsDataAvailable(getSteamNetworking(), hListenSocket, (*C.uint32)(pcubMsgSize), phSocket))
+func SteamAPI_ISteamNetworking_IsDataAvailable(side Side, hListenSocket SNetListenSocket, pcubMsgSize *uint32, phSocket *SNetSocket) bool {
+	return bool(C.SteamAPI_ISteamNetworking_IsDataAvailable(getSteamNetworking(side), hListenSocket, (*C.uint32)(pcubMsgSize), phSocket))
 }
-func SteamAPI_ISteamNetworking_RetrieveData(hListenSocket SNetListenSocket, pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32, phSocket *SNetSocket) bool {
-	return bool(C.SteamAPI_ISteamNetworking_RetrieveData(getSteamNetworking(), hListenSocket, pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize), phSocket))
+func SteamAPI_ISteamNetworking_RetrieveData(side Side, hListenSocket SNetListenSocket, pubDest unsafe.Pointer, cubDest uint32, pcubMsgSize *uint32, phSocket *SNetSocket) bool {
+	return bool(C.SteamAPI_ISteamNetworking_RetrieveData(getSteamNetworking(side), hListenSocket, pubDest, C.uint32(cubDest), (*C.uint32)(pcubMsgSize), phSocket))
 }
-func SteamAPI_ISteamNetworking_GetSocketInfo(hSocket SNetSocket, pSteamIDRemote *SteamID, peSocketStatus *int32, punIPRemote *uint32, punPortRemote *uint16) bool {
-	return bool(C.SteamAPI_ISteamNetworking_GetSocketInfo(getSteamNetworking(), hSocket, (*C.CSteamID)(pSteamIDRemote), (*C.int32)(peSocketStatus), (*C.uint32)(punIPRemote), (*C.uint16)(punPortRemote)))
+func SteamAPI_ISteamNetworking_GetSocketInfo(side Side, hSocket SNetSocket, pSteamIDRemote *SteamID, peSocketStatus *int32, punIPRemote *uint32, punPortRemote *uint16) bool {
+	return bool(C.SteamAPI_ISteamNetworking_GetSocketInfo(getSteamNetworking(side), hSocket, (*C.CSteamID)(pSteamIDRemote), (*C.int32)(peSocketStatus), (*C.uint32)(punIPRemote), (*C.uint16)(punPortRemote)))
 }
-func SteamAPI_ISteamNetworking_GetListenSocketInfo(hListenSocket SNetListenSocket, pnIP *uint32, pnPort *uint16) bool {
-	return bool(C.SteamAPI_ISteamNetworking_GetListenSocketInfo(getSteamNetworking(), hListenSocket, (*C.uint32)(pnIP), (*C.uint16)(pnPort)))
+func SteamAPI_ISteamNetworking_GetListenSocketInfo(side Side, hListenSocket SNetListenSocket, pnIP *uint32, pnPort *uint16) bool {
+	return bool(C.SteamAPI_ISteamNetworking_GetListenSocketInfo(getSteamNetworking(side), hListenSocket, (*C.uint32)(pnIP), (*C.uint16)(pnPort)))
 }
-func SteamAPI_ISteamNetworking_GetSocketConnectionType(hSocket SNetSocket) ESNetSocketConnectionType {
-	return ESNetSocketConnectionType(C.SteamAPI_ISteamNetworking_GetSocketConnectionType(getSteamNetworking(), hSocket))
+func SteamAPI_ISteamNetworking_GetSocketConnectionType(side Side, hSocket SNetSocket) ESNetSocketConnectionType {
+	return ESNetSocketConnectionType(C.SteamAPI_ISteamNetworking_GetSocketConnectionType(getSteamNetworking(side), hSocket))
 }
-func SteamAPI_ISteamNetworking_GetMaxPacketSize(hSocket SNetSocket) int32 {
-	return int32(C.SteamAPI_ISteamNetworking_GetMaxPacketSize(getSteamNetworking(), hSocket))
+func SteamAPI_ISteamNetworking_GetMaxPacketSize(side Side, hSocket SNetSocket) int32 {
+	return int32(C.SteamAPI_ISteamNetworking_GetMaxPacketSize(getSteamNetworking(side), hSocket))
 }
 func SteamAPI_ISteamScreenshots_WriteScreenshot(pubRGB unsafe.Pointer, cubRGB uint32, nWidth int32, nHeight int32) ScreenshotHandle {
 	return C.SteamAPI_ISteamScreenshots_WriteScreenshot(getSteamScreenshots(), pubRGB, C.uint32(cubRGB), C.int32(nWidth), C.int32(nHeight))
@@ -3008,80 +1588,80 @@ func SteamAPI_ISteamMusicRemote_SetCurrentPlaylistEntry(nID int32) bool {
 func SteamAPI_ISteamMusicRemote_PlaylistDidChange() bool {
 	return bool(C.SteamAPI_ISteamMusicRemote_PlaylistDidChange(getSteamMusicRemote()))
 }
-func SteamAPI_ISteamHTTP_CreateHTTPRequest(eHTTPRequestMethod EHTTPMethod, pchAbsoluteURL *C.char) HTTPRequestHandle {
-	return C.SteamAPI_ISteamHTTP_CreateHTTPRequest(getSteamHTTP(), C.EHTTPMethod(eHTTPRequestMethod), pchAbsoluteURL)
+func SteamAPI_ISteamHTTP_CreateHTTPRequest(side Side, eHTTPRequestMethod EHTTPMethod, pchAbsoluteURL *C.char) HTTPRequestHandle {
+	return C.SteamAPI_ISteamHTTP_CreateHTTPRequest(getSteamHTTP(side), C.EHTTPMethod(eHTTPRequestMethod), pchAbsoluteURL)
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestContextValue(hRequest HTTPRequestHandle, ulContextValue uint64) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestContextValue(getSteamHTTP(), hRequest, C.uint64(ulContextValue)))
+func SteamAPI_ISteamHTTP_SetHTTPRequestContextValue(side Side, hRequest HTTPRequestHandle, ulContextValue uint64) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestContextValue(getSteamHTTP(side), hRequest, C.uint64(ulContextValue)))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestNetworkActivityTimeout(hRequest HTTPRequestHandle, unTimeoutSeconds uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestNetworkActivityTimeout(getSteamHTTP(), hRequest, C.uint32(unTimeoutSeconds)))
+func SteamAPI_ISteamHTTP_SetHTTPRequestNetworkActivityTimeout(side Side, hRequest HTTPRequestHandle, unTimeoutSeconds uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestNetworkActivityTimeout(getSteamHTTP(side), hRequest, C.uint32(unTimeoutSeconds)))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestHeaderValue(hRequest HTTPRequestHandle, pchHeaderName *C.char, pchHeaderValue *C.char) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestHeaderValue(getSteamHTTP(), hRequest, pchHeaderName, pchHeaderValue))
+func SteamAPI_ISteamHTTP_SetHTTPRequestHeaderValue(side Side, hRequest HTTPRequestHandle, pchHeaderName *C.char, pchHeaderValue *C.char) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestHeaderValue(getSteamHTTP(side), hRequest, pchHeaderName, pchHeaderValue))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestGetOrPostParameter(hRequest HTTPRequestHandle, pchParamName *C.char, pchParamValue *C.char) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestGetOrPostParameter(getSteamHTTP(), hRequest, pchParamName, pchParamValue))
+func SteamAPI_ISteamHTTP_SetHTTPRequestGetOrPostParameter(side Side, hRequest HTTPRequestHandle, pchParamName *C.char, pchParamValue *C.char) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestGetOrPostParameter(getSteamHTTP(side), hRequest, pchParamName, pchParamValue))
 }
-func SteamAPI_ISteamHTTP_SendHTTPRequest(hRequest HTTPRequestHandle, pCallHandle *SteamAPICall) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SendHTTPRequest(getSteamHTTP(), hRequest, pCallHandle))
+func SteamAPI_ISteamHTTP_SendHTTPRequest(side Side, hRequest HTTPRequestHandle, pCallHandle *SteamAPICall) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SendHTTPRequest(getSteamHTTP(side), hRequest, pCallHandle))
 }
-func SteamAPI_ISteamHTTP_SendHTTPRequestAndStreamResponse(hRequest HTTPRequestHandle, pCallHandle *SteamAPICall) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SendHTTPRequestAndStreamResponse(getSteamHTTP(), hRequest, pCallHandle))
+func SteamAPI_ISteamHTTP_SendHTTPRequestAndStreamResponse(side Side, hRequest HTTPRequestHandle, pCallHandle *SteamAPICall) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SendHTTPRequestAndStreamResponse(getSteamHTTP(side), hRequest, pCallHandle))
 }
-func SteamAPI_ISteamHTTP_DeferHTTPRequest(hRequest HTTPRequestHandle) bool {
-	return bool(C.SteamAPI_ISteamHTTP_DeferHTTPRequest(getSteamHTTP(), hRequest))
+func SteamAPI_ISteamHTTP_DeferHTTPRequest(side Side, hRequest HTTPRequestHandle) bool {
+	return bool(C.SteamAPI_ISteamHTTP_DeferHTTPRequest(getSteamHTTP(side), hRequest))
 }
-func SteamAPI_ISteamHTTP_PrioritizeHTTPRequest(hRequest HTTPRequestHandle) bool {
-	return bool(C.SteamAPI_ISteamHTTP_PrioritizeHTTPRequest(getSteamHTTP(), hRequest))
+func SteamAPI_ISteamHTTP_PrioritizeHTTPRequest(side Side, hRequest HTTPRequestHandle) bool {
+	return bool(C.SteamAPI_ISteamHTTP_PrioritizeHTTPRequest(getSteamHTTP(side), hRequest))
 }
-func SteamAPI_ISteamHTTP_GetHTTPResponseHeaderSize(hRequest HTTPRequestHandle, pchHeaderName *C.char, unResponseHeaderSize *uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseHeaderSize(getSteamHTTP(), hRequest, pchHeaderName, (*C.uint32)(unResponseHeaderSize)))
+func SteamAPI_ISteamHTTP_GetHTTPResponseHeaderSize(side Side, hRequest HTTPRequestHandle, pchHeaderName *C.char, unResponseHeaderSize *uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseHeaderSize(getSteamHTTP(side), hRequest, pchHeaderName, (*C.uint32)(unResponseHeaderSize)))
 }
-func SteamAPI_ISteamHTTP_GetHTTPResponseHeaderValue(hRequest HTTPRequestHandle, pchHeaderName *C.char, pHeaderValueBuffer *uint8, unBufferSize uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseHeaderValue(getSteamHTTP(), hRequest, pchHeaderName, (*C.uint8)(pHeaderValueBuffer), C.uint32(unBufferSize)))
+func SteamAPI_ISteamHTTP_GetHTTPResponseHeaderValue(side Side, hRequest HTTPRequestHandle, pchHeaderName *C.char, pHeaderValueBuffer *uint8, unBufferSize uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseHeaderValue(getSteamHTTP(side), hRequest, pchHeaderName, (*C.uint8)(pHeaderValueBuffer), C.uint32(unBufferSize)))
 }
-func SteamAPI_ISteamHTTP_GetHTTPResponseBodySize(hRequest HTTPRequestHandle, unBodySize *uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseBodySize(getSteamHTTP(), hRequest, (*C.uint32)(unBodySize)))
+func SteamAPI_ISteamHTTP_GetHTTPResponseBodySize(side Side, hRequest HTTPRequestHandle, unBodySize *uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseBodySize(getSteamHTTP(side), hRequest, (*C.uint32)(unBodySize)))
 }
-func SteamAPI_ISteamHTTP_GetHTTPResponseBodyData(hRequest HTTPRequestHandle, pBodyDataBuffer *uint8, unBufferSize uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseBodyData(getSteamHTTP(), hRequest, (*C.uint8)(pBodyDataBuffer), C.uint32(unBufferSize)))
+func SteamAPI_ISteamHTTP_GetHTTPResponseBodyData(side Side, hRequest HTTPRequestHandle, pBodyDataBuffer *uint8, unBufferSize uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPResponseBodyData(getSteamHTTP(side), hRequest, (*C.uint8)(pBodyDataBuffer), C.uint32(unBufferSize)))
 }
-func SteamAPI_ISteamHTTP_GetHTTPStreamingResponseBodyData(hRequest HTTPRequestHandle, cOffset uint32, pBodyDataBuffer *uint8, unBufferSize uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPStreamingResponseBodyData(getSteamHTTP(), hRequest, C.uint32(cOffset), (*C.uint8)(pBodyDataBuffer), C.uint32(unBufferSize)))
+func SteamAPI_ISteamHTTP_GetHTTPStreamingResponseBodyData(side Side, hRequest HTTPRequestHandle, cOffset uint32, pBodyDataBuffer *uint8, unBufferSize uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPStreamingResponseBodyData(getSteamHTTP(side), hRequest, C.uint32(cOffset), (*C.uint8)(pBodyDataBuffer), C.uint32(unBufferSize)))
 }
-func SteamAPI_ISteamHTTP_ReleaseHTTPRequest(hRequest HTTPRequestHandle) bool {
-	return bool(C.SteamAPI_ISteamHTTP_ReleaseHTTPRequest(getSteamHTTP(), hRequest))
+func SteamAPI_ISteamHTTP_ReleaseHTTPRequest(side Side, hRequest HTTPRequestHandle) bool {
+	return bool(C.SteamAPI_ISteamHTTP_ReleaseHTTPRequest(getSteamHTTP(side), hRequest))
 }
-func SteamAPI_ISteamHTTP_GetHTTPDownloadProgressPct(hRequest HTTPRequestHandle, pflPercentOut *float32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPDownloadProgressPct(getSteamHTTP(), hRequest, (*C.float)(pflPercentOut)))
+func SteamAPI_ISteamHTTP_GetHTTPDownloadProgressPct(side Side, hRequest HTTPRequestHandle, pflPercentOut *float32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPDownloadProgressPct(getSteamHTTP(side), hRequest, (*C.float)(pflPercentOut)))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestRawPostBody(hRequest HTTPRequestHandle, pchContentType *C.char, pubBody *uint8, unBodyLen uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestRawPostBody(getSteamHTTP(), hRequest, pchContentType, (*C.uint8)(pubBody), C.uint32(unBodyLen)))
+func SteamAPI_ISteamHTTP_SetHTTPRequestRawPostBody(side Side, hRequest HTTPRequestHandle, pchContentType *C.char, pubBody *uint8, unBodyLen uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestRawPostBody(getSteamHTTP(side), hRequest, pchContentType, (*C.uint8)(pubBody), C.uint32(unBodyLen)))
 }
-func SteamAPI_ISteamHTTP_CreateCookieContainer(bAllowResponsesToModify bool) HTTPCookieContainerHandle {
-	return C.SteamAPI_ISteamHTTP_CreateCookieContainer(getSteamHTTP(), C.bool(bAllowResponsesToModify))
+func SteamAPI_ISteamHTTP_CreateCookieContainer(side Side, bAllowResponsesToModify bool) HTTPCookieContainerHandle {
+	return C.SteamAPI_ISteamHTTP_CreateCookieContainer(getSteamHTTP(side), C.bool(bAllowResponsesToModify))
 }
-func SteamAPI_ISteamHTTP_ReleaseCookieContainer(hCookieContainer HTTPCookieContainerHandle) bool {
-	return bool(C.SteamAPI_ISteamHTTP_ReleaseCookieContainer(getSteamHTTP(), hCookieContainer))
+func SteamAPI_ISteamHTTP_ReleaseCookieContainer(side Side, hCookieContainer HTTPCookieContainerHandle) bool {
+	return bool(C.SteamAPI_ISteamHTTP_ReleaseCookieContainer(getSteamHTTP(side), hCookieContainer))
 }
-func SteamAPI_ISteamHTTP_SetCookie(hCookieContainer HTTPCookieContainerHandle, pchHost *C.char, pchUrl *C.char, pchCookie *C.char) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetCookie(getSteamHTTP(), hCookieContainer, pchHost, pchUrl, pchCookie))
+func SteamAPI_ISteamHTTP_SetCookie(side Side, hCookieContainer HTTPCookieContainerHandle, pchHost *C.char, pchUrl *C.char, pchCookie *C.char) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetCookie(getSteamHTTP(side), hCookieContainer, pchHost, pchUrl, pchCookie))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestCookieContainer(hRequest HTTPRequestHandle, hCookieContainer HTTPCookieContainerHandle) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestCookieContainer(getSteamHTTP(), hRequest, hCookieContainer))
+func SteamAPI_ISteamHTTP_SetHTTPRequestCookieContainer(side Side, hRequest HTTPRequestHandle, hCookieContainer HTTPCookieContainerHandle) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestCookieContainer(getSteamHTTP(side), hRequest, hCookieContainer))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestUserAgentInfo(hRequest HTTPRequestHandle, pchUserAgentInfo *C.char) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestUserAgentInfo(getSteamHTTP(), hRequest, pchUserAgentInfo))
+func SteamAPI_ISteamHTTP_SetHTTPRequestUserAgentInfo(side Side, hRequest HTTPRequestHandle, pchUserAgentInfo *C.char) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestUserAgentInfo(getSteamHTTP(side), hRequest, pchUserAgentInfo))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestRequiresVerifiedCertificate(hRequest HTTPRequestHandle, bRequireVerifiedCertificate bool) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestRequiresVerifiedCertificate(getSteamHTTP(), hRequest, C.bool(bRequireVerifiedCertificate)))
+func SteamAPI_ISteamHTTP_SetHTTPRequestRequiresVerifiedCertificate(side Side, hRequest HTTPRequestHandle, bRequireVerifiedCertificate bool) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestRequiresVerifiedCertificate(getSteamHTTP(side), hRequest, C.bool(bRequireVerifiedCertificate)))
 }
-func SteamAPI_ISteamHTTP_SetHTTPRequestAbsoluteTimeoutMS(hRequest HTTPRequestHandle, unMilliseconds uint32) bool {
-	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestAbsoluteTimeoutMS(getSteamHTTP(), hRequest, C.uint32(unMilliseconds)))
+func SteamAPI_ISteamHTTP_SetHTTPRequestAbsoluteTimeoutMS(side Side, hRequest HTTPRequestHandle, unMilliseconds uint32) bool {
+	return bool(C.SteamAPI_ISteamHTTP_SetHTTPRequestAbsoluteTimeoutMS(getSteamHTTP(side), hRequest, C.uint32(unMilliseconds)))
 }
-func SteamAPI_ISteamHTTP_GetHTTPRequestWasTimedOut(hRequest HTTPRequestHandle, pbWasTimedOut *bool) bool {
-	return bool(C.SteamAPI_ISteamHTTP_GetHTTPRequestWasTimedOut(getSteamHTTP(), hRequest, (*C.bool)(pbWasTimedOut)))
+func SteamAPI_ISteamHTTP_GetHTTPRequestWasTimedOut(side Side, hRequest HTTPRequestHandle, pbWasTimedOut *bool) bool {
+	return bool(C.SteamAPI_ISteamHTTP_GetHTTPRequestWasTimedOut(getSteamHTTP(side), hRequest, (*C.bool)(pbWasTimedOut)))
 }
 func SteamAPI_ISteamController_Init() bool {
 	return bool(C.SteamAPI_ISteamController_Init(getSteamController()))
@@ -3176,227 +1756,227 @@ func SteamAPI_ISteamController_GetGlyphForActionOrigin(eOrigin EControllerAction
 func SteamAPI_ISteamController_GetInputTypeForHandle(controllerHandle ControllerHandle) ESteamInputType {
 	return ESteamInputType(C.SteamAPI_ISteamController_GetInputTypeForHandle(getSteamController(), controllerHandle))
 }
-func SteamAPI_ISteamUGC_CreateQueryUserUGCRequest(unAccountID AccountID, eListType EUserUGCList, eMatchingUGCType EUGCMatchingUGCType, eSortOrder EUserUGCListSortOrder, nCreatorAppID AppId, nConsumerAppID AppId, unPage uint32) UGCQueryHandle {
-	return C.SteamAPI_ISteamUGC_CreateQueryUserUGCRequest(getSteamUGC(), unAccountID, C.EUserUGCList(eListType), C.EUGCMatchingUGCType(eMatchingUGCType), C.EUserUGCListSortOrder(eSortOrder), nCreatorAppID, nConsumerAppID, C.uint32(unPage))
+func SteamAPI_ISteamUGC_CreateQueryUserUGCRequest(side Side, unAccountID AccountID, eListType EUserUGCList, eMatchingUGCType EUGCMatchingUGCType, eSortOrder EUserUGCListSortOrder, nCreatorAppID AppId, nConsumerAppID AppId, unPage uint32) UGCQueryHandle {
+	return C.SteamAPI_ISteamUGC_CreateQueryUserUGCRequest(getSteamUGC(side), unAccountID, C.EUserUGCList(eListType), C.EUGCMatchingUGCType(eMatchingUGCType), C.EUserUGCListSortOrder(eSortOrder), nCreatorAppID, nConsumerAppID, C.uint32(unPage))
 }
-func SteamAPI_ISteamUGC_CreateQueryAllUGCRequest(eQueryType EUGCQuery, eMatchingeMatchingUGCTypeFileType EUGCMatchingUGCType, nCreatorAppID AppId, nConsumerAppID AppId, unPage uint32) UGCQueryHandle {
-	return C.SteamAPI_ISteamUGC_CreateQueryAllUGCRequest(getSteamUGC(), C.EUGCQuery(eQueryType), C.EUGCMatchingUGCType(eMatchingeMatchingUGCTypeFileType), nCreatorAppID, nConsumerAppID, C.uint32(unPage))
+func SteamAPI_ISteamUGC_CreateQueryAllUGCRequest(side Side, eQueryType EUGCQuery, eMatchingeMatchingUGCTypeFileType EUGCMatchingUGCType, nCreatorAppID AppId, nConsumerAppID AppId, unPage uint32) UGCQueryHandle {
+	return C.SteamAPI_ISteamUGC_CreateQueryAllUGCRequest(getSteamUGC(side), C.EUGCQuery(eQueryType), C.EUGCMatchingUGCType(eMatchingeMatchingUGCTypeFileType), nCreatorAppID, nConsumerAppID, C.uint32(unPage))
 }
-func SteamAPI_ISteamUGC_CreateQueryUGCDetailsRequest(pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) UGCQueryHandle {
-	return C.SteamAPI_ISteamUGC_CreateQueryUGCDetailsRequest(getSteamUGC(), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
+func SteamAPI_ISteamUGC_CreateQueryUGCDetailsRequest(side Side, pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) UGCQueryHandle {
+	return C.SteamAPI_ISteamUGC_CreateQueryUGCDetailsRequest(getSteamUGC(side), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
 }
-func SteamAPI_ISteamUGC_SendQueryUGCRequest(handle UGCQueryHandle) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_SendQueryUGCRequest(getSteamUGC(), handle)
+func SteamAPI_ISteamUGC_SendQueryUGCRequest(side Side, handle UGCQueryHandle) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_SendQueryUGCRequest(getSteamUGC(side), handle)
 }
-func SteamAPI_ISteamUGC_GetQueryUGCResult(handle UGCQueryHandle, index uint32, pDetails *SteamUGCDetails) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCResult(getSteamUGC(), handle, C.uint32(index), pDetails))
+func SteamAPI_ISteamUGC_GetQueryUGCResult(side Side, handle UGCQueryHandle, index uint32, pDetails *SteamUGCDetails) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCResult(getSteamUGC(side), handle, C.uint32(index), pDetails))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCPreviewURL(handle UGCQueryHandle, index uint32, pchURL *C.char, cchURLSize uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCPreviewURL(getSteamUGC(), handle, C.uint32(index), pchURL, C.uint32(cchURLSize)))
+func SteamAPI_ISteamUGC_GetQueryUGCPreviewURL(side Side, handle UGCQueryHandle, index uint32, pchURL *C.char, cchURLSize uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCPreviewURL(getSteamUGC(side), handle, C.uint32(index), pchURL, C.uint32(cchURLSize)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCMetadata(handle UGCQueryHandle, index uint32, pchMetadata *C.char, cchMetadatasize uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCMetadata(getSteamUGC(), handle, C.uint32(index), pchMetadata, C.uint32(cchMetadatasize)))
+func SteamAPI_ISteamUGC_GetQueryUGCMetadata(side Side, handle UGCQueryHandle, index uint32, pchMetadata *C.char, cchMetadatasize uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCMetadata(getSteamUGC(side), handle, C.uint32(index), pchMetadata, C.uint32(cchMetadatasize)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCChildren(handle UGCQueryHandle, index uint32, pvecPublishedFileID *PublishedFileId, cMaxEntries uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCChildren(getSteamUGC(), handle, C.uint32(index), pvecPublishedFileID, C.uint32(cMaxEntries)))
+func SteamAPI_ISteamUGC_GetQueryUGCChildren(side Side, handle UGCQueryHandle, index uint32, pvecPublishedFileID *PublishedFileId, cMaxEntries uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCChildren(getSteamUGC(side), handle, C.uint32(index), pvecPublishedFileID, C.uint32(cMaxEntries)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCStatistic(handle UGCQueryHandle, index uint32, eStatType EItemStatistic, pStatValue *uint64) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCStatistic(getSteamUGC(), handle, C.uint32(index), C.EItemStatistic(eStatType), (*C.uint64)(pStatValue)))
+func SteamAPI_ISteamUGC_GetQueryUGCStatistic(side Side, handle UGCQueryHandle, index uint32, eStatType EItemStatistic, pStatValue *uint64) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCStatistic(getSteamUGC(side), handle, C.uint32(index), C.EItemStatistic(eStatType), (*C.uint64)(pStatValue)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCNumAdditionalPreviews(handle UGCQueryHandle, index uint32) uint32 {
-	return uint32(C.SteamAPI_ISteamUGC_GetQueryUGCNumAdditionalPreviews(getSteamUGC(), handle, C.uint32(index)))
+func SteamAPI_ISteamUGC_GetQueryUGCNumAdditionalPreviews(side Side, handle UGCQueryHandle, index uint32) uint32 {
+	return uint32(C.SteamAPI_ISteamUGC_GetQueryUGCNumAdditionalPreviews(getSteamUGC(side), handle, C.uint32(index)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCAdditionalPreview(handle UGCQueryHandle, index uint32, previewIndex uint32, pchURLOrVideoID *C.char, cchURLSize uint32, pchOriginalFileName *C.char, cchOriginalFileNameSize uint32, pPreviewType *EItemPreviewType) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCAdditionalPreview(getSteamUGC(), handle, C.uint32(index), C.uint32(previewIndex), pchURLOrVideoID, C.uint32(cchURLSize), pchOriginalFileName, C.uint32(cchOriginalFileNameSize), (*C.EItemPreviewType)(pPreviewType)))
+func SteamAPI_ISteamUGC_GetQueryUGCAdditionalPreview(side Side, handle UGCQueryHandle, index uint32, previewIndex uint32, pchURLOrVideoID *C.char, cchURLSize uint32, pchOriginalFileName *C.char, cchOriginalFileNameSize uint32, pPreviewType *EItemPreviewType) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCAdditionalPreview(getSteamUGC(side), handle, C.uint32(index), C.uint32(previewIndex), pchURLOrVideoID, C.uint32(cchURLSize), pchOriginalFileName, C.uint32(cchOriginalFileNameSize), (*C.EItemPreviewType)(pPreviewType)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCNumKeyValueTags(handle UGCQueryHandle, index uint32) uint32 {
-	return uint32(C.SteamAPI_ISteamUGC_GetQueryUGCNumKeyValueTags(getSteamUGC(), handle, C.uint32(index)))
+func SteamAPI_ISteamUGC_GetQueryUGCNumKeyValueTags(side Side, handle UGCQueryHandle, index uint32) uint32 {
+	return uint32(C.SteamAPI_ISteamUGC_GetQueryUGCNumKeyValueTags(getSteamUGC(side), handle, C.uint32(index)))
 }
-func SteamAPI_ISteamUGC_GetQueryUGCKeyValueTag(handle UGCQueryHandle, index uint32, keyValueTagIndex uint32, pchKey *C.char, cchKeySize uint32, pchValue *C.char, cchValueSize uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCKeyValueTag(getSteamUGC(), handle, C.uint32(index), C.uint32(keyValueTagIndex), pchKey, C.uint32(cchKeySize), pchValue, C.uint32(cchValueSize)))
+func SteamAPI_ISteamUGC_GetQueryUGCKeyValueTag(side Side, handle UGCQueryHandle, index uint32, keyValueTagIndex uint32, pchKey *C.char, cchKeySize uint32, pchValue *C.char, cchValueSize uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetQueryUGCKeyValueTag(getSteamUGC(side), handle, C.uint32(index), C.uint32(keyValueTagIndex), pchKey, C.uint32(cchKeySize), pchValue, C.uint32(cchValueSize)))
 }
-func SteamAPI_ISteamUGC_ReleaseQueryUGCRequest(handle UGCQueryHandle) bool {
-	return bool(C.SteamAPI_ISteamUGC_ReleaseQueryUGCRequest(getSteamUGC(), handle))
+func SteamAPI_ISteamUGC_ReleaseQueryUGCRequest(side Side, handle UGCQueryHandle) bool {
+	return bool(C.SteamAPI_ISteamUGC_ReleaseQueryUGCRequest(getSteamUGC(side), handle))
 }
-func SteamAPI_ISteamUGC_AddRequiredTag(handle UGCQueryHandle, pTagName *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddRequiredTag(getSteamUGC(), handle, pTagName))
+func SteamAPI_ISteamUGC_AddRequiredTag(side Side, handle UGCQueryHandle, pTagName *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddRequiredTag(getSteamUGC(side), handle, pTagName))
 }
-func SteamAPI_ISteamUGC_AddExcludedTag(handle UGCQueryHandle, pTagName *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddExcludedTag(getSteamUGC(), handle, pTagName))
+func SteamAPI_ISteamUGC_AddExcludedTag(side Side, handle UGCQueryHandle, pTagName *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddExcludedTag(getSteamUGC(side), handle, pTagName))
 }
-func SteamAPI_ISteamUGC_SetReturnOnlyIDs(handle UGCQueryHandle, bReturnOnlyIDs bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnOnlyIDs(getSteamUGC(), handle, C.bool(bReturnOnlyIDs)))
+func SteamAPI_ISteamUGC_SetReturnOnlyIDs(side Side, handle UGCQueryHandle, bReturnOnlyIDs bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnOnlyIDs(getSteamUGC(side), handle, C.bool(bReturnOnlyIDs)))
 }
-func SteamAPI_ISteamUGC_SetReturnKeyValueTags(handle UGCQueryHandle, bReturnKeyValueTags bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnKeyValueTags(getSteamUGC(), handle, C.bool(bReturnKeyValueTags)))
+func SteamAPI_ISteamUGC_SetReturnKeyValueTags(side Side, handle UGCQueryHandle, bReturnKeyValueTags bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnKeyValueTags(getSteamUGC(side), handle, C.bool(bReturnKeyValueTags)))
 }
-func SteamAPI_ISteamUGC_SetReturnLongDescription(handle UGCQueryHandle, bReturnLongDescription bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnLongDescription(getSteamUGC(), handle, C.bool(bReturnLongDescription)))
+func SteamAPI_ISteamUGC_SetReturnLongDescription(side Side, handle UGCQueryHandle, bReturnLongDescription bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnLongDescription(getSteamUGC(side), handle, C.bool(bReturnLongDescription)))
 }
-func SteamAPI_ISteamUGC_SetReturnMetadata(handle UGCQueryHandle, bReturnMetadata bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnMetadata(getSteamUGC(), handle, C.bool(bReturnMetadata)))
+func SteamAPI_ISteamUGC_SetReturnMetadata(side Side, handle UGCQueryHandle, bReturnMetadata bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnMetadata(getSteamUGC(side), handle, C.bool(bReturnMetadata)))
 }
-func SteamAPI_ISteamUGC_SetReturnChildren(handle UGCQueryHandle, bReturnChildren bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnChildren(getSteamUGC(), handle, C.bool(bReturnChildren)))
+func SteamAPI_ISteamUGC_SetReturnChildren(side Side, handle UGCQueryHandle, bReturnChildren bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnChildren(getSteamUGC(side), handle, C.bool(bReturnChildren)))
 }
-func SteamAPI_ISteamUGC_SetReturnAdditionalPreviews(handle UGCQueryHandle, bReturnAdditionalPreviews bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnAdditionalPreviews(getSteamUGC(), handle, C.bool(bReturnAdditionalPreviews)))
+func SteamAPI_ISteamUGC_SetReturnAdditionalPreviews(side Side, handle UGCQueryHandle, bReturnAdditionalPreviews bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnAdditionalPreviews(getSteamUGC(side), handle, C.bool(bReturnAdditionalPreviews)))
 }
-func SteamAPI_ISteamUGC_SetReturnTotalOnly(handle UGCQueryHandle, bReturnTotalOnly bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnTotalOnly(getSteamUGC(), handle, C.bool(bReturnTotalOnly)))
+func SteamAPI_ISteamUGC_SetReturnTotalOnly(side Side, handle UGCQueryHandle, bReturnTotalOnly bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnTotalOnly(getSteamUGC(side), handle, C.bool(bReturnTotalOnly)))
 }
-func SteamAPI_ISteamUGC_SetReturnPlaytimeStats(handle UGCQueryHandle, unDays uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetReturnPlaytimeStats(getSteamUGC(), handle, C.uint32(unDays)))
+func SteamAPI_ISteamUGC_SetReturnPlaytimeStats(side Side, handle UGCQueryHandle, unDays uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetReturnPlaytimeStats(getSteamUGC(side), handle, C.uint32(unDays)))
 }
-func SteamAPI_ISteamUGC_SetLanguage(handle UGCQueryHandle, pchLanguage *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetLanguage(getSteamUGC(), handle, pchLanguage))
+func SteamAPI_ISteamUGC_SetLanguage(side Side, handle UGCQueryHandle, pchLanguage *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetLanguage(getSteamUGC(side), handle, pchLanguage))
 }
-func SteamAPI_ISteamUGC_SetAllowCachedResponse(handle UGCQueryHandle, unMaxAgeSeconds uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetAllowCachedResponse(getSteamUGC(), handle, C.uint32(unMaxAgeSeconds)))
+func SteamAPI_ISteamUGC_SetAllowCachedResponse(side Side, handle UGCQueryHandle, unMaxAgeSeconds uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetAllowCachedResponse(getSteamUGC(side), handle, C.uint32(unMaxAgeSeconds)))
 }
-func SteamAPI_ISteamUGC_SetCloudFileNameFilter(handle UGCQueryHandle, pMatchCloudFileName *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetCloudFileNameFilter(getSteamUGC(), handle, pMatchCloudFileName))
+func SteamAPI_ISteamUGC_SetCloudFileNameFilter(side Side, handle UGCQueryHandle, pMatchCloudFileName *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetCloudFileNameFilter(getSteamUGC(side), handle, pMatchCloudFileName))
 }
-func SteamAPI_ISteamUGC_SetMatchAnyTag(handle UGCQueryHandle, bMatchAnyTag bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetMatchAnyTag(getSteamUGC(), handle, C.bool(bMatchAnyTag)))
+func SteamAPI_ISteamUGC_SetMatchAnyTag(side Side, handle UGCQueryHandle, bMatchAnyTag bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetMatchAnyTag(getSteamUGC(side), handle, C.bool(bMatchAnyTag)))
 }
-func SteamAPI_ISteamUGC_SetSearchText(handle UGCQueryHandle, pSearchText *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetSearchText(getSteamUGC(), handle, pSearchText))
+func SteamAPI_ISteamUGC_SetSearchText(side Side, handle UGCQueryHandle, pSearchText *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetSearchText(getSteamUGC(side), handle, pSearchText))
 }
-func SteamAPI_ISteamUGC_SetRankedByTrendDays(handle UGCQueryHandle, unDays uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetRankedByTrendDays(getSteamUGC(), handle, C.uint32(unDays)))
+func SteamAPI_ISteamUGC_SetRankedByTrendDays(side Side, handle UGCQueryHandle, unDays uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetRankedByTrendDays(getSteamUGC(side), handle, C.uint32(unDays)))
 }
-func SteamAPI_ISteamUGC_AddRequiredKeyValueTag(handle UGCQueryHandle, pKey *C.char, pValue *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddRequiredKeyValueTag(getSteamUGC(), handle, pKey, pValue))
+func SteamAPI_ISteamUGC_AddRequiredKeyValueTag(side Side, handle UGCQueryHandle, pKey *C.char, pValue *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddRequiredKeyValueTag(getSteamUGC(side), handle, pKey, pValue))
 }
-func SteamAPI_ISteamUGC_RequestUGCDetails(nPublishedFileID PublishedFileId, unMaxAgeSeconds uint32) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_RequestUGCDetails(getSteamUGC(), nPublishedFileID, C.uint32(unMaxAgeSeconds))
+func SteamAPI_ISteamUGC_RequestUGCDetails(side Side, nPublishedFileID PublishedFileId, unMaxAgeSeconds uint32) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_RequestUGCDetails(getSteamUGC(side), nPublishedFileID, C.uint32(unMaxAgeSeconds))
 }
-func SteamAPI_ISteamUGC_CreateItem(nConsumerAppId AppId, eFileType EWorkshopFileType) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_CreateItem(getSteamUGC(), nConsumerAppId, C.EWorkshopFileType(eFileType))
+func SteamAPI_ISteamUGC_CreateItem(side Side, nConsumerAppId AppId, eFileType EWorkshopFileType) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_CreateItem(getSteamUGC(side), nConsumerAppId, C.EWorkshopFileType(eFileType))
 }
-func SteamAPI_ISteamUGC_StartItemUpdate(nConsumerAppId AppId, nPublishedFileID PublishedFileId) UGCUpdateHandle {
-	return C.SteamAPI_ISteamUGC_StartItemUpdate(getSteamUGC(), nConsumerAppId, nPublishedFileID)
+func SteamAPI_ISteamUGC_StartItemUpdate(side Side, nConsumerAppId AppId, nPublishedFileID PublishedFileId) UGCUpdateHandle {
+	return C.SteamAPI_ISteamUGC_StartItemUpdate(getSteamUGC(side), nConsumerAppId, nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_SetItemTitle(handle UGCUpdateHandle, pchTitle *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemTitle(getSteamUGC(), handle, pchTitle))
+func SteamAPI_ISteamUGC_SetItemTitle(side Side, handle UGCUpdateHandle, pchTitle *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemTitle(getSteamUGC(side), handle, pchTitle))
 }
-func SteamAPI_ISteamUGC_SetItemDescription(handle UGCUpdateHandle, pchDescription *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemDescription(getSteamUGC(), handle, pchDescription))
+func SteamAPI_ISteamUGC_SetItemDescription(side Side, handle UGCUpdateHandle, pchDescription *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemDescription(getSteamUGC(side), handle, pchDescription))
 }
-func SteamAPI_ISteamUGC_SetItemUpdateLanguage(handle UGCUpdateHandle, pchLanguage *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemUpdateLanguage(getSteamUGC(), handle, pchLanguage))
+func SteamAPI_ISteamUGC_SetItemUpdateLanguage(side Side, handle UGCUpdateHandle, pchLanguage *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemUpdateLanguage(getSteamUGC(side), handle, pchLanguage))
 }
-func SteamAPI_ISteamUGC_SetItemMetadata(handle UGCUpdateHandle, pchMetaData *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemMetadata(getSteamUGC(), handle, pchMetaData))
+func SteamAPI_ISteamUGC_SetItemMetadata(side Side, handle UGCUpdateHandle, pchMetaData *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemMetadata(getSteamUGC(side), handle, pchMetaData))
 }
-func SteamAPI_ISteamUGC_SetItemVisibility(handle UGCUpdateHandle, eVisibility ERemoteStoragePublishedFileVisibility) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemVisibility(getSteamUGC(), handle, C.ERemoteStoragePublishedFileVisibility(eVisibility)))
+func SteamAPI_ISteamUGC_SetItemVisibility(side Side, handle UGCUpdateHandle, eVisibility ERemoteStoragePublishedFileVisibility) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemVisibility(getSteamUGC(side), handle, C.ERemoteStoragePublishedFileVisibility(eVisibility)))
 }
-func SteamAPI_ISteamUGC_SetItemTags(updateHandle UGCUpdateHandle, pTags *SteamParamStringArray) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemTags(getSteamUGC(), updateHandle, pTags))
+func SteamAPI_ISteamUGC_SetItemTags(side Side, updateHandle UGCUpdateHandle, pTags *SteamParamStringArray) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemTags(getSteamUGC(side), updateHandle, pTags))
 }
-func SteamAPI_ISteamUGC_SetItemContent(handle UGCUpdateHandle, pszContentFolder *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemContent(getSteamUGC(), handle, pszContentFolder))
+func SteamAPI_ISteamUGC_SetItemContent(side Side, handle UGCUpdateHandle, pszContentFolder *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemContent(getSteamUGC(side), handle, pszContentFolder))
 }
-func SteamAPI_ISteamUGC_SetItemPreview(handle UGCUpdateHandle, pszPreviewFile *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_SetItemPreview(getSteamUGC(), handle, pszPreviewFile))
+func SteamAPI_ISteamUGC_SetItemPreview(side Side, handle UGCUpdateHandle, pszPreviewFile *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_SetItemPreview(getSteamUGC(side), handle, pszPreviewFile))
 }
-func SteamAPI_ISteamUGC_RemoveItemKeyValueTags(handle UGCUpdateHandle, pchKey *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_RemoveItemKeyValueTags(getSteamUGC(), handle, pchKey))
+func SteamAPI_ISteamUGC_RemoveItemKeyValueTags(side Side, handle UGCUpdateHandle, pchKey *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_RemoveItemKeyValueTags(getSteamUGC(side), handle, pchKey))
 }
-func SteamAPI_ISteamUGC_AddItemKeyValueTag(handle UGCUpdateHandle, pchKey *C.char, pchValue *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddItemKeyValueTag(getSteamUGC(), handle, pchKey, pchValue))
+func SteamAPI_ISteamUGC_AddItemKeyValueTag(side Side, handle UGCUpdateHandle, pchKey *C.char, pchValue *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddItemKeyValueTag(getSteamUGC(side), handle, pchKey, pchValue))
 }
-func SteamAPI_ISteamUGC_AddItemPreviewFile(handle UGCUpdateHandle, pszPreviewFile *C.char, type_ EItemPreviewType) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddItemPreviewFile(getSteamUGC(), handle, pszPreviewFile, C.EItemPreviewType(type_)))
+func SteamAPI_ISteamUGC_AddItemPreviewFile(side Side, handle UGCUpdateHandle, pszPreviewFile *C.char, type_ EItemPreviewType) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddItemPreviewFile(getSteamUGC(side), handle, pszPreviewFile, C.EItemPreviewType(type_)))
 }
-func SteamAPI_ISteamUGC_AddItemPreviewVideo(handle UGCUpdateHandle, pszVideoID *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_AddItemPreviewVideo(getSteamUGC(), handle, pszVideoID))
+func SteamAPI_ISteamUGC_AddItemPreviewVideo(side Side, handle UGCUpdateHandle, pszVideoID *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_AddItemPreviewVideo(getSteamUGC(side), handle, pszVideoID))
 }
-func SteamAPI_ISteamUGC_UpdateItemPreviewFile(handle UGCUpdateHandle, index uint32, pszPreviewFile *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_UpdateItemPreviewFile(getSteamUGC(), handle, C.uint32(index), pszPreviewFile))
+func SteamAPI_ISteamUGC_UpdateItemPreviewFile(side Side, handle UGCUpdateHandle, index uint32, pszPreviewFile *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_UpdateItemPreviewFile(getSteamUGC(side), handle, C.uint32(index), pszPreviewFile))
 }
-func SteamAPI_ISteamUGC_UpdateItemPreviewVideo(handle UGCUpdateHandle, index uint32, pszVideoID *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_UpdateItemPreviewVideo(getSteamUGC(), handle, C.uint32(index), pszVideoID))
+func SteamAPI_ISteamUGC_UpdateItemPreviewVideo(side Side, handle UGCUpdateHandle, index uint32, pszVideoID *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_UpdateItemPreviewVideo(getSteamUGC(side), handle, C.uint32(index), pszVideoID))
 }
-func SteamAPI_ISteamUGC_RemoveItemPreview(handle UGCUpdateHandle, index uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_RemoveItemPreview(getSteamUGC(), handle, C.uint32(index)))
+func SteamAPI_ISteamUGC_RemoveItemPreview(side Side, handle UGCUpdateHandle, index uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_RemoveItemPreview(getSteamUGC(side), handle, C.uint32(index)))
 }
-func SteamAPI_ISteamUGC_SubmitItemUpdate(handle UGCUpdateHandle, pchChangeNote *C.char) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_SubmitItemUpdate(getSteamUGC(), handle, pchChangeNote)
+func SteamAPI_ISteamUGC_SubmitItemUpdate(side Side, handle UGCUpdateHandle, pchChangeNote *C.char) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_SubmitItemUpdate(getSteamUGC(side), handle, pchChangeNote)
 }
-func SteamAPI_ISteamUGC_GetItemUpdateProgress(handle UGCUpdateHandle, punBytesProcessed *uint64, punBytesTotal *uint64) EItemUpdateStatus {
-	return EItemUpdateStatus(C.SteamAPI_ISteamUGC_GetItemUpdateProgress(getSteamUGC(), handle, (*C.uint64)(punBytesProcessed), (*C.uint64)(punBytesTotal)))
+func SteamAPI_ISteamUGC_GetItemUpdateProgress(side Side, handle UGCUpdateHandle, punBytesProcessed *uint64, punBytesTotal *uint64) EItemUpdateStatus {
+	return EItemUpdateStatus(C.SteamAPI_ISteamUGC_GetItemUpdateProgress(getSteamUGC(side), handle, (*C.uint64)(punBytesProcessed), (*C.uint64)(punBytesTotal)))
 }
-func SteamAPI_ISteamUGC_SetUserItemVote(nPublishedFileID PublishedFileId, bVoteUp bool) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_SetUserItemVote(getSteamUGC(), nPublishedFileID, C.bool(bVoteUp))
+func SteamAPI_ISteamUGC_SetUserItemVote(side Side, nPublishedFileID PublishedFileId, bVoteUp bool) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_SetUserItemVote(getSteamUGC(side), nPublishedFileID, C.bool(bVoteUp))
 }
-func SteamAPI_ISteamUGC_GetUserItemVote(nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_GetUserItemVote(getSteamUGC(), nPublishedFileID)
+func SteamAPI_ISteamUGC_GetUserItemVote(side Side, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_GetUserItemVote(getSteamUGC(side), nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_AddItemToFavorites(nAppId AppId, nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_AddItemToFavorites(getSteamUGC(), nAppId, nPublishedFileID)
+func SteamAPI_ISteamUGC_AddItemToFavorites(side Side, nAppId AppId, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_AddItemToFavorites(getSteamUGC(side), nAppId, nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_RemoveItemFromFavorites(nAppId AppId, nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_RemoveItemFromFavorites(getSteamUGC(), nAppId, nPublishedFileID)
+func SteamAPI_ISteamUGC_RemoveItemFromFavorites(side Side, nAppId AppId, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_RemoveItemFromFavorites(getSteamUGC(side), nAppId, nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_SubscribeItem(nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_SubscribeItem(getSteamUGC(), nPublishedFileID)
+func SteamAPI_ISteamUGC_SubscribeItem(side Side, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_SubscribeItem(getSteamUGC(side), nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_UnsubscribeItem(nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_UnsubscribeItem(getSteamUGC(), nPublishedFileID)
+func SteamAPI_ISteamUGC_UnsubscribeItem(side Side, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_UnsubscribeItem(getSteamUGC(side), nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_GetNumSubscribedItems() uint32 {
-	return uint32(C.SteamAPI_ISteamUGC_GetNumSubscribedItems(getSteamUGC()))
+func SteamAPI_ISteamUGC_GetNumSubscribedItems(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamUGC_GetNumSubscribedItems(getSteamUGC(side)))
 }
-func SteamAPI_ISteamUGC_GetSubscribedItems(pvecPublishedFileID *PublishedFileId, cMaxEntries uint32) uint32 {
-	return uint32(C.SteamAPI_ISteamUGC_GetSubscribedItems(getSteamUGC(), pvecPublishedFileID, C.uint32(cMaxEntries)))
+func SteamAPI_ISteamUGC_GetSubscribedItems(side Side, pvecPublishedFileID *PublishedFileId, cMaxEntries uint32) uint32 {
+	return uint32(C.SteamAPI_ISteamUGC_GetSubscribedItems(getSteamUGC(side), pvecPublishedFileID, C.uint32(cMaxEntries)))
 }
-func SteamAPI_ISteamUGC_GetItemState(nPublishedFileID PublishedFileId) uint32 {
-	return uint32(C.SteamAPI_ISteamUGC_GetItemState(getSteamUGC(), nPublishedFileID))
+func SteamAPI_ISteamUGC_GetItemState(side Side, nPublishedFileID PublishedFileId) uint32 {
+	return uint32(C.SteamAPI_ISteamUGC_GetItemState(getSteamUGC(side), nPublishedFileID))
 }
-func SteamAPI_ISteamUGC_GetItemInstallInfo(nPublishedFileID PublishedFileId, punSizeOnDisk *uint64, pchFolder *C.char, cchFolderSize uint32, punTimeStamp *uint32) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetItemInstallInfo(getSteamUGC(), nPublishedFileID, (*C.uint64)(punSizeOnDisk), pchFolder, C.uint32(cchFolderSize), (*C.uint32)(punTimeStamp)))
+func SteamAPI_ISteamUGC_GetItemInstallInfo(side Side, nPublishedFileID PublishedFileId, punSizeOnDisk *uint64, pchFolder *C.char, cchFolderSize uint32, punTimeStamp *uint32) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetItemInstallInfo(getSteamUGC(side), nPublishedFileID, (*C.uint64)(punSizeOnDisk), pchFolder, C.uint32(cchFolderSize), (*C.uint32)(punTimeStamp)))
 }
-func SteamAPI_ISteamUGC_GetItemDownloadInfo(nPublishedFileID PublishedFileId, punBytesDownloaded *uint64, punBytesTotal *uint64) bool {
-	return bool(C.SteamAPI_ISteamUGC_GetItemDownloadInfo(getSteamUGC(), nPublishedFileID, (*C.uint64)(punBytesDownloaded), (*C.uint64)(punBytesTotal)))
+func SteamAPI_ISteamUGC_GetItemDownloadInfo(side Side, nPublishedFileID PublishedFileId, punBytesDownloaded *uint64, punBytesTotal *uint64) bool {
+	return bool(C.SteamAPI_ISteamUGC_GetItemDownloadInfo(getSteamUGC(side), nPublishedFileID, (*C.uint64)(punBytesDownloaded), (*C.uint64)(punBytesTotal)))
 }
-func SteamAPI_ISteamUGC_DownloadItem(nPublishedFileID PublishedFileId, bHighPriority bool) bool {
-	return bool(C.SteamAPI_ISteamUGC_DownloadItem(getSteamUGC(), nPublishedFileID, C.bool(bHighPriority)))
+func SteamAPI_ISteamUGC_DownloadItem(side Side, nPublishedFileID PublishedFileId, bHighPriority bool) bool {
+	return bool(C.SteamAPI_ISteamUGC_DownloadItem(getSteamUGC(side), nPublishedFileID, C.bool(bHighPriority)))
 }
-func SteamAPI_ISteamUGC_BInitWorkshopForGameServer(unWorkshopDepotID DepotId, pszFolder *C.char) bool {
-	return bool(C.SteamAPI_ISteamUGC_BInitWorkshopForGameServer(getSteamUGC(), unWorkshopDepotID, pszFolder))
+func SteamAPI_ISteamUGC_BInitWorkshopForGameServer(side Side, unWorkshopDepotID DepotId, pszFolder *C.char) bool {
+	return bool(C.SteamAPI_ISteamUGC_BInitWorkshopForGameServer(getSteamUGC(side), unWorkshopDepotID, pszFolder))
 }
-func SteamAPI_ISteamUGC_SuspendDownloads(bSuspend bool) {
-	C.SteamAPI_ISteamUGC_SuspendDownloads(getSteamUGC(), C.bool(bSuspend))
+func SteamAPI_ISteamUGC_SuspendDownloads(side Side, bSuspend bool) {
+	C.SteamAPI_ISteamUGC_SuspendDownloads(getSteamUGC(side), C.bool(bSuspend))
 }
-func SteamAPI_ISteamUGC_StartPlaytimeTracking(pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_StartPlaytimeTracking(getSteamUGC(), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
+func SteamAPI_ISteamUGC_StartPlaytimeTracking(side Side, pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_StartPlaytimeTracking(getSteamUGC(side), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
 }
-func SteamAPI_ISteamUGC_StopPlaytimeTracking(pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_StopPlaytimeTracking(getSteamUGC(), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
+func SteamAPI_ISteamUGC_StopPlaytimeTracking(side Side, pvecPublishedFileID *PublishedFileId, unNumPublishedFileIDs uint32) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_StopPlaytimeTracking(getSteamUGC(side), pvecPublishedFileID, C.uint32(unNumPublishedFileIDs))
 }
-func SteamAPI_ISteamUGC_StopPlaytimeTrackingForAllItems() SteamAPICall {
-	return C.SteamAPI_ISteamUGC_StopPlaytimeTrackingForAllItems(getSteamUGC())
+func SteamAPI_ISteamUGC_StopPlaytimeTrackingForAllItems(side Side) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_StopPlaytimeTrackingForAllItems(getSteamUGC(side))
 }
-func SteamAPI_ISteamUGC_AddDependency(nParentPublishedFileID PublishedFileId, nChildPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_AddDependency(getSteamUGC(), nParentPublishedFileID, nChildPublishedFileID)
+func SteamAPI_ISteamUGC_AddDependency(side Side, nParentPublishedFileID PublishedFileId, nChildPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_AddDependency(getSteamUGC(side), nParentPublishedFileID, nChildPublishedFileID)
 }
-func SteamAPI_ISteamUGC_RemoveDependency(nParentPublishedFileID PublishedFileId, nChildPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_RemoveDependency(getSteamUGC(), nParentPublishedFileID, nChildPublishedFileID)
+func SteamAPI_ISteamUGC_RemoveDependency(side Side, nParentPublishedFileID PublishedFileId, nChildPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_RemoveDependency(getSteamUGC(side), nParentPublishedFileID, nChildPublishedFileID)
 }
-func SteamAPI_ISteamUGC_AddAppDependency(nPublishedFileID PublishedFileId, nAppID AppId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_AddAppDependency(getSteamUGC(), nPublishedFileID, nAppID)
+func SteamAPI_ISteamUGC_AddAppDependency(side Side, nPublishedFileID PublishedFileId, nAppID AppId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_AddAppDependency(getSteamUGC(side), nPublishedFileID, nAppID)
 }
-func SteamAPI_ISteamUGC_RemoveAppDependency(nPublishedFileID PublishedFileId, nAppID AppId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_RemoveAppDependency(getSteamUGC(), nPublishedFileID, nAppID)
+func SteamAPI_ISteamUGC_RemoveAppDependency(side Side, nPublishedFileID PublishedFileId, nAppID AppId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_RemoveAppDependency(getSteamUGC(side), nPublishedFileID, nAppID)
 }
-func SteamAPI_ISteamUGC_GetAppDependencies(nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_GetAppDependencies(getSteamUGC(), nPublishedFileID)
+func SteamAPI_ISteamUGC_GetAppDependencies(side Side, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_GetAppDependencies(getSteamUGC(side), nPublishedFileID)
 }
-func SteamAPI_ISteamUGC_DeleteItem(nPublishedFileID PublishedFileId) SteamAPICall {
-	return C.SteamAPI_ISteamUGC_DeleteItem(getSteamUGC(), nPublishedFileID)
+func SteamAPI_ISteamUGC_DeleteItem(side Side, nPublishedFileID PublishedFileId) SteamAPICall {
+	return C.SteamAPI_ISteamUGC_DeleteItem(getSteamUGC(side), nPublishedFileID)
 }
 func SteamAPI_ISteamAppList_GetNumInstalledApps() uint32 {
 	return uint32(C.SteamAPI_ISteamAppList_GetNumInstalledApps(getSteamAppList()))
@@ -3521,116 +2101,116 @@ func SteamAPI_ISteamHTMLSurface_AllowStartRequest(unBrowserHandle HHTMLBrowser,
 func SteamAPI_ISteamHTMLSurface_JSDialogResponse(unBrowserHandle HHTMLBrowser, bResult bool) {
 	C.SteamAPI_ISteamHTMLSurface_JSDialogResponse(getSteamHTMLSurface(), unBrowserHandle, C.bool(bResult))
 }
-func SteamAPI_ISteamInventory_GetResultStatus(resultHandle SteamInventoryResult) EResult {
-	return EResult(C.SteamAPI_ISteamInventory_GetResultStatus(getSteamInventory(), resultHandle))
+func SteamAPI_ISteamInventory_GetResultStatus(side Side, resultHandle SteamInventoryResult) EResult {
+	return EResult(C.SteamAPI_ISteamInventory_GetResultStatus(getSteamInventory(side), resultHandle))
 }
-func SteamAPI_ISteamInventory_GetResultItems(resultHandle SteamInventoryResult, pOutItemsArray *SteamItemDetails, punOutItemsArraySize *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetResultItems(getSteamInventory(), resultHandle, pOutItemsArray, (*C.uint32)(punOutItemsArraySize)))
+func SteamAPI_ISteamInventory_GetResultItems(side Side, resultHandle SteamInventoryResult, pOutItemsArray *SteamItemDetails, punOutItemsArraySize *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetResultItems(getSteamInventory(side), resultHandle, pOutItemsArray, (*C.uint32)(punOutItemsArraySize)))
 }
-func SteamAPI_ISteamInventory_GetResultItemProperty(resultHandle SteamInventoryResult, unItemIndex uint32, pchPropertyName *C.char, pchValueBuffer *C.char, punValueBufferSizeOut *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetResultItemProperty(getSteamInventory(), resultHandle, C.uint32(unItemIndex), pchPropertyName, pchValueBuffer, (*C.uint32)(punValueBufferSizeOut)))
+func SteamAPI_ISteamInventory_GetResultItemProperty(side Side, resultHandle SteamInventoryResult, unItemIndex uint32, pchPropertyName *C.char, pchValueBuffer *C.char, punValueBufferSizeOut *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetResultItemProperty(getSteamInventory(side), resultHandle, C.uint32(unItemIndex), pchPropertyName, pchValueBuffer, (*C.uint32)(punValueBufferSizeOut)))
 }
-func SteamAPI_ISteamInventory_GetResultTimestamp(resultHandle SteamInventoryResult) uint32 {
-	return uint32(C.SteamAPI_ISteamInventory_GetResultTimestamp(getSteamInventory(), resultHandle))
+func SteamAPI_ISteamInventory_GetResultTimestamp(side Side, resultHandle SteamInventoryResult) uint32 {
+	return uint32(C.SteamAPI_ISteamInventory_GetResultTimestamp(getSteamInventory(side), resultHandle))
 }
-func SteamAPI_ISteamInventory_CheckResultSteamID(resultHandle SteamInventoryResult, steamIDExpected SteamID) bool {
-	return bool(C.SteamAPI_ISteamInventory_CheckResultSteamID(getSteamInventory(), resultHandle, C.CSteamID(steamIDExpected)))
+func SteamAPI_ISteamInventory_CheckResultSteamID(side Side, resultHandle SteamInventoryResult, steamIDExpected SteamID) bool {
+	return bool(C.SteamAPI_ISteamInventory_CheckResultSteamID(getSteamInventory(side), resultHandle, C.CSteamID(steamIDExpected)))
 }
-func SteamAPI_ISteamInventory_DestroyResult(resultHandle SteamInventoryResult) {
-	C.SteamAPI_ISteamInventory_DestroyResult(getSteamInventory(), resultHandle)
+func SteamAPI_ISteamInventory_DestroyResult(side Side, resultHandle SteamInventoryResult) {
+	C.SteamAPI_ISteamInventory_DestroyResult(getSteamInventory(side), resultHandle)
 }
-func SteamAPI_ISteamInventory_GetAllItems(pResultHandle *SteamInventoryResult) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetAllItems(getSteamInventory(), pResultHandle))
+func SteamAPI_ISteamInventory_GetAllItems(side Side, pResultHandle *SteamInventoryResult) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetAllItems(getSteamInventory(side), pResultHandle))
 }
-func SteamAPI_ISteamInventory_GetItemsByID(pResultHandle *SteamInventoryResult, pInstanceIDs *SteamItemInstanceID, unCountInstanceIDs uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetItemsByID(getSteamInventory(), pResultHandle, pInstanceIDs, C.uint32(unCountInstanceIDs)))
+func SteamAPI_ISteamInventory_GetItemsByID(side Side, pResultHandle *SteamInventoryResult, pInstanceIDs *SteamItemInstanceID, unCountInstanceIDs uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetItemsByID(getSteamInventory(side), pResultHandle, pInstanceIDs, C.uint32(unCountInstanceIDs)))
 }
-func SteamAPI_ISteamInventory_SerializeResult(resultHandle SteamInventoryResult, pOutBuffer unsafe.Pointer, punOutBufferSize *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_SerializeResult(getSteamInventory(), resultHandle, pOutBuffer, (*C.uint32)(punOutBufferSize)))
+func SteamAPI_ISteamInventory_SerializeResult(side Side, resultHandle SteamInventoryResult, pOutBuffer unsafe.Pointer, punOutBufferSize *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_SerializeResult(getSteamInventory(side), resultHandle, pOutBuffer, (*C.uint32)(punOutBufferSize)))
 }
-func SteamAPI_ISteamInventory_DeserializeResult(pOutResultHandle *SteamInventoryResult, pBuffer unsafe.Pointer, unBufferSize uint32, bRESERVED_MUST_BE_FALSE bool) bool {
-	return bool(C.SteamAPI_ISteamInventory_DeserializeResult(getSteamInventory(), pOutResultHandle, pBuffer, C.uint32(unBufferSize), C.bool(bRESERVED_MUST_BE_FALSE)))
+func SteamAPI_ISteamInventory_DeserializeResult(side Side, pOutResultHandle *SteamInventoryResult, pBuffer unsafe.Pointer, unBufferSize uint32, bRESERVED_MUST_BE_FALSE bool) bool {
+	return bool(C.SteamAPI_ISteamInventory_DeserializeResult(getSteamInventory(side), pOutResultHandle, pBuffer, C.uint32(unBufferSize), C.bool(bRESERVED_MUST_BE_FALSE)))
 }
-func SteamAPI_ISteamInventory_GenerateItems(pResultHandle *SteamInventoryResult, pArrayItemDefs *SteamItemDef, punArrayQuantity *uint32, unArrayLength uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GenerateItems(getSteamInventory(), pResultHandle, pArrayItemDefs, (*C.uint32)(punArrayQuantity), C.uint32(unArrayLength)))
+func SteamAPI_ISteamInventory_GenerateItems(side Side, pResultHandle *SteamInventoryResult, pArrayItemDefs *SteamItemDef, punArrayQuantity *uint32, unArrayLength uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GenerateItems(getSteamInventory(side), pResultHandle, pArrayItemDefs, (*C.uint32)(punArrayQuantity), C.uint32(unArrayLength)))
 }
-func SteamAPI_ISteamInventory_GrantPromoItems(pResultHandle *SteamInventoryResult) bool {
-	return bool(C.SteamAPI_ISteamInventory_GrantPromoItems(getSteamInventory(), pResultHandle))
+func SteamAPI_ISteamInventory_GrantPromoItems(side Side, pResultHandle *SteamInventoryResult) bool {
+	return bool(C.SteamAPI_ISteamInventory_GrantPromoItems(getSteamInventory(side), pResultHandle))
 }
-func SteamAPI_ISteamInventory_AddPromoItem(pResultHandle *SteamInventoryResult, itemDef SteamItemDef) bool {
-	return bool(C.SteamAPI_ISteamInventory_AddPromoItem(getSteamInventory(), pResultHandle, itemDef))
+func SteamAPI_ISteamInventory_AddPromoItem(side Side, pResultHandle *SteamInventoryResult, itemDef SteamItemDef) bool {
+	return bool(C.SteamAPI_ISteamInventory_AddPromoItem(getSteamInventory(side), pResultHandle, itemDef))
 }
-func SteamAPI_ISteamInventory_AddPromoItems(pResultHandle *SteamInventoryResult, pArrayItemDefs *SteamItemDef, unArrayLength uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_AddPromoItems(getSteamInventory(), pResultHandle, pArrayItemDefs, C.uint32(unArrayLength)))
+func SteamAPI_ISteamInventory_AddPromoItems(side Side, pResultHandle *SteamInventoryResult, pArrayItemDefs *SteamItemDef, unArrayLength uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_AddPromoItems(getSteamInventory(side), pResultHandle, pArrayItemDefs, C.uint32(unArrayLength)))
 }
-func SteamAPI_ISteamInventory_ConsumeItem(pResultHandle *SteamInventoryResult, itemConsume SteamItemInstanceID, unQuantity uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_ConsumeItem(getSteamInventory(), pResultHandle, itemConsume, C.uint32(unQuantity)))
+func SteamAPI_ISteamInventory_ConsumeItem(side Side, pResultHandle *SteamInventoryResult, itemConsume SteamItemInstanceID, unQuantity uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_ConsumeItem(getSteamInventory(side), pResultHandle, itemConsume, C.uint32(unQuantity)))
 }
-func SteamAPI_ISteamInventory_ExchangeItems(pResultHandle *SteamInventoryResult, pArrayGenerate *SteamItemDef, punArrayGenerateQuantity *uint32, unArrayGenerateLength uint32, pArrayDestroy *SteamItemInstanceID, punArrayDestroyQuantity *uint32, unArrayDestroyLength uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_ExchangeItems(getSteamInventory(), pResultHandle, pArrayGenerate, (*C.uint32)(punArrayGenerateQuantity), C.uint32(unArrayGenerateLength), pArrayDestroy, (*C.uint32)(punArrayDestroyQuantity), C.uint32(unArrayDestroyLength)))
+func SteamAPI_ISteamInventory_ExchangeItems(side Side, pResultHandle *SteamInventoryResult, pArrayGenerate *SteamItemDef, punArrayGenerateQuantity *uint32, unArrayGenerateLength uint32, pArrayDestroy *SteamItemInstanceID, punArrayDestroyQuantity *uint32, unArrayDestroyLength uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_ExchangeItems(getSteamInventory(side), pResultHandle, pArrayGenerate, (*C.uint32)(punArrayGenerateQuantity), C.uint32(unArrayGenerateLength), pArrayDestroy, (*C.uint32)(punArrayDestroyQuantity), C.uint32(unArrayDestroyLength)))
 }
-func SteamAPI_ISteamInventory_TransferItemQuantity(pResultHandle *SteamInventoryResult, itemIdSource SteamItemInstanceID, unQuantity uint32, itemIdDest SteamItemInstanceID) bool {
-	return bool(C.SteamAPI_ISteamInventory_TransferItemQuantity(getSteamInventory(), pResultHandle, itemIdSource, C.uint32(unQuantity), itemIdDest))
+func SteamAPI_ISteamInventory_TransferItemQuantity(side Side, pResultHandle *SteamInventoryResult, itemIdSource SteamItemInstanceID, unQuantity uint32, itemIdDest SteamItemInstanceID) bool {
+	return bool(C.SteamAPI_ISteamInventory_TransferItemQuantity(getSteamInventory(side), pResultHandle, itemIdSource, C.uint32(unQuantity), itemIdDest))
 }
-func SteamAPI_ISteamInventory_SendItemDropHeartbeat() {
-	C.SteamAPI_ISteamInventory_SendItemDropHeartbeat(getSteamInventory())
+func SteamAPI_ISteamInventory_SendItemDropHeartbeat(side Side) {
+	C.SteamAPI_ISteamInventory_SendItemDropHeartbeat(getSteamInventory(side))
 }
-func SteamAPI_ISteamInventory_TriggerItemDrop(pResultHandle *SteamInventoryResult, dropListDefinition SteamItemDef) bool {
-	return bool(C.SteamAPI_ISteamInventory_TriggerItemDrop(getSteamInventory(), pResultHandle, dropListDefinition))
+func SteamAPI_ISteamInventory_TriggerItemDrop(side Side, pResultHandle *SteamInventoryResult, dropListDefinition SteamItemDef) bool {
+	return bool(C.SteamAPI_ISteamInventory_TriggerItemDrop(getSteamInventory(side), pResultHandle, dropListDefinition))
 }
-func SteamAPI_ISteamInventory_TradeItems(pResultHandle *SteamInventoryResult, steamIDTradePartner SteamID, pArrayGive *SteamItemInstanceID, pArrayGiveQuantity *uint32, nArrayGiveLength uint32, pArrayGet *SteamItemInstanceID, pArrayGetQuantity *uint32, nArrayGetLength uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_TradeItems(getSteamInventory(), pResultHandle, C.CSteamID(steamIDTradePartner), pArrayGive, (*C.uint32)(pArrayGiveQuantity), C.uint32(nArrayGiveLength), pArrayGet, (*C.uint32)(pArrayGetQuantity), C.uint32(nArrayGetLength)))
+func SteamAPI_ISteamInventory_TradeItems(side Side, pResultHandle *SteamInventoryResult, steamIDTradePartner SteamID, pArrayGive *SteamItemInstanceID, pArrayGiveQuantity *uint32, nArrayGiveLength uint32, pArrayGet *SteamItemInstanceID, pArrayGetQuantity *uint32, nArrayGetLength uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_TradeItems(getSteamInventory(side), pResultHandle, C.CSteamID(steamIDTradePartner), pArrayGive, (*C.uint32)(pArrayGiveQuantity), C.uint32(nArrayGiveLength), pArrayGet, (*C.uint32)(pArrayGetQuantity), C.uint32(nArrayGetLength)))
 }
-func SteamAPI_ISteamInventory_LoadItemDefinitions() bool {
-	return bool(C.SteamAPI_ISteamInventory_LoadItemDefinitions(getSteamInventory()))
+func SteamAPI_ISteamInventory_LoadItemDefinitions(side Side) bool {
+	return bool(C.SteamAPI_ISteamInventory_LoadItemDefinitions(getSteamInventory(side)))
 }
-func SteamAPI_ISteamInventory_GetItemDefinitionIDs(pItemDefIDs *SteamItemDef, punItemDefIDsArraySize *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetItemDefinitionIDs(getSteamInventory(), pItemDefIDs, (*C.uint32)(punItemDefIDsArraySize)))
+func SteamAPI_ISteamInventory_GetItemDefinitionIDs(side Side, pItemDefIDs *SteamItemDef, punItemDefIDsArraySize *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetItemDefinitionIDs(getSteamInventory(side), pItemDefIDs, (*C.uint32)(punItemDefIDsArraySize)))
 }
-func SteamAPI_ISteamInventory_GetItemDefinitionProperty(iDefinition SteamItemDef, pchPropertyName *C.char, pchValueBuffer *C.char, punValueBufferSizeOut *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetItemDefinitionProperty(getSteamInventory(), iDefinition, pchPropertyName, pchValueBuffer, (*C.uint32)(punValueBufferSizeOut)))
+func SteamAPI_ISteamInventory_GetItemDefinitionProperty(side Side, iDefinition SteamItemDef, pchPropertyName *C.char, pchValueBuffer *C.char, punValueBufferSizeOut *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetItemDefinitionProperty(getSteamInventory(side), iDefinition, pchPropertyName, pchValueBuffer, (*C.uint32)(punValueBufferSizeOut)))
 }
-func SteamAPI_ISteamInventory_RequestEligiblePromoItemDefinitionsIDs(steamID SteamID) SteamAPICall {
-	return C.SteamAPI_ISteamInventory_RequestEligiblePromoItemDefinitionsIDs(getSteamInventory(), C.CSteamID(steamID))
+func SteamAPI_ISteamInventory_RequestEligiblePromoItemDefinitionsIDs(side Side, steamID SteamID) SteamAPICall {
+	return C.SteamAPI_ISteamInventory_RequestEligiblePromoItemDefinitionsIDs(getSteamInventory(side), C.CSteamID(steamID))
 }
-func SteamAPI_ISteamInventory_GetEligiblePromoItemDefinitionIDs(steamID SteamID, pItemDefIDs *SteamItemDef, punItemDefIDsArraySize *uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetEligiblePromoItemDefinitionIDs(getSteamInventory(), C.CSteamID(steamID), pItemDefIDs, (*C.uint32)(punItemDefIDsArraySize)))
+func SteamAPI_ISteamInventory_GetEligiblePromoItemDefinitionIDs(side Side, steamID SteamID, pItemDefIDs *SteamItemDef, punItemDefIDsArraySize *uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetEligiblePromoItemDefinitionIDs(getSteamInventory(side), C.CSteamID(steamID), pItemDefIDs, (*C.uint32)(punItemDefIDsArraySize)))
 }
-func SteamAPI_ISteamInventory_StartPurchase(pArrayItemDefs *SteamItemDef, punArrayQuantity *uint32, unArrayLength uint32) SteamAPICall {
-	return C.SteamAPI_ISteamInventory_StartPurchase(getSteamInventory(), pArrayItemDefs, (*C.uint32)(punArrayQuantity), C.uint32(unArrayLength))
+func SteamAPI_ISteamInventory_StartPurchase(side Side, pArrayItemDefs *SteamItemDef, punArrayQuantity *uint32, unArrayLength uint32) SteamAPICall {
+	return C.SteamAPI_ISteamInventory_StartPurchase(getSteamInventory(side), pArrayItemDefs, (*C.uint32)(punArrayQuantity), C.uint32(unArrayLength))
 }
-func SteamAPI_ISteamInventory_RequestPrices() SteamAPICall {
-	return C.SteamAPI_ISteamInventory_RequestPrices(getSteamInventory())
+func SteamAPI_ISteamInventory_RequestPrices(side Side) SteamAPICall {
+	return C.SteamAPI_ISteamInventory_RequestPrices(getSteamInventory(side))
 }
-func SteamAPI_ISteamInventory_GetNumItemsWithPrices() uint32 {
-	return uint32(C.SteamAPI_ISteamInventory_GetNumItemsWithPrices(getSteamInventory()))
+func SteamAPI_ISteamInventory_GetNumItemsWithPrices(side Side) uint32 {
+	return uint32(C.SteamAPI_ISteamInventory_GetNumItemsWithPrices(getSteamInventory(side)))
 }
-func SteamAPI_ISteamInventory_GetItemsWithPrices(pArrayItemDefs *SteamItemDef, pPrices *uint64, unArrayLength uint32) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetItemsWithPrices(getSteamInventory(), pArrayItemDefs, (*C.uint64)(pPrices), C.uint32(unArrayLength)))
+func SteamAPI_ISteamInventory_GetItemsWithPrices(side Side, pArrayItemDefs *SteamItemDef, pPrices *uint64, unArrayLength uint32) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetItemsWithPrices(getSteamInventory(side), pArrayItemDefs, (*C.uint64)(pPrices), C.uint32(unArrayLength)))
 }
-func SteamAPI_ISteamInventory_GetItemPrice(iDefinition SteamItemDef, pPrice *uint64) bool {
-	return bool(C.SteamAPI_ISteamInventory_GetItemPrice(getSteamInventory(), iDefinition, (*C.uint64)(pPrice)))
+func SteamAPI_ISteamInventory_GetItemPrice(side Side, iDefinition SteamItemDef, pPrice *uint64) bool {
+	return bool(C.SteamAPI_ISteamInventory_GetItemPrice(getSteamInventory(side), iDefinition, (*C.uint64)(pPrice)))
 }
-func SteamAPI_ISteamInventory_StartUpdateProperties() SteamInventoryUpdateHandle {
-	return C.SteamAPI_ISteamInventory_StartUpdateProperties(getSteamInventory())
+func SteamAPI_ISteamInventory_StartUpdateProperties(side Side) SteamInventoryUpdateHandle {
+	return C.SteamAPI_ISteamInventory_StartUpdateProperties(getSteamInventory(side))
 }
-func SteamAPI_ISteamInventory_RemoveProperty(handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char) bool {
-	return bool(C.SteamAPI_ISteamInventory_RemoveProperty(getSteamInventory(), handle, nItemID, pchPropertyName))
+func SteamAPI_ISteamInventory_RemoveProperty(side Side, handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char) bool {
+	return bool(C.SteamAPI_ISteamInventory_RemoveProperty(getSteamInventory(side), handle, nItemID, pchPropertyName))
 }
-func SteamAPI_ISteamInventory_SetProperty(handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, pchPropertyValue *C.char) bool {
-	return bool(C.SteamAPI_ISteamInventory_SetProperty(getSteamInventory(), handle, nItemID, pchPropertyName, pchPropertyValue))
+func SteamAPI_ISteamInventory_SetProperty(side Side, handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, pchPropertyValue *C.char) bool {
+	return bool(C.SteamAPI_ISteamInventory_SetProperty(getSteamInventory(side), handle, nItemID, pchPropertyName, pchPropertyValue))
 }
-func SteamAPI_ISteamInventory_SetProperty0(handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, bValue bool) bool {
-	return bool(C.SteamAPI_ISteamInventory_SetProperty0(getSteamInventory(), handle, nItemID, pchPropertyName, C.bool(bValue)))
+func SteamAPI_ISteamInventory_SetProperty0(side Side, handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, bValue bool) bool {
+	return bool(C.SteamAPI_ISteamInventory_SetProperty0(getSteamInventory(side), handle, nItemID, pchPropertyName, C.bool(bValue)))
 }
-func SteamAPI_ISteamInventory_SetProperty1(handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, nValue int64) bool {
-	return bool(C.SteamAPI_ISteamInventory_SetProperty1(getSteamInventory(), handle, nItemID, pchPropertyName, C.int64(nValue)))
+func SteamAPI_ISteamInventory_SetProperty1(side Side, handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, nValue int64) bool {
+	return bool(C.SteamAPI_ISteamInventory_SetProperty1(getSteamInventory(side), handle, nItemID, pchPropertyName, C.int64(nValue)))
 }
-func SteamAPI_ISteamInventory_SetProperty2(handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, flValue float32) bool {
-	return bool(C.SteamAPI_ISteamInventory_SetProperty2(getSteamInventory(), handle, nItemID, pchPropertyName, C.float(flValue)))
+func SteamAPI_ISteamInventory_SetProperty2(side Side, handle SteamInventoryUpdateHandle, nItemID SteamItemInstanceID, pchPropertyName *C.char, flValue float32) bool {
+	return bool(C.SteamAPI_ISteamInventory_SetProperty2(getSteamInventory(side), handle, nItemID, pchPropertyName, C.float(flValue)))
 }
-func SteamAPI_ISteamInventory_SubmitUpdateProperties(handle SteamInventoryUpdateHandle, pResultHandle *SteamInventoryResult) bool {
-	return bool(C.SteamAPI_ISteamInventory_SubmitUpdateProperties(getSteamInventory(), handle, pResultHandle))
+func SteamAPI_ISteamInventory_SubmitUpdateProperties(side Side, handle SteamInventoryUpdateHandle, pResultHandle *SteamInventoryResult) bool {
+	return bool(C.SteamAPI_ISteamInventory_SubmitUpdateProperties(getSteamInventory(side), handle, pResultHandle))
 }
 func SteamAPI_ISteamVideo_GetVideoURL(unVideoAppID AppId) {
 	C.SteamAPI_ISteamVideo_GetVideoURL(getSteamVideo(), unVideoAppID)
@@ -3824,1853 +2404,2041 @@ func SteamAPI_ISteamGameServerStats_ClearUserAchievement(steamIDUser SteamID, pc
 func SteamAPI_ISteamGameServerStats_StoreUserStats(steamIDUser SteamID) SteamAPICall {
 	return C.SteamAPI_ISteamGameServerStats_StoreUserStats(getSteamGameServerStats(), C.CSteamID(steamIDUser))
 }
-func RegisterCallback_SteamAppInstalled(f func(*SteamAppInstalled, bool), apiCall SteamAPICall) registeredCallback {
+
+// CallbackSizes is the size of the data for each callback type.
+var CallbackSizes = map[int32]uintptr{
+	SteamAppListCallbacks + 1:          unsafe.Sizeof(SteamAppInstalled{}),
+	SteamAppListCallbacks + 2:          unsafe.Sizeof(SteamAppUninstalled{}),
+	SteamAppsCallbacks + 5:             unsafe.Sizeof(DlcInstalled{}),
+	SteamAppsCallbacks + 8:             unsafe.Sizeof(RegisterActivationCodeResponse{}),
+	SteamAppsCallbacks + 14:            unsafe.Sizeof(NewLaunchQueryParameters{}),
+	SteamAppsCallbacks + 21:            unsafe.Sizeof(AppProofOfPurchaseKeyResponse{}),
+	SteamAppsCallbacks + 23:            unsafe.Sizeof(FileDetailsResult{}),
+	SteamFriendsCallbacks + 4:          unsafe.Sizeof(PersonaStateChange{}),
+	SteamFriendsCallbacks + 31:         unsafe.Sizeof(GameOverlayActivated{}),
+	SteamFriendsCallbacks + 32:         unsafe.Sizeof(GameServerChangeRequested{}),
+	SteamFriendsCallbacks + 33:         unsafe.Sizeof(GameLobbyJoinRequested{}),
+	SteamFriendsCallbacks + 34:         unsafe.Sizeof(AvatarImageLoaded{}),
+	SteamFriendsCallbacks + 35:         unsafe.Sizeof(ClanOfficerListResponse{}),
+	SteamFriendsCallbacks + 36:         unsafe.Sizeof(FriendRichPresenceUpdate{}),
+	SteamFriendsCallbacks + 37:         unsafe.Sizeof(GameRichPresenceJoinRequested{}),
+	SteamFriendsCallbacks + 38:         unsafe.Sizeof(GameConnectedClanChatMsg{}),
+	SteamFriendsCallbacks + 39:         unsafe.Sizeof(GameConnectedChatJoin{}),
+	SteamFriendsCallbacks + 40:         unsafe.Sizeof(GameConnectedChatLeave{}),
+	SteamFriendsCallbacks + 41:         unsafe.Sizeof(DownloadClanActivityCountsResult{}),
+	SteamFriendsCallbacks + 42:         unsafe.Sizeof(JoinClanChatRoomCompletionResult{}),
+	SteamFriendsCallbacks + 43:         unsafe.Sizeof(GameConnectedFriendChatMsg{}),
+	SteamFriendsCallbacks + 44:         unsafe.Sizeof(FriendsGetFollowerCount{}),
+	SteamFriendsCallbacks + 45:         unsafe.Sizeof(FriendsIsFollowing{}),
+	SteamFriendsCallbacks + 46:         unsafe.Sizeof(FriendsEnumerateFollowingList{}),
+	SteamFriendsCallbacks + 47:         unsafe.Sizeof(SetPersonaNameResponse{}),
+	SteamGameCoordinatorCallbacks + 1:  unsafe.Sizeof(GCMessageAvailable{}),
+	SteamGameCoordinatorCallbacks + 2:  unsafe.Sizeof(GCMessageFailed{}),
+	SteamGameServerCallbacks + 1:       unsafe.Sizeof(GSClientApprove{}),
+	SteamGameServerCallbacks + 2:       unsafe.Sizeof(GSClientDeny{}),
+	SteamGameServerCallbacks + 3:       unsafe.Sizeof(GSClientKick{}),
+	SteamGameServerCallbacks + 6:       unsafe.Sizeof(GSClientAchievementStatus{}),
+	SteamUserCallbacks + 15:            unsafe.Sizeof(GSPolicyResponse{}),
+	SteamGameServerCallbacks + 7:       unsafe.Sizeof(GSGameplayStats{}),
+	SteamGameServerCallbacks + 8:       unsafe.Sizeof(GSClientGroupStatus{}),
+	SteamGameServerCallbacks + 9:       unsafe.Sizeof(GSReputation{}),
+	SteamGameServerCallbacks + 10:      unsafe.Sizeof(AssociateWithClanResult{}),
+	SteamGameServerCallbacks + 11:      unsafe.Sizeof(ComputeNewPlayerCompatibilityResult{}),
+	SteamGameServerStatsCallbacks + 1:  unsafe.Sizeof(GSStatsStored{}),
+	SteamUserStatsCallbacks + 8:        unsafe.Sizeof(GSStatsUnloaded{}),
+	SteamHTMLSurfaceCallbacks + 1:      unsafe.Sizeof(HTML_BrowserReady{}),
+	SteamHTMLSurfaceCallbacks + 2:      unsafe.Sizeof(HTML_NeedsPaint{}),
+	SteamHTMLSurfaceCallbacks + 3:      unsafe.Sizeof(HTML_StartRequest{}),
+	SteamHTMLSurfaceCallbacks + 4:      unsafe.Sizeof(HTML_CloseBrowser{}),
+	SteamHTMLSurfaceCallbacks + 5:      unsafe.Sizeof(HTML_URLChanged{}),
+	SteamHTMLSurfaceCallbacks + 6:      unsafe.Sizeof(HTML_FinishedRequest{}),
+	SteamHTMLSurfaceCallbacks + 7:      unsafe.Sizeof(HTML_OpenLinkInNewTab{}),
+	SteamHTMLSurfaceCallbacks + 8:      unsafe.Sizeof(HTML_ChangedTitle{}),
+	SteamHTMLSurfaceCallbacks + 9:      unsafe.Sizeof(HTML_SearchResults{}),
+	SteamHTMLSurfaceCallbacks + 10:     unsafe.Sizeof(HTML_CanGoBackAndForward{}),
+	SteamHTMLSurfaceCallbacks + 11:     unsafe.Sizeof(HTML_HorizontalScroll{}),
+	SteamHTMLSurfaceCallbacks + 12:     unsafe.Sizeof(HTML_VerticalScroll{}),
+	SteamHTMLSurfaceCallbacks + 13:     unsafe.Sizeof(HTML_LinkAtPosition{}),
+	SteamHTMLSurfaceCallbacks + 14:     unsafe.Sizeof(HTML_JSAlert{}),
+	SteamHTMLSurfaceCallbacks + 15:     unsafe.Sizeof(HTML_JSConfirm{}),
+	SteamHTMLSurfaceCallbacks + 16:     unsafe.Sizeof(HTML_FileOpenDialog{}),
+	SteamHTMLSurfaceCallbacks + 21:     unsafe.Sizeof(HTML_NewWindow{}),
+	SteamHTMLSurfaceCallbacks + 22:     unsafe.Sizeof(HTML_SetCursor{}),
+	SteamHTMLSurfaceCallbacks + 23:     unsafe.Sizeof(HTML_StatusText{}),
+	SteamHTMLSurfaceCallbacks + 24:     unsafe.Sizeof(HTML_ShowToolTip{}),
+	SteamHTMLSurfaceCallbacks + 25:     unsafe.Sizeof(HTML_UpdateToolTip{}),
+	SteamHTMLSurfaceCallbacks + 26:     unsafe.Sizeof(HTML_HideToolTip{}),
+	SteamHTMLSurfaceCallbacks + 27:     unsafe.Sizeof(HTML_BrowserRestarted{}),
+	ClientHTTPCallbacks + 1:            unsafe.Sizeof(HTTPRequestCompleted{}),
+	ClientHTTPCallbacks + 2:            unsafe.Sizeof(HTTPRequestHeadersReceived{}),
+	ClientHTTPCallbacks + 3:            unsafe.Sizeof(HTTPRequestDataReceived{}),
+	ClientInventoryCallbacks + 0:       unsafe.Sizeof(SteamInventoryResultReady{}),
+	ClientInventoryCallbacks + 1:       unsafe.Sizeof(SteamInventoryFullUpdate{}),
+	ClientInventoryCallbacks + 2:       unsafe.Sizeof(SteamInventoryDefinitionUpdate{}),
+	ClientInventoryCallbacks + 3:       unsafe.Sizeof(SteamInventoryEligiblePromoItemDefIDs{}),
+	ClientInventoryCallbacks + 4:       unsafe.Sizeof(SteamInventoryStartPurchaseResult{}),
+	ClientInventoryCallbacks + 5:       unsafe.Sizeof(SteamInventoryRequestPricesResult{}),
+	SteamMatchmakingCallbacks + 2:      unsafe.Sizeof(FavoritesListChanged{}),
+	SteamMatchmakingCallbacks + 3:      unsafe.Sizeof(LobbyInvite{}),
+	SteamMatchmakingCallbacks + 4:      unsafe.Sizeof(LobbyEnter{}),
+	SteamMatchmakingCallbacks + 5:      unsafe.Sizeof(LobbyDataUpdate{}),
+	SteamMatchmakingCallbacks + 6:      unsafe.Sizeof(LobbyChatUpdate{}),
+	SteamMatchmakingCallbacks + 7:      unsafe.Sizeof(LobbyChatMsg{}),
+	SteamMatchmakingCallbacks + 9:      unsafe.Sizeof(LobbyGameCreated{}),
+	SteamMatchmakingCallbacks + 10:     unsafe.Sizeof(LobbyMatchList{}),
+	SteamMatchmakingCallbacks + 12:     unsafe.Sizeof(LobbyKicked{}),
+	SteamMatchmakingCallbacks + 13:     unsafe.Sizeof(LobbyCreated{}),
+	SteamMatchmakingCallbacks + 15:     unsafe.Sizeof(PSNGameBootInviteResult{}),
+	SteamMatchmakingCallbacks + 16:     unsafe.Sizeof(FavoritesListAccountsUpdated{}),
+	SteamMusicCallbacks + 1:            unsafe.Sizeof(PlaybackStatusHasChanged{}),
+	SteamMusicCallbacks + 2:            unsafe.Sizeof(VolumeHasChanged{}),
+	SteamMusicRemoteCallbacks + 1:      unsafe.Sizeof(MusicPlayerRemoteWillActivate{}),
+	SteamMusicRemoteCallbacks + 2:      unsafe.Sizeof(MusicPlayerRemoteWillDeactivate{}),
+	SteamMusicRemoteCallbacks + 3:      unsafe.Sizeof(MusicPlayerRemoteToFront{}),
+	SteamMusicRemoteCallbacks + 4:      unsafe.Sizeof(MusicPlayerWillQuit{}),
+	SteamMusicRemoteCallbacks + 5:      unsafe.Sizeof(MusicPlayerWantsPlay{}),
+	SteamMusicRemoteCallbacks + 6:      unsafe.Sizeof(MusicPlayerWantsPause{}),
+	SteamMusicRemoteCallbacks + 7:      unsafe.Sizeof(MusicPlayerWantsPlayPrevious{}),
+	SteamMusicRemoteCallbacks + 8:      unsafe.Sizeof(MusicPlayerWantsPlayNext{}),
+	SteamMusicRemoteCallbacks + 9:      unsafe.Sizeof(MusicPlayerWantsShuffled{}),
+	SteamMusicRemoteCallbacks + 10:     unsafe.Sizeof(MusicPlayerWantsLooped{}),
+	SteamMusicCallbacks + 11:           unsafe.Sizeof(MusicPlayerWantsVolume{}),
+	SteamMusicCallbacks + 12:           unsafe.Sizeof(MusicPlayerSelectsQueueEntry{}),
+	SteamMusicCallbacks + 13:           unsafe.Sizeof(MusicPlayerSelectsPlaylistEntry{}),
+	SteamMusicRemoteCallbacks + 14:     unsafe.Sizeof(MusicPlayerWantsPlayingRepeatStatus{}),
+	SteamNetworkingCallbacks + 2:       unsafe.Sizeof(P2PSessionRequest{}),
+	SteamNetworkingCallbacks + 3:       unsafe.Sizeof(P2PSessionConnectFail{}),
+	SteamNetworkingCallbacks + 1:       unsafe.Sizeof(SocketStatusCallback{}),
+	SteamParentalSettingsCallbacks + 1: unsafe.Sizeof(SteamParentalSettingsChanged{}),
+	ClientRemoteStorageCallbacks + 1:   unsafe.Sizeof(RemoteStorageAppSyncedClient{}),
+	ClientRemoteStorageCallbacks + 2:   unsafe.Sizeof(RemoteStorageAppSyncedServer{}),
+	ClientRemoteStorageCallbacks + 3:   unsafe.Sizeof(RemoteStorageAppSyncProgress{}),
+	ClientRemoteStorageCallbacks + 5:   unsafe.Sizeof(RemoteStorageAppSyncStatusCheck{}),
+	ClientRemoteStorageCallbacks + 7:   unsafe.Sizeof(RemoteStorageFileShareResult{}),
+	ClientRemoteStorageCallbacks + 9:   unsafe.Sizeof(RemoteStoragePublishFileResult{}),
+	ClientRemoteStorageCallbacks + 11:  unsafe.Sizeof(RemoteStorageDeletePublishedFileResult{}),
+	ClientRemoteStorageCallbacks + 12:  unsafe.Sizeof(RemoteStorageEnumerateUserPublishedFilesResult{}),
+	ClientRemoteStorageCallbacks + 13:  unsafe.Sizeof(RemoteStorageSubscribePublishedFileResult{}),
+	ClientRemoteStorageCallbacks + 14:  unsafe.Sizeof(RemoteStorageEnumerateUserSubscribedFilesResult{}),
+	ClientRemoteStorageCallbacks + 15:  unsafe.Sizeof(RemoteStorageUnsubscribePublishedFileResult{}),
+	ClientRemoteStorageCallbacks + 16:  unsafe.Sizeof(RemoteStorageUpdatePublishedFileResult{}),
+	ClientRemoteStorageCallbacks + 17:  unsafe.Sizeof(RemoteStorageDownloadUGCResult{}),
+	ClientRemoteStorageCallbacks + 18:  unsafe.Sizeof(RemoteStorageGetPublishedFileDetailsResult{}),
+	ClientRemoteStorageCallbacks + 19:  unsafe.Sizeof(RemoteStorageEnumerateWorkshopFilesResult{}),
+	ClientRemoteStorageCallbacks + 20:  unsafe.Sizeof(RemoteStorageGetPublishedItemVoteDetailsResult{}),
+	ClientRemoteStorageCallbacks + 21:  unsafe.Sizeof(RemoteStoragePublishedFileSubscribed{}),
+	ClientRemoteStorageCallbacks + 22:  unsafe.Sizeof(RemoteStoragePublishedFileUnsubscribed{}),
+	ClientRemoteStorageCallbacks + 23:  unsafe.Sizeof(RemoteStoragePublishedFileDeleted{}),
+	ClientRemoteStorageCallbacks + 24:  unsafe.Sizeof(RemoteStorageUpdateUserPublishedItemVoteResult{}),
+	ClientRemoteStorageCallbacks + 25:  unsafe.Sizeof(RemoteStorageUserVoteDetails{}),
+	ClientRemoteStorageCallbacks + 26:  unsafe.Sizeof(RemoteStorageEnumerateUserSharedWorkshopFilesResult{}),
+	ClientRemoteStorageCallbacks + 27:  unsafe.Sizeof(RemoteStorageSetUserPublishedFileActionResult{}),
+	ClientRemoteStorageCallbacks + 28:  unsafe.Sizeof(RemoteStorageEnumeratePublishedFilesByUserActionResult{}),
+	ClientRemoteStorageCallbacks + 29:  unsafe.Sizeof(RemoteStoragePublishFileProgress{}),
+	ClientRemoteStorageCallbacks + 30:  unsafe.Sizeof(RemoteStoragePublishedFileUpdated{}),
+	ClientRemoteStorageCallbacks + 31:  unsafe.Sizeof(RemoteStorageFileWriteAsyncComplete{}),
+	ClientRemoteStorageCallbacks + 32:  unsafe.Sizeof(RemoteStorageFileReadAsyncComplete{}),
+	SteamScreenshotsCallbacks + 1:      unsafe.Sizeof(ScreenshotReady{}),
+	SteamScreenshotsCallbacks + 2:      unsafe.Sizeof(ScreenshotRequested{}),
+	ClientUGCCallbacks + 1:             unsafe.Sizeof(SteamUGCQueryCompleted{}),
+	ClientUGCCallbacks + 2:             unsafe.Sizeof(SteamUGCRequestUGCDetailsResult{}),
+	ClientUGCCallbacks + 3:             unsafe.Sizeof(CreateItemResult{}),
+	ClientUGCCallbacks + 4:             unsafe.Sizeof(SubmitItemUpdateResult{}),
+	ClientUGCCallbacks + 5:             unsafe.Sizeof(ItemInstalled{}),
+	ClientUGCCallbacks + 6:             unsafe.Sizeof(DownloadItemResult{}),
+	ClientUGCCallbacks + 7:             unsafe.Sizeof(UserFavoriteItemsListChanged{}),
+	ClientUGCCallbacks + 8:             unsafe.Sizeof(SetUserItemVoteResult{}),
+	ClientUGCCallbacks + 9:             unsafe.Sizeof(GetUserItemVoteResult{}),
+	ClientUGCCallbacks + 10:            unsafe.Sizeof(StartPlaytimeTrackingResult{}),
+	ClientUGCCallbacks + 11:            unsafe.Sizeof(StopPlaytimeTrackingResult{}),
+	ClientUGCCallbacks + 12:            unsafe.Sizeof(AddUGCDependencyResult{}),
+	ClientUGCCallbacks + 13:            unsafe.Sizeof(RemoveUGCDependencyResult{}),
+	ClientUGCCallbacks + 14:            unsafe.Sizeof(AddAppDependencyResult{}),
+	ClientUGCCallbacks + 15:            unsafe.Sizeof(RemoveAppDependencyResult{}),
+	ClientUGCCallbacks + 16:            unsafe.Sizeof(GetAppDependenciesResult{}),
+	ClientUGCCallbacks + 17:            unsafe.Sizeof(DeleteItemResult{}),
+	SteamUserCallbacks + 1:             unsafe.Sizeof(SteamServersConnected{}),
+	SteamUserCallbacks + 2:             unsafe.Sizeof(SteamServerConnectFailure{}),
+	SteamUserCallbacks + 3:             unsafe.Sizeof(SteamServersDisconnected{}),
+	SteamUserCallbacks + 13:            unsafe.Sizeof(ClientGameServerDeny{}),
+	SteamUserCallbacks + 17:            unsafe.Sizeof(IPCFailure{}),
+	SteamUserCallbacks + 25:            unsafe.Sizeof(LicensesUpdated{}),
+	SteamUserCallbacks + 43:            unsafe.Sizeof(ValidateAuthTicketResponse{}),
+	SteamUserCallbacks + 52:            unsafe.Sizeof(MicroTxnAuthorizationResponse{}),
+	SteamUserCallbacks + 54:            unsafe.Sizeof(EncryptedAppTicketResponse{}),
+	SteamUserCallbacks + 63:            unsafe.Sizeof(GetAuthSessionTicketResponse{}),
+	SteamUserCallbacks + 64:            unsafe.Sizeof(GameWebCallback{}),
+	SteamUserCallbacks + 65:            unsafe.Sizeof(StoreAuthURLResponse{}),
+	SteamUserStatsCallbacks + 1:        unsafe.Sizeof(UserStatsReceived{}),
+	SteamUserStatsCallbacks + 2:        unsafe.Sizeof(UserStatsStored{}),
+	SteamUserStatsCallbacks + 3:        unsafe.Sizeof(UserAchievementStored{}),
+	SteamUserStatsCallbacks + 4:        unsafe.Sizeof(LeaderboardFindResult{}),
+	SteamUserStatsCallbacks + 5:        unsafe.Sizeof(LeaderboardScoresDownloaded{}),
+	SteamUserStatsCallbacks + 6:        unsafe.Sizeof(LeaderboardScoreUploaded{}),
+	SteamUserStatsCallbacks + 7:        unsafe.Sizeof(NumberOfCurrentPlayers{}),
+	SteamUserStatsCallbacks + 9:        unsafe.Sizeof(UserAchievementIconFetched{}),
+	SteamUserStatsCallbacks + 10:       unsafe.Sizeof(GlobalAchievementPercentagesReady{}),
+	SteamUserStatsCallbacks + 11:       unsafe.Sizeof(LeaderboardUGCSet{}),
+	SteamUserStatsCallbacks + 12:       unsafe.Sizeof(PS3TrophiesInstalled{}),
+	SteamUtilsCallbacks + 1:            unsafe.Sizeof(IPCountry{}),
+	SteamUtilsCallbacks + 2:            unsafe.Sizeof(LowBatteryPower{}),
+	SteamUtilsCallbacks + 3:            unsafe.Sizeof(SteamAPICallCompleted{}),
+	SteamUtilsCallbacks + 4:            unsafe.Sizeof(SteamShutdown{}),
+	SteamUtilsCallbacks + 5:            unsafe.Sizeof(CheckFileSignature{}),
+	SteamUtilsCallbacks + 14:           unsafe.Sizeof(GamepadTextInputDismissed{}),
+	ClientVideoCallbacks + 4:           unsafe.Sizeof(BroadcastUploadStart{}),
+	ClientVideoCallbacks + 5:           unsafe.Sizeof(BroadcastUploadStop{}),
+	ClientVideoCallbacks + 11:          unsafe.Sizeof(GetVideoURLResult{}),
+	ClientVideoCallbacks + 24:          unsafe.Sizeof(GetOPFSettingsResult{}),
+}
+
+func RegisterCallback_SteamAppInstalled(f func(*SteamAppInstalled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamAppInstalled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamAppInstalled{}), SteamAppListCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamAppInstalled{}), SteamAppListCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamAppUninstalled(f func(*SteamAppUninstalled, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamAppUninstalled(f func(*SteamAppUninstalled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamAppUninstalled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamAppUninstalled{}), SteamAppListCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamAppUninstalled{}), SteamAppListCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_DlcInstalled(f func(*DlcInstalled, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_DlcInstalled(f func(*DlcInstalled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*DlcInstalled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(DlcInstalled{}), SteamAppsCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(DlcInstalled{}), SteamAppsCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RegisterActivationCodeResponse(f func(*RegisterActivationCodeResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RegisterActivationCodeResponse(f func(*RegisterActivationCodeResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RegisterActivationCodeResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RegisterActivationCodeResponse{}), SteamAppsCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RegisterActivationCodeResponse{}), SteamAppsCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_NewLaunchQueryParameters(f func(*NewLaunchQueryParameters, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_NewLaunchQueryParameters(f func(*NewLaunchQueryParameters, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*NewLaunchQueryParameters)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(NewLaunchQueryParameters{}), SteamAppsCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(NewLaunchQueryParameters{}), SteamAppsCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_AppProofOfPurchaseKeyResponse(f func(*AppProofOfPurchaseKeyResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_AppProofOfPurchaseKeyResponse(f func(*AppProofOfPurchaseKeyResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*AppProofOfPurchaseKeyResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(AppProofOfPurchaseKeyResponse{}), SteamAppsCallbacks+21, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(AppProofOfPurchaseKeyResponse{}), SteamAppsCallbacks+21, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FileDetailsResult(f func(*FileDetailsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FileDetailsResult(f func(*FileDetailsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FileDetailsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FileDetailsResult{}), SteamAppsCallbacks+23, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FileDetailsResult{}), SteamAppsCallbacks+23, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_PersonaStateChange(f func(*PersonaStateChange, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_PersonaStateChange(f func(*PersonaStateChange, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*PersonaStateChange)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(PersonaStateChange{}), SteamFriendsCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(PersonaStateChange{}), SteamFriendsCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameOverlayActivated(f func(*GameOverlayActivated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameOverlayActivated(f func(*GameOverlayActivated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameOverlayActivated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameOverlayActivated{}), SteamFriendsCallbacks+31, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameOverlayActivated{}), SteamFriendsCallbacks+31, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameServerChangeRequested(f func(*GameServerChangeRequested, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameServerChangeRequested(f func(*GameServerChangeRequested, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameServerChangeRequested)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameServerChangeRequested{}), SteamFriendsCallbacks+32, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameServerChangeRequested{}), SteamFriendsCallbacks+32, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameLobbyJoinRequested(f func(*GameLobbyJoinRequested, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameLobbyJoinRequested(f func(*GameLobbyJoinRequested, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameLobbyJoinRequested)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameLobbyJoinRequested{}), SteamFriendsCallbacks+33, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameLobbyJoinRequested{}), SteamFriendsCallbacks+33, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_AvatarImageLoaded(f func(*AvatarImageLoaded, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_AvatarImageLoaded(f func(*AvatarImageLoaded, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*AvatarImageLoaded)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(AvatarImageLoaded{}), SteamFriendsCallbacks+34, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(AvatarImageLoaded{}), SteamFriendsCallbacks+34, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ClanOfficerListResponse(f func(*ClanOfficerListResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ClanOfficerListResponse(f func(*ClanOfficerListResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ClanOfficerListResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ClanOfficerListResponse{}), SteamFriendsCallbacks+35, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ClanOfficerListResponse{}), SteamFriendsCallbacks+35, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FriendRichPresenceUpdate(f func(*FriendRichPresenceUpdate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FriendRichPresenceUpdate(f func(*FriendRichPresenceUpdate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FriendRichPresenceUpdate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FriendRichPresenceUpdate{}), SteamFriendsCallbacks+36, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FriendRichPresenceUpdate{}), SteamFriendsCallbacks+36, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameRichPresenceJoinRequested(f func(*GameRichPresenceJoinRequested, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameRichPresenceJoinRequested(f func(*GameRichPresenceJoinRequested, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameRichPresenceJoinRequested)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameRichPresenceJoinRequested{}), SteamFriendsCallbacks+37, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameRichPresenceJoinRequested{}), SteamFriendsCallbacks+37, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameConnectedClanChatMsg(f func(*GameConnectedClanChatMsg, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameConnectedClanChatMsg(f func(*GameConnectedClanChatMsg, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameConnectedClanChatMsg)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameConnectedClanChatMsg{}), SteamFriendsCallbacks+38, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameConnectedClanChatMsg{}), SteamFriendsCallbacks+38, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameConnectedChatJoin(f func(*GameConnectedChatJoin, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameConnectedChatJoin(f func(*GameConnectedChatJoin, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameConnectedChatJoin)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameConnectedChatJoin{}), SteamFriendsCallbacks+39, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameConnectedChatJoin{}), SteamFriendsCallbacks+39, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameConnectedChatLeave(f func(*GameConnectedChatLeave, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameConnectedChatLeave(f func(*GameConnectedChatLeave, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameConnectedChatLeave)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameConnectedChatLeave{}), SteamFriendsCallbacks+40, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameConnectedChatLeave{}), SteamFriendsCallbacks+40, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_DownloadClanActivityCountsResult(f func(*DownloadClanActivityCountsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_DownloadClanActivityCountsResult(f func(*DownloadClanActivityCountsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*DownloadClanActivityCountsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(DownloadClanActivityCountsResult{}), SteamFriendsCallbacks+41, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(DownloadClanActivityCountsResult{}), SteamFriendsCallbacks+41, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_JoinClanChatRoomCompletionResult(f func(*JoinClanChatRoomCompletionResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_JoinClanChatRoomCompletionResult(f func(*JoinClanChatRoomCompletionResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*JoinClanChatRoomCompletionResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(JoinClanChatRoomCompletionResult{}), SteamFriendsCallbacks+42, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(JoinClanChatRoomCompletionResult{}), SteamFriendsCallbacks+42, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameConnectedFriendChatMsg(f func(*GameConnectedFriendChatMsg, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameConnectedFriendChatMsg(f func(*GameConnectedFriendChatMsg, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameConnectedFriendChatMsg)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameConnectedFriendChatMsg{}), SteamFriendsCallbacks+43, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameConnectedFriendChatMsg{}), SteamFriendsCallbacks+43, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FriendsGetFollowerCount(f func(*FriendsGetFollowerCount, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FriendsGetFollowerCount(f func(*FriendsGetFollowerCount, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FriendsGetFollowerCount)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FriendsGetFollowerCount{}), SteamFriendsCallbacks+44, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FriendsGetFollowerCount{}), SteamFriendsCallbacks+44, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FriendsIsFollowing(f func(*FriendsIsFollowing, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FriendsIsFollowing(f func(*FriendsIsFollowing, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FriendsIsFollowing)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FriendsIsFollowing{}), SteamFriendsCallbacks+45, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FriendsIsFollowing{}), SteamFriendsCallbacks+45, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FriendsEnumerateFollowingList(f func(*FriendsEnumerateFollowingList, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FriendsEnumerateFollowingList(f func(*FriendsEnumerateFollowingList, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FriendsEnumerateFollowingList)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FriendsEnumerateFollowingList{}), SteamFriendsCallbacks+46, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FriendsEnumerateFollowingList{}), SteamFriendsCallbacks+46, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SetPersonaNameResponse(f func(*SetPersonaNameResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SetPersonaNameResponse(f func(*SetPersonaNameResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SetPersonaNameResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SetPersonaNameResponse{}), SteamFriendsCallbacks+47, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SetPersonaNameResponse{}), SteamFriendsCallbacks+47, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GCMessageAvailable(f func(*GCMessageAvailable, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GCMessageAvailable(f func(*GCMessageAvailable, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GCMessageAvailable)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GCMessageAvailable{}), SteamGameCoordinatorCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GCMessageAvailable{}), SteamGameCoordinatorCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GCMessageFailed(f func(*GCMessageFailed, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GCMessageFailed(f func(*GCMessageFailed, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GCMessageFailed)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GCMessageFailed{}), SteamGameCoordinatorCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GCMessageFailed{}), SteamGameCoordinatorCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSClientApprove(f func(*GSClientApprove, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSClientApprove(f func(*GSClientApprove, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSClientApprove)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSClientApprove{}), SteamGameServerCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSClientApprove{}), SteamGameServerCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSClientDeny(f func(*GSClientDeny, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSClientDeny(f func(*GSClientDeny, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSClientDeny)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSClientDeny{}), SteamGameServerCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSClientDeny{}), SteamGameServerCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSClientKick(f func(*GSClientKick, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSClientKick(f func(*GSClientKick, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSClientKick)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSClientKick{}), SteamGameServerCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSClientKick{}), SteamGameServerCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSClientAchievementStatus(f func(*GSClientAchievementStatus, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSClientAchievementStatus(f func(*GSClientAchievementStatus, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSClientAchievementStatus)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSClientAchievementStatus{}), SteamGameServerCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSClientAchievementStatus{}), SteamGameServerCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSPolicyResponse(f func(*GSPolicyResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSPolicyResponse(f func(*GSPolicyResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSPolicyResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSPolicyResponse{}), SteamUserCallbacks+15, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSPolicyResponse{}), SteamUserCallbacks+15, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSGameplayStats(f func(*GSGameplayStats, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSGameplayStats(f func(*GSGameplayStats, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSGameplayStats)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSGameplayStats{}), SteamGameServerCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSGameplayStats{}), SteamGameServerCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSClientGroupStatus(f func(*GSClientGroupStatus, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSClientGroupStatus(f func(*GSClientGroupStatus, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSClientGroupStatus)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSClientGroupStatus{}), SteamGameServerCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSClientGroupStatus{}), SteamGameServerCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSReputation(f func(*GSReputation, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSReputation(f func(*GSReputation, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSReputation)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSReputation{}), SteamGameServerCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSReputation{}), SteamGameServerCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_AssociateWithClanResult(f func(*AssociateWithClanResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_AssociateWithClanResult(f func(*AssociateWithClanResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*AssociateWithClanResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(AssociateWithClanResult{}), SteamGameServerCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(AssociateWithClanResult{}), SteamGameServerCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ComputeNewPlayerCompatibilityResult(f func(*ComputeNewPlayerCompatibilityResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ComputeNewPlayerCompatibilityResult(f func(*ComputeNewPlayerCompatibilityResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ComputeNewPlayerCompatibilityResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ComputeNewPlayerCompatibilityResult{}), SteamGameServerCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ComputeNewPlayerCompatibilityResult{}), SteamGameServerCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSStatsStored(f func(*GSStatsStored, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSStatsStored(f func(*GSStatsStored, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSStatsStored)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSStatsStored{}), SteamGameServerStatsCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSStatsStored{}), SteamGameServerStatsCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GSStatsUnloaded(f func(*GSStatsUnloaded, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GSStatsUnloaded(f func(*GSStatsUnloaded, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GSStatsUnloaded)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GSStatsUnloaded{}), SteamUserStatsCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GSStatsUnloaded{}), SteamUserStatsCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_BrowserReady(f func(*HTML_BrowserReady, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_BrowserReady(f func(*HTML_BrowserReady, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_BrowserReady)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_BrowserReady{}), SteamHTMLSurfaceCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_BrowserReady{}), SteamHTMLSurfaceCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_NeedsPaint(f func(*HTML_NeedsPaint, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_NeedsPaint(f func(*HTML_NeedsPaint, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_NeedsPaint)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_NeedsPaint{}), SteamHTMLSurfaceCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_NeedsPaint{}), SteamHTMLSurfaceCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_StartRequest(f func(*HTML_StartRequest, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_StartRequest(f func(*HTML_StartRequest, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_StartRequest)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_StartRequest{}), SteamHTMLSurfaceCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_StartRequest{}), SteamHTMLSurfaceCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_CloseBrowser(f func(*HTML_CloseBrowser, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_CloseBrowser(f func(*HTML_CloseBrowser, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_CloseBrowser)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_CloseBrowser{}), SteamHTMLSurfaceCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_CloseBrowser{}), SteamHTMLSurfaceCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_URLChanged(f func(*HTML_URLChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_URLChanged(f func(*HTML_URLChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_URLChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_URLChanged{}), SteamHTMLSurfaceCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_URLChanged{}), SteamHTMLSurfaceCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_FinishedRequest(f func(*HTML_FinishedRequest, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_FinishedRequest(f func(*HTML_FinishedRequest, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_FinishedRequest)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_FinishedRequest{}), SteamHTMLSurfaceCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_FinishedRequest{}), SteamHTMLSurfaceCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_OpenLinkInNewTab(f func(*HTML_OpenLinkInNewTab, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_OpenLinkInNewTab(f func(*HTML_OpenLinkInNewTab, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_OpenLinkInNewTab)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_OpenLinkInNewTab{}), SteamHTMLSurfaceCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_OpenLinkInNewTab{}), SteamHTMLSurfaceCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_ChangedTitle(f func(*HTML_ChangedTitle, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_ChangedTitle(f func(*HTML_ChangedTitle, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_ChangedTitle)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_ChangedTitle{}), SteamHTMLSurfaceCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_ChangedTitle{}), SteamHTMLSurfaceCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_SearchResults(f func(*HTML_SearchResults, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_SearchResults(f func(*HTML_SearchResults, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_SearchResults)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_SearchResults{}), SteamHTMLSurfaceCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_SearchResults{}), SteamHTMLSurfaceCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_CanGoBackAndForward(f func(*HTML_CanGoBackAndForward, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_CanGoBackAndForward(f func(*HTML_CanGoBackAndForward, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_CanGoBackAndForward)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_CanGoBackAndForward{}), SteamHTMLSurfaceCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_CanGoBackAndForward{}), SteamHTMLSurfaceCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_HorizontalScroll(f func(*HTML_HorizontalScroll, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_HorizontalScroll(f func(*HTML_HorizontalScroll, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_HorizontalScroll)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_HorizontalScroll{}), SteamHTMLSurfaceCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_HorizontalScroll{}), SteamHTMLSurfaceCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_VerticalScroll(f func(*HTML_VerticalScroll, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_VerticalScroll(f func(*HTML_VerticalScroll, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_VerticalScroll)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_VerticalScroll{}), SteamHTMLSurfaceCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_VerticalScroll{}), SteamHTMLSurfaceCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_LinkAtPosition(f func(*HTML_LinkAtPosition, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_LinkAtPosition(f func(*HTML_LinkAtPosition, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_LinkAtPosition)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_LinkAtPosition{}), SteamHTMLSurfaceCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_LinkAtPosition{}), SteamHTMLSurfaceCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_JSAlert(f func(*HTML_JSAlert, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_JSAlert(f func(*HTML_JSAlert, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_JSAlert)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_JSAlert{}), SteamHTMLSurfaceCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_JSAlert{}), SteamHTMLSurfaceCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_JSConfirm(f func(*HTML_JSConfirm, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_JSConfirm(f func(*HTML_JSConfirm, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_JSConfirm)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_JSConfirm{}), SteamHTMLSurfaceCallbacks+15, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_JSConfirm{}), SteamHTMLSurfaceCallbacks+15, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_FileOpenDialog(f func(*HTML_FileOpenDialog, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_FileOpenDialog(f func(*HTML_FileOpenDialog, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_FileOpenDialog)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_FileOpenDialog{}), SteamHTMLSurfaceCallbacks+16, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_FileOpenDialog{}), SteamHTMLSurfaceCallbacks+16, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_NewWindow(f func(*HTML_NewWindow, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_NewWindow(f func(*HTML_NewWindow, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_NewWindow)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_NewWindow{}), SteamHTMLSurfaceCallbacks+21, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_NewWindow{}), SteamHTMLSurfaceCallbacks+21, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_SetCursor(f func(*HTML_SetCursor, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_SetCursor(f func(*HTML_SetCursor, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_SetCursor)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_SetCursor{}), SteamHTMLSurfaceCallbacks+22, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_SetCursor{}), SteamHTMLSurfaceCallbacks+22, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_StatusText(f func(*HTML_StatusText, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_StatusText(f func(*HTML_StatusText, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_StatusText)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_StatusText{}), SteamHTMLSurfaceCallbacks+23, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_StatusText{}), SteamHTMLSurfaceCallbacks+23, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_ShowToolTip(f func(*HTML_ShowToolTip, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_ShowToolTip(f func(*HTML_ShowToolTip, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_ShowToolTip)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_ShowToolTip{}), SteamHTMLSurfaceCallbacks+24, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_ShowToolTip{}), SteamHTMLSurfaceCallbacks+24, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_UpdateToolTip(f func(*HTML_UpdateToolTip, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_UpdateToolTip(f func(*HTML_UpdateToolTip, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_UpdateToolTip)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_UpdateToolTip{}), SteamHTMLSurfaceCallbacks+25, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_UpdateToolTip{}), SteamHTMLSurfaceCallbacks+25, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_HideToolTip(f func(*HTML_HideToolTip, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_HideToolTip(f func(*HTML_HideToolTip, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_HideToolTip)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_HideToolTip{}), SteamHTMLSurfaceCallbacks+26, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_HideToolTip{}), SteamHTMLSurfaceCallbacks+26, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTML_BrowserRestarted(f func(*HTML_BrowserRestarted, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTML_BrowserRestarted(f func(*HTML_BrowserRestarted, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTML_BrowserRestarted)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTML_BrowserRestarted{}), SteamHTMLSurfaceCallbacks+27, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTML_BrowserRestarted{}), SteamHTMLSurfaceCallbacks+27, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTTPRequestCompleted(f func(*HTTPRequestCompleted, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTTPRequestCompleted(f func(*HTTPRequestCompleted, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTTPRequestCompleted)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTTPRequestCompleted{}), ClientHTTPCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTTPRequestCompleted{}), ClientHTTPCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTTPRequestHeadersReceived(f func(*HTTPRequestHeadersReceived, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTTPRequestHeadersReceived(f func(*HTTPRequestHeadersReceived, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTTPRequestHeadersReceived)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTTPRequestHeadersReceived{}), ClientHTTPCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTTPRequestHeadersReceived{}), ClientHTTPCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_HTTPRequestDataReceived(f func(*HTTPRequestDataReceived, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_HTTPRequestDataReceived(f func(*HTTPRequestDataReceived, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*HTTPRequestDataReceived)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(HTTPRequestDataReceived{}), ClientHTTPCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(HTTPRequestDataReceived{}), ClientHTTPCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryResultReady(f func(*SteamInventoryResultReady, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryResultReady(f func(*SteamInventoryResultReady, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryResultReady)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryResultReady{}), ClientInventoryCallbacks+0, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryResultReady{}), ClientInventoryCallbacks+0, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryFullUpdate(f func(*SteamInventoryFullUpdate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryFullUpdate(f func(*SteamInventoryFullUpdate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryFullUpdate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryFullUpdate{}), ClientInventoryCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryFullUpdate{}), ClientInventoryCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryDefinitionUpdate(f func(*SteamInventoryDefinitionUpdate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryDefinitionUpdate(f func(*SteamInventoryDefinitionUpdate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryDefinitionUpdate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryDefinitionUpdate{}), ClientInventoryCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryDefinitionUpdate{}), ClientInventoryCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryEligiblePromoItemDefIDs(f func(*SteamInventoryEligiblePromoItemDefIDs, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryEligiblePromoItemDefIDs(f func(*SteamInventoryEligiblePromoItemDefIDs, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryEligiblePromoItemDefIDs)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryEligiblePromoItemDefIDs{}), ClientInventoryCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryEligiblePromoItemDefIDs{}), ClientInventoryCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryStartPurchaseResult(f func(*SteamInventoryStartPurchaseResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryStartPurchaseResult(f func(*SteamInventoryStartPurchaseResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryStartPurchaseResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryStartPurchaseResult{}), ClientInventoryCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryStartPurchaseResult{}), ClientInventoryCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamInventoryRequestPricesResult(f func(*SteamInventoryRequestPricesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamInventoryRequestPricesResult(f func(*SteamInventoryRequestPricesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamInventoryRequestPricesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamInventoryRequestPricesResult{}), ClientInventoryCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamInventoryRequestPricesResult{}), ClientInventoryCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FavoritesListChanged(f func(*FavoritesListChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FavoritesListChanged(f func(*FavoritesListChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FavoritesListChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FavoritesListChanged{}), SteamMatchmakingCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FavoritesListChanged{}), SteamMatchmakingCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyInvite(f func(*LobbyInvite, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyInvite(f func(*LobbyInvite, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyInvite)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyInvite{}), SteamMatchmakingCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyInvite{}), SteamMatchmakingCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyEnter(f func(*LobbyEnter, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyEnter(f func(*LobbyEnter, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyEnter)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyEnter{}), SteamMatchmakingCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyEnter{}), SteamMatchmakingCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyDataUpdate(f func(*LobbyDataUpdate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyDataUpdate(f func(*LobbyDataUpdate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyDataUpdate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyDataUpdate{}), SteamMatchmakingCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyDataUpdate{}), SteamMatchmakingCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyChatUpdate(f func(*LobbyChatUpdate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyChatUpdate(f func(*LobbyChatUpdate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyChatUpdate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyChatUpdate{}), SteamMatchmakingCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyChatUpdate{}), SteamMatchmakingCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyChatMsg(f func(*LobbyChatMsg, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyChatMsg(f func(*LobbyChatMsg, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyChatMsg)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyChatMsg{}), SteamMatchmakingCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyChatMsg{}), SteamMatchmakingCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyGameCreated(f func(*LobbyGameCreated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyGameCreated(f func(*LobbyGameCreated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyGameCreated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyGameCreated{}), SteamMatchmakingCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyGameCreated{}), SteamMatchmakingCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyMatchList(f func(*LobbyMatchList, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyMatchList(f func(*LobbyMatchList, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyMatchList)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyMatchList{}), SteamMatchmakingCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyMatchList{}), SteamMatchmakingCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyKicked(f func(*LobbyKicked, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyKicked(f func(*LobbyKicked, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyKicked)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyKicked{}), SteamMatchmakingCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyKicked{}), SteamMatchmakingCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LobbyCreated(f func(*LobbyCreated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LobbyCreated(f func(*LobbyCreated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LobbyCreated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LobbyCreated{}), SteamMatchmakingCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LobbyCreated{}), SteamMatchmakingCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_PSNGameBootInviteResult(f func(*PSNGameBootInviteResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_PSNGameBootInviteResult(f func(*PSNGameBootInviteResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*PSNGameBootInviteResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(PSNGameBootInviteResult{}), SteamMatchmakingCallbacks+15, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(PSNGameBootInviteResult{}), SteamMatchmakingCallbacks+15, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_FavoritesListAccountsUpdated(f func(*FavoritesListAccountsUpdated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_FavoritesListAccountsUpdated(f func(*FavoritesListAccountsUpdated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*FavoritesListAccountsUpdated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(FavoritesListAccountsUpdated{}), SteamMatchmakingCallbacks+16, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(FavoritesListAccountsUpdated{}), SteamMatchmakingCallbacks+16, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_PlaybackStatusHasChanged(f func(*PlaybackStatusHasChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_PlaybackStatusHasChanged(f func(*PlaybackStatusHasChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*PlaybackStatusHasChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(PlaybackStatusHasChanged{}), SteamMusicCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(PlaybackStatusHasChanged{}), SteamMusicCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_VolumeHasChanged(f func(*VolumeHasChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_VolumeHasChanged(f func(*VolumeHasChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*VolumeHasChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(VolumeHasChanged{}), SteamMusicCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(VolumeHasChanged{}), SteamMusicCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerRemoteWillActivate(f func(*MusicPlayerRemoteWillActivate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerRemoteWillActivate(f func(*MusicPlayerRemoteWillActivate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerRemoteWillActivate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerRemoteWillActivate{}), SteamMusicRemoteCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerRemoteWillActivate{}), SteamMusicRemoteCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerRemoteWillDeactivate(f func(*MusicPlayerRemoteWillDeactivate, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerRemoteWillDeactivate(f func(*MusicPlayerRemoteWillDeactivate, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerRemoteWillDeactivate)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerRemoteWillDeactivate{}), SteamMusicRemoteCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerRemoteWillDeactivate{}), SteamMusicRemoteCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerRemoteToFront(f func(*MusicPlayerRemoteToFront, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerRemoteToFront(f func(*MusicPlayerRemoteToFront, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerRemoteToFront)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerRemoteToFront{}), SteamMusicRemoteCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerRemoteToFront{}), SteamMusicRemoteCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWillQuit(f func(*MusicPlayerWillQuit, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWillQuit(f func(*MusicPlayerWillQuit, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWillQuit)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWillQuit{}), SteamMusicRemoteCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWillQuit{}), SteamMusicRemoteCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsPlay(f func(*MusicPlayerWantsPlay, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsPlay(f func(*MusicPlayerWantsPlay, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsPlay)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsPlay{}), SteamMusicRemoteCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsPlay{}), SteamMusicRemoteCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsPause(f func(*MusicPlayerWantsPause, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsPause(f func(*MusicPlayerWantsPause, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsPause)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsPause{}), SteamMusicRemoteCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsPause{}), SteamMusicRemoteCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsPlayPrevious(f func(*MusicPlayerWantsPlayPrevious, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsPlayPrevious(f func(*MusicPlayerWantsPlayPrevious, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsPlayPrevious)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsPlayPrevious{}), SteamMusicRemoteCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsPlayPrevious{}), SteamMusicRemoteCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsPlayNext(f func(*MusicPlayerWantsPlayNext, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsPlayNext(f func(*MusicPlayerWantsPlayNext, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsPlayNext)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsPlayNext{}), SteamMusicRemoteCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsPlayNext{}), SteamMusicRemoteCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsShuffled(f func(*MusicPlayerWantsShuffled, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsShuffled(f func(*MusicPlayerWantsShuffled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsShuffled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsShuffled{}), SteamMusicRemoteCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsShuffled{}), SteamMusicRemoteCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsLooped(f func(*MusicPlayerWantsLooped, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsLooped(f func(*MusicPlayerWantsLooped, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsLooped)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsLooped{}), SteamMusicRemoteCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsLooped{}), SteamMusicRemoteCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsVolume(f func(*MusicPlayerWantsVolume, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsVolume(f func(*MusicPlayerWantsVolume, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsVolume)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsVolume{}), SteamMusicCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsVolume{}), SteamMusicCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerSelectsQueueEntry(f func(*MusicPlayerSelectsQueueEntry, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerSelectsQueueEntry(f func(*MusicPlayerSelectsQueueEntry, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerSelectsQueueEntry)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerSelectsQueueEntry{}), SteamMusicCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerSelectsQueueEntry{}), SteamMusicCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerSelectsPlaylistEntry(f func(*MusicPlayerSelectsPlaylistEntry, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerSelectsPlaylistEntry(f func(*MusicPlayerSelectsPlaylistEntry, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerSelectsPlaylistEntry)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerSelectsPlaylistEntry{}), SteamMusicCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerSelectsPlaylistEntry{}), SteamMusicCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MusicPlayerWantsPlayingRepeatStatus(f func(*MusicPlayerWantsPlayingRepeatStatus, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MusicPlayerWantsPlayingRepeatStatus(f func(*MusicPlayerWantsPlayingRepeatStatus, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MusicPlayerWantsPlayingRepeatStatus)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MusicPlayerWantsPlayingRepeatStatus{}), SteamMusicRemoteCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MusicPlayerWantsPlayingRepeatStatus{}), SteamMusicRemoteCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_P2PSessionRequest(f func(*P2PSessionRequest, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_P2PSessionRequest(f func(*P2PSessionRequest, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*P2PSessionRequest)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(P2PSessionRequest{}), SteamNetworkingCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(P2PSessionRequest{}), SteamNetworkingCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_P2PSessionConnectFail(f func(*P2PSessionConnectFail, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_P2PSessionConnectFail(f func(*P2PSessionConnectFail, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*P2PSessionConnectFail)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(P2PSessionConnectFail{}), SteamNetworkingCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(P2PSessionConnectFail{}), SteamNetworkingCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SocketStatusCallback(f func(*SocketStatusCallback, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SocketStatusCallback(f func(*SocketStatusCallback, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SocketStatusCallback)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SocketStatusCallback{}), SteamNetworkingCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SocketStatusCallback{}), SteamNetworkingCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamParentalSettingsChanged(f func(*SteamParentalSettingsChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamParentalSettingsChanged(f func(*SteamParentalSettingsChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamParentalSettingsChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamParentalSettingsChanged{}), SteamParentalSettingsCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamParentalSettingsChanged{}), SteamParentalSettingsCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageAppSyncedClient(f func(*RemoteStorageAppSyncedClient, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageAppSyncedClient(f func(*RemoteStorageAppSyncedClient, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageAppSyncedClient)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageAppSyncedClient{}), ClientRemoteStorageCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageAppSyncedClient{}), ClientRemoteStorageCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageAppSyncedServer(f func(*RemoteStorageAppSyncedServer, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageAppSyncedServer(f func(*RemoteStorageAppSyncedServer, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageAppSyncedServer)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageAppSyncedServer{}), ClientRemoteStorageCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageAppSyncedServer{}), ClientRemoteStorageCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageAppSyncProgress(f func(*RemoteStorageAppSyncProgress, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageAppSyncProgress(f func(*RemoteStorageAppSyncProgress, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageAppSyncProgress)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageAppSyncProgress{}), ClientRemoteStorageCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageAppSyncProgress{}), ClientRemoteStorageCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageAppSyncStatusCheck(f func(*RemoteStorageAppSyncStatusCheck, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageAppSyncStatusCheck(f func(*RemoteStorageAppSyncStatusCheck, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageAppSyncStatusCheck)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageAppSyncStatusCheck{}), ClientRemoteStorageCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageAppSyncStatusCheck{}), ClientRemoteStorageCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageFileShareResult(f func(*RemoteStorageFileShareResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageFileShareResult(f func(*RemoteStorageFileShareResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageFileShareResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageFileShareResult{}), ClientRemoteStorageCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageFileShareResult{}), ClientRemoteStorageCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishFileResult(f func(*RemoteStoragePublishFileResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishFileResult(f func(*RemoteStoragePublishFileResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishFileResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishFileResult{}), ClientRemoteStorageCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishFileResult{}), ClientRemoteStorageCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageDeletePublishedFileResult(f func(*RemoteStorageDeletePublishedFileResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageDeletePublishedFileResult(f func(*RemoteStorageDeletePublishedFileResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageDeletePublishedFileResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageDeletePublishedFileResult{}), ClientRemoteStorageCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageDeletePublishedFileResult{}), ClientRemoteStorageCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageEnumerateUserPublishedFilesResult(f func(*RemoteStorageEnumerateUserPublishedFilesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageEnumerateUserPublishedFilesResult(f func(*RemoteStorageEnumerateUserPublishedFilesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageEnumerateUserPublishedFilesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageEnumerateUserPublishedFilesResult{}), ClientRemoteStorageCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageEnumerateUserPublishedFilesResult{}), ClientRemoteStorageCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageSubscribePublishedFileResult(f func(*RemoteStorageSubscribePublishedFileResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageSubscribePublishedFileResult(f func(*RemoteStorageSubscribePublishedFileResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageSubscribePublishedFileResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageSubscribePublishedFileResult{}), ClientRemoteStorageCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageSubscribePublishedFileResult{}), ClientRemoteStorageCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageEnumerateUserSubscribedFilesResult(f func(*RemoteStorageEnumerateUserSubscribedFilesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageEnumerateUserSubscribedFilesResult(f func(*RemoteStorageEnumerateUserSubscribedFilesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageEnumerateUserSubscribedFilesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageEnumerateUserSubscribedFilesResult{}), ClientRemoteStorageCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageEnumerateUserSubscribedFilesResult{}), ClientRemoteStorageCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageUnsubscribePublishedFileResult(f func(*RemoteStorageUnsubscribePublishedFileResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageUnsubscribePublishedFileResult(f func(*RemoteStorageUnsubscribePublishedFileResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageUnsubscribePublishedFileResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageUnsubscribePublishedFileResult{}), ClientRemoteStorageCallbacks+15, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageUnsubscribePublishedFileResult{}), ClientRemoteStorageCallbacks+15, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageUpdatePublishedFileResult(f func(*RemoteStorageUpdatePublishedFileResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageUpdatePublishedFileResult(f func(*RemoteStorageUpdatePublishedFileResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageUpdatePublishedFileResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageUpdatePublishedFileResult{}), ClientRemoteStorageCallbacks+16, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageUpdatePublishedFileResult{}), ClientRemoteStorageCallbacks+16, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageDownloadUGCResult(f func(*RemoteStorageDownloadUGCResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageDownloadUGCResult(f func(*RemoteStorageDownloadUGCResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageDownloadUGCResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageDownloadUGCResult{}), ClientRemoteStorageCallbacks+17, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageDownloadUGCResult{}), ClientRemoteStorageCallbacks+17, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageGetPublishedFileDetailsResult(f func(*RemoteStorageGetPublishedFileDetailsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageGetPublishedFileDetailsResult(f func(*RemoteStorageGetPublishedFileDetailsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageGetPublishedFileDetailsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageGetPublishedFileDetailsResult{}), ClientRemoteStorageCallbacks+18, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageGetPublishedFileDetailsResult{}), ClientRemoteStorageCallbacks+18, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageEnumerateWorkshopFilesResult(f func(*RemoteStorageEnumerateWorkshopFilesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageEnumerateWorkshopFilesResult(f func(*RemoteStorageEnumerateWorkshopFilesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageEnumerateWorkshopFilesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageEnumerateWorkshopFilesResult{}), ClientRemoteStorageCallbacks+19, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageEnumerateWorkshopFilesResult{}), ClientRemoteStorageCallbacks+19, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageGetPublishedItemVoteDetailsResult(f func(*RemoteStorageGetPublishedItemVoteDetailsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageGetPublishedItemVoteDetailsResult(f func(*RemoteStorageGetPublishedItemVoteDetailsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageGetPublishedItemVoteDetailsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageGetPublishedItemVoteDetailsResult{}), ClientRemoteStorageCallbacks+20, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageGetPublishedItemVoteDetailsResult{}), ClientRemoteStorageCallbacks+20, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishedFileSubscribed(f func(*RemoteStoragePublishedFileSubscribed, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishedFileSubscribed(f func(*RemoteStoragePublishedFileSubscribed, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishedFileSubscribed)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishedFileSubscribed{}), ClientRemoteStorageCallbacks+21, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishedFileSubscribed{}), ClientRemoteStorageCallbacks+21, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishedFileUnsubscribed(f func(*RemoteStoragePublishedFileUnsubscribed, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishedFileUnsubscribed(f func(*RemoteStoragePublishedFileUnsubscribed, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishedFileUnsubscribed)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishedFileUnsubscribed{}), ClientRemoteStorageCallbacks+22, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishedFileUnsubscribed{}), ClientRemoteStorageCallbacks+22, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishedFileDeleted(f func(*RemoteStoragePublishedFileDeleted, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishedFileDeleted(f func(*RemoteStoragePublishedFileDeleted, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishedFileDeleted)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishedFileDeleted{}), ClientRemoteStorageCallbacks+23, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishedFileDeleted{}), ClientRemoteStorageCallbacks+23, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageUpdateUserPublishedItemVoteResult(f func(*RemoteStorageUpdateUserPublishedItemVoteResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageUpdateUserPublishedItemVoteResult(f func(*RemoteStorageUpdateUserPublishedItemVoteResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageUpdateUserPublishedItemVoteResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageUpdateUserPublishedItemVoteResult{}), ClientRemoteStorageCallbacks+24, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageUpdateUserPublishedItemVoteResult{}), ClientRemoteStorageCallbacks+24, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageUserVoteDetails(f func(*RemoteStorageUserVoteDetails, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageUserVoteDetails(f func(*RemoteStorageUserVoteDetails, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageUserVoteDetails)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageUserVoteDetails{}), ClientRemoteStorageCallbacks+25, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageUserVoteDetails{}), ClientRemoteStorageCallbacks+25, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageEnumerateUserSharedWorkshopFilesResult(f func(*RemoteStorageEnumerateUserSharedWorkshopFilesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageEnumerateUserSharedWorkshopFilesResult(f func(*RemoteStorageEnumerateUserSharedWorkshopFilesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageEnumerateUserSharedWorkshopFilesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageEnumerateUserSharedWorkshopFilesResult{}), ClientRemoteStorageCallbacks+26, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageEnumerateUserSharedWorkshopFilesResult{}), ClientRemoteStorageCallbacks+26, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageSetUserPublishedFileActionResult(f func(*RemoteStorageSetUserPublishedFileActionResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageSetUserPublishedFileActionResult(f func(*RemoteStorageSetUserPublishedFileActionResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageSetUserPublishedFileActionResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageSetUserPublishedFileActionResult{}), ClientRemoteStorageCallbacks+27, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageSetUserPublishedFileActionResult{}), ClientRemoteStorageCallbacks+27, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageEnumeratePublishedFilesByUserActionResult(f func(*RemoteStorageEnumeratePublishedFilesByUserActionResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageEnumeratePublishedFilesByUserActionResult(f func(*RemoteStorageEnumeratePublishedFilesByUserActionResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageEnumeratePublishedFilesByUserActionResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageEnumeratePublishedFilesByUserActionResult{}), ClientRemoteStorageCallbacks+28, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageEnumeratePublishedFilesByUserActionResult{}), ClientRemoteStorageCallbacks+28, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishFileProgress(f func(*RemoteStoragePublishFileProgress, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishFileProgress(f func(*RemoteStoragePublishFileProgress, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishFileProgress)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishFileProgress{}), ClientRemoteStorageCallbacks+29, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishFileProgress{}), ClientRemoteStorageCallbacks+29, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStoragePublishedFileUpdated(f func(*RemoteStoragePublishedFileUpdated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStoragePublishedFileUpdated(f func(*RemoteStoragePublishedFileUpdated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStoragePublishedFileUpdated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStoragePublishedFileUpdated{}), ClientRemoteStorageCallbacks+30, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStoragePublishedFileUpdated{}), ClientRemoteStorageCallbacks+30, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageFileWriteAsyncComplete(f func(*RemoteStorageFileWriteAsyncComplete, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageFileWriteAsyncComplete(f func(*RemoteStorageFileWriteAsyncComplete, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageFileWriteAsyncComplete)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageFileWriteAsyncComplete{}), ClientRemoteStorageCallbacks+31, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageFileWriteAsyncComplete{}), ClientRemoteStorageCallbacks+31, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoteStorageFileReadAsyncComplete(f func(*RemoteStorageFileReadAsyncComplete, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoteStorageFileReadAsyncComplete(f func(*RemoteStorageFileReadAsyncComplete, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoteStorageFileReadAsyncComplete)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoteStorageFileReadAsyncComplete{}), ClientRemoteStorageCallbacks+32, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoteStorageFileReadAsyncComplete{}), ClientRemoteStorageCallbacks+32, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ScreenshotReady(f func(*ScreenshotReady, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ScreenshotReady(f func(*ScreenshotReady, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ScreenshotReady)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ScreenshotReady{}), SteamScreenshotsCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ScreenshotReady{}), SteamScreenshotsCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ScreenshotRequested(f func(*ScreenshotRequested, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ScreenshotRequested(f func(*ScreenshotRequested, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ScreenshotRequested)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ScreenshotRequested{}), SteamScreenshotsCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ScreenshotRequested{}), SteamScreenshotsCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamUGCQueryCompleted(f func(*SteamUGCQueryCompleted, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamUGCQueryCompleted(f func(*SteamUGCQueryCompleted, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamUGCQueryCompleted)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamUGCQueryCompleted{}), ClientUGCCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamUGCQueryCompleted{}), ClientUGCCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamUGCRequestUGCDetailsResult(f func(*SteamUGCRequestUGCDetailsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamUGCRequestUGCDetailsResult(f func(*SteamUGCRequestUGCDetailsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamUGCRequestUGCDetailsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamUGCRequestUGCDetailsResult{}), ClientUGCCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamUGCRequestUGCDetailsResult{}), ClientUGCCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_CreateItemResult(f func(*CreateItemResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_CreateItemResult(f func(*CreateItemResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*CreateItemResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(CreateItemResult{}), ClientUGCCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(CreateItemResult{}), ClientUGCCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SubmitItemUpdateResult(f func(*SubmitItemUpdateResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SubmitItemUpdateResult(f func(*SubmitItemUpdateResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SubmitItemUpdateResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SubmitItemUpdateResult{}), ClientUGCCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SubmitItemUpdateResult{}), ClientUGCCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ItemInstalled(f func(*ItemInstalled, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ItemInstalled(f func(*ItemInstalled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ItemInstalled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ItemInstalled{}), ClientUGCCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ItemInstalled{}), ClientUGCCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_DownloadItemResult(f func(*DownloadItemResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_DownloadItemResult(f func(*DownloadItemResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*DownloadItemResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(DownloadItemResult{}), ClientUGCCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(DownloadItemResult{}), ClientUGCCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserFavoriteItemsListChanged(f func(*UserFavoriteItemsListChanged, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserFavoriteItemsListChanged(f func(*UserFavoriteItemsListChanged, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserFavoriteItemsListChanged)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserFavoriteItemsListChanged{}), ClientUGCCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserFavoriteItemsListChanged{}), ClientUGCCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SetUserItemVoteResult(f func(*SetUserItemVoteResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SetUserItemVoteResult(f func(*SetUserItemVoteResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SetUserItemVoteResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SetUserItemVoteResult{}), ClientUGCCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SetUserItemVoteResult{}), ClientUGCCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GetUserItemVoteResult(f func(*GetUserItemVoteResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GetUserItemVoteResult(f func(*GetUserItemVoteResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GetUserItemVoteResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GetUserItemVoteResult{}), ClientUGCCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GetUserItemVoteResult{}), ClientUGCCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_StartPlaytimeTrackingResult(f func(*StartPlaytimeTrackingResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_StartPlaytimeTrackingResult(f func(*StartPlaytimeTrackingResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*StartPlaytimeTrackingResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(StartPlaytimeTrackingResult{}), ClientUGCCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(StartPlaytimeTrackingResult{}), ClientUGCCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_StopPlaytimeTrackingResult(f func(*StopPlaytimeTrackingResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_StopPlaytimeTrackingResult(f func(*StopPlaytimeTrackingResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*StopPlaytimeTrackingResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(StopPlaytimeTrackingResult{}), ClientUGCCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(StopPlaytimeTrackingResult{}), ClientUGCCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_AddUGCDependencyResult(f func(*AddUGCDependencyResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_AddUGCDependencyResult(f func(*AddUGCDependencyResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*AddUGCDependencyResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(AddUGCDependencyResult{}), ClientUGCCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(AddUGCDependencyResult{}), ClientUGCCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoveUGCDependencyResult(f func(*RemoveUGCDependencyResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoveUGCDependencyResult(f func(*RemoveUGCDependencyResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoveUGCDependencyResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoveUGCDependencyResult{}), ClientUGCCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoveUGCDependencyResult{}), ClientUGCCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_AddAppDependencyResult(f func(*AddAppDependencyResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_AddAppDependencyResult(f func(*AddAppDependencyResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*AddAppDependencyResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(AddAppDependencyResult{}), ClientUGCCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(AddAppDependencyResult{}), ClientUGCCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_RemoveAppDependencyResult(f func(*RemoveAppDependencyResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_RemoveAppDependencyResult(f func(*RemoveAppDependencyResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*RemoveAppDependencyResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(RemoveAppDependencyResult{}), ClientUGCCallbacks+15, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(RemoveAppDependencyResult{}), ClientUGCCallbacks+15, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GetAppDependenciesResult(f func(*GetAppDependenciesResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GetAppDependenciesResult(f func(*GetAppDependenciesResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GetAppDependenciesResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GetAppDependenciesResult{}), ClientUGCCallbacks+16, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GetAppDependenciesResult{}), ClientUGCCallbacks+16, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_DeleteItemResult(f func(*DeleteItemResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_DeleteItemResult(f func(*DeleteItemResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*DeleteItemResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(DeleteItemResult{}), ClientUGCCallbacks+17, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(DeleteItemResult{}), ClientUGCCallbacks+17, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamServersConnected(f func(*SteamServersConnected, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamServersConnected(f func(*SteamServersConnected, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamServersConnected)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamServersConnected{}), SteamUserCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamServersConnected{}), SteamUserCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamServerConnectFailure(f func(*SteamServerConnectFailure, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamServerConnectFailure(f func(*SteamServerConnectFailure, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamServerConnectFailure)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamServerConnectFailure{}), SteamUserCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamServerConnectFailure{}), SteamUserCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamServersDisconnected(f func(*SteamServersDisconnected, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamServersDisconnected(f func(*SteamServersDisconnected, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamServersDisconnected)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamServersDisconnected{}), SteamUserCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamServersDisconnected{}), SteamUserCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ClientGameServerDeny(f func(*ClientGameServerDeny, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ClientGameServerDeny(f func(*ClientGameServerDeny, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ClientGameServerDeny)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ClientGameServerDeny{}), SteamUserCallbacks+13, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ClientGameServerDeny{}), SteamUserCallbacks+13, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_IPCFailure(f func(*IPCFailure, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_IPCFailure(f func(*IPCFailure, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*IPCFailure)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(IPCFailure{}), SteamUserCallbacks+17, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(IPCFailure{}), SteamUserCallbacks+17, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LicensesUpdated(f func(*LicensesUpdated, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LicensesUpdated(f func(*LicensesUpdated, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LicensesUpdated)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LicensesUpdated{}), SteamUserCallbacks+25, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LicensesUpdated{}), SteamUserCallbacks+25, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_ValidateAuthTicketResponse(f func(*ValidateAuthTicketResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_ValidateAuthTicketResponse(f func(*ValidateAuthTicketResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*ValidateAuthTicketResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(ValidateAuthTicketResponse{}), SteamUserCallbacks+43, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(ValidateAuthTicketResponse{}), SteamUserCallbacks+43, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_MicroTxnAuthorizationResponse(f func(*MicroTxnAuthorizationResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_MicroTxnAuthorizationResponse(f func(*MicroTxnAuthorizationResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*MicroTxnAuthorizationResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(MicroTxnAuthorizationResponse{}), SteamUserCallbacks+52, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(MicroTxnAuthorizationResponse{}), SteamUserCallbacks+52, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_EncryptedAppTicketResponse(f func(*EncryptedAppTicketResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_EncryptedAppTicketResponse(f func(*EncryptedAppTicketResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*EncryptedAppTicketResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(EncryptedAppTicketResponse{}), SteamUserCallbacks+54, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(EncryptedAppTicketResponse{}), SteamUserCallbacks+54, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GetAuthSessionTicketResponse(f func(*GetAuthSessionTicketResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GetAuthSessionTicketResponse(f func(*GetAuthSessionTicketResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GetAuthSessionTicketResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GetAuthSessionTicketResponse{}), SteamUserCallbacks+63, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GetAuthSessionTicketResponse{}), SteamUserCallbacks+63, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GameWebCallback(f func(*GameWebCallback, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GameWebCallback(f func(*GameWebCallback, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GameWebCallback)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GameWebCallback{}), SteamUserCallbacks+64, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GameWebCallback{}), SteamUserCallbacks+64, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_StoreAuthURLResponse(f func(*StoreAuthURLResponse, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_StoreAuthURLResponse(f func(*StoreAuthURLResponse, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*StoreAuthURLResponse)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(StoreAuthURLResponse{}), SteamUserCallbacks+65, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(StoreAuthURLResponse{}), SteamUserCallbacks+65, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserStatsReceived(f func(*UserStatsReceived, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserStatsReceived(f func(*UserStatsReceived, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserStatsReceived)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserStatsReceived{}), SteamUserStatsCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserStatsReceived{}), SteamUserStatsCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserStatsStored(f func(*UserStatsStored, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserStatsStored(f func(*UserStatsStored, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserStatsStored)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserStatsStored{}), SteamUserStatsCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserStatsStored{}), SteamUserStatsCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserAchievementStored(f func(*UserAchievementStored, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserAchievementStored(f func(*UserAchievementStored, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserAchievementStored)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserAchievementStored{}), SteamUserStatsCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserAchievementStored{}), SteamUserStatsCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LeaderboardFindResult(f func(*LeaderboardFindResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LeaderboardFindResult(f func(*LeaderboardFindResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LeaderboardFindResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LeaderboardFindResult{}), SteamUserStatsCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LeaderboardFindResult{}), SteamUserStatsCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LeaderboardScoresDownloaded(f func(*LeaderboardScoresDownloaded, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LeaderboardScoresDownloaded(f func(*LeaderboardScoresDownloaded, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LeaderboardScoresDownloaded)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LeaderboardScoresDownloaded{}), SteamUserStatsCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LeaderboardScoresDownloaded{}), SteamUserStatsCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LeaderboardScoreUploaded(f func(*LeaderboardScoreUploaded, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LeaderboardScoreUploaded(f func(*LeaderboardScoreUploaded, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LeaderboardScoreUploaded)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LeaderboardScoreUploaded{}), SteamUserStatsCallbacks+6, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LeaderboardScoreUploaded{}), SteamUserStatsCallbacks+6, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_NumberOfCurrentPlayers(f func(*NumberOfCurrentPlayers, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_NumberOfCurrentPlayers(f func(*NumberOfCurrentPlayers, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*NumberOfCurrentPlayers)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(NumberOfCurrentPlayers{}), SteamUserStatsCallbacks+7, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(NumberOfCurrentPlayers{}), SteamUserStatsCallbacks+7, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserStatsUnloaded(f func(*UserStatsUnloaded, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserStatsUnloaded(f func(*UserStatsUnloaded, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserStatsUnloaded)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserStatsUnloaded{}), SteamUserStatsCallbacks+8, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserStatsUnloaded{}), SteamUserStatsCallbacks+8, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_UserAchievementIconFetched(f func(*UserAchievementIconFetched, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_UserAchievementIconFetched(f func(*UserAchievementIconFetched, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*UserAchievementIconFetched)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(UserAchievementIconFetched{}), SteamUserStatsCallbacks+9, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(UserAchievementIconFetched{}), SteamUserStatsCallbacks+9, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GlobalAchievementPercentagesReady(f func(*GlobalAchievementPercentagesReady, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GlobalAchievementPercentagesReady(f func(*GlobalAchievementPercentagesReady, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GlobalAchievementPercentagesReady)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GlobalAchievementPercentagesReady{}), SteamUserStatsCallbacks+10, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GlobalAchievementPercentagesReady{}), SteamUserStatsCallbacks+10, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LeaderboardUGCSet(f func(*LeaderboardUGCSet, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LeaderboardUGCSet(f func(*LeaderboardUGCSet, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LeaderboardUGCSet)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LeaderboardUGCSet{}), SteamUserStatsCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LeaderboardUGCSet{}), SteamUserStatsCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_PS3TrophiesInstalled(f func(*PS3TrophiesInstalled, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_PS3TrophiesInstalled(f func(*PS3TrophiesInstalled, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*PS3TrophiesInstalled)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(PS3TrophiesInstalled{}), SteamUserStatsCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(PS3TrophiesInstalled{}), SteamUserStatsCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GlobalStatsReceived(f func(*GlobalStatsReceived, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GlobalStatsReceived(f func(*GlobalStatsReceived, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GlobalStatsReceived)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GlobalStatsReceived{}), SteamUserStatsCallbacks+12, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GlobalStatsReceived{}), SteamUserStatsCallbacks+12, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_IPCountry(f func(*IPCountry, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_IPCountry(f func(*IPCountry, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*IPCountry)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(IPCountry{}), SteamUtilsCallbacks+1, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(IPCountry{}), SteamUtilsCallbacks+1, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_LowBatteryPower(f func(*LowBatteryPower, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_LowBatteryPower(f func(*LowBatteryPower, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*LowBatteryPower)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(LowBatteryPower{}), SteamUtilsCallbacks+2, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(LowBatteryPower{}), SteamUtilsCallbacks+2, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamAPICallCompleted(f func(*SteamAPICallCompleted, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamAPICallCompleted(f func(*SteamAPICallCompleted, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamAPICallCompleted)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamAPICallCompleted{}), SteamUtilsCallbacks+3, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamAPICallCompleted{}), SteamUtilsCallbacks+3, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_SteamShutdown(f func(*SteamShutdown, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_SteamShutdown(f func(*SteamShutdown, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*SteamShutdown)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(SteamShutdown{}), SteamUtilsCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(SteamShutdown{}), SteamUtilsCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_CheckFileSignature(f func(*CheckFileSignature, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_CheckFileSignature(f func(*CheckFileSignature, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*CheckFileSignature)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(CheckFileSignature{}), SteamUtilsCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(CheckFileSignature{}), SteamUtilsCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GamepadTextInputDismissed(f func(*GamepadTextInputDismissed, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GamepadTextInputDismissed(f func(*GamepadTextInputDismissed, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GamepadTextInputDismissed)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GamepadTextInputDismissed{}), SteamUtilsCallbacks+14, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GamepadTextInputDismissed{}), SteamUtilsCallbacks+14, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_BroadcastUploadStart(f func(*BroadcastUploadStart, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_BroadcastUploadStart(f func(*BroadcastUploadStart, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*BroadcastUploadStart)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(BroadcastUploadStart{}), ClientVideoCallbacks+4, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(BroadcastUploadStart{}), ClientVideoCallbacks+4, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_BroadcastUploadStop(f func(*BroadcastUploadStop, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_BroadcastUploadStop(f func(*BroadcastUploadStop, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*BroadcastUploadStop)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(BroadcastUploadStop{}), ClientVideoCallbacks+5, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(BroadcastUploadStop{}), ClientVideoCallbacks+5, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GetVideoURLResult(f func(*GetVideoURLResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GetVideoURLResult(f func(*GetVideoURLResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GetVideoURLResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GetVideoURLResult{}), ClientVideoCallbacks+11, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GetVideoURLResult{}), ClientVideoCallbacks+11, apiCall, side.GameServer())
 	return cb
 }
-func RegisterCallback_GetOPFSettingsResult(f func(*GetOPFSettingsResult, bool), apiCall SteamAPICall) registeredCallback {
+func RegisterCallback_GetOPFSettingsResult(f func(*GetOPFSettingsResult, bool), apiCall SteamAPICall, side Side) registeredCallback {
 	var cb registeredCallback
 	cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) {
 		f((*GetOPFSettingsResult)(cdata), ioFailure)
 		if apiCall != 0 {
 			cb.Unregister()
 		}
-	}, unsafe.Sizeof(GetOPFSettingsResult{}), ClientVideoCallbacks+24, apiCall, !IsGameClient)
+	}, unsafe.Sizeof(GetOPFSettingsResult{}), ClientVideoCallbacks+24, apiCall, side.GameServer())
 	return cb
 }
@@ -0,0 +1,9 @@
+#pragma once
+
+// api.h includes the generated declarations for the SDK selected by the
+// steamworks_flat build tag.
+#ifdef STEAMWORKS_FLAT
+#include "api_flat.gen.h"
+#else
+#include "api.gen.h"
+#endif
@@ -1,5 +1,6 @@
 #include "shim.h"
 
+#include <atomic>
 #include <map>
 #include <memory>
 #include <mutex>
@@ -13,8 +14,10 @@ extern "C" void onCallback(CallbackID_t callback_id, void * data, size_t data_le
 class CCallbackGo : public CCallbackBase
 {
 public:
-	CCallbackGo(size_t size, int callback_type_id, SteamAPICall_t api_call_id = k_uAPICallInvalid, bool game_server = false) : data_length(size), api_call_id(api_call_id)
+	CCallbackGo(CallbackID_t callback_id, size_t size, int callback_type_id, SteamAPICall_t api_call_id = k_uAPICallInvalid, bool game_server = false) : callback_id(callback_id), data_length(size), api_call_id(api_call_id)
 	{
+		m_iCallback = callback_type_id;
+
 		if (game_server)
 		{
 			m_nCallbackFlags |= k_ECallbackFlagsGameServer;
@@ -45,7 +48,7 @@ public:
 	}
 	virtual void Run(void *data)
 	{
-		onCallback(GetICallback(), data, data_length, false, api_call_id);
+		onCallback(callback_id, data, data_length, false, api_call_id);
 	}
 	virtual void Run(void *data, bool ioFailure, SteamAPICall_t hSteamAPICall)
 	{
@@ -54,7 +57,7 @@ public:
 			return;
 		}
 
-		onCallback(GetICallback(), data, data_length, ioFailure, hSteamAPICall);
+		onCallback(callback_id, data, data_length, ioFailure, hSteamAPICall);
 	}
 	virtual int GetCallbackSizeBytes()
 	{
@@ -62,18 +65,22 @@ public:
 	}
 
 protected:
+	const CallbackID_t callback_id;
 	const size_t data_length;
 	const SteamAPICall_t api_call_id;
 };
 
 static std::map<CallbackID_t, std::unique_ptr<CCallbackGo>> callbacks;
 static std::mutex callbacks_lock;
+// Multiple callbacks can be registered for the same callback type, so
+// GetICallback can't be used to identify them.
+static std::atomic<CallbackID_t> next_callback_id(1);
 
 extern "C" CallbackID_t Register_Callback(size_t size, int callback_type_id, SteamAPICall_t api_call_id, bool game_server)
 {
-	std::unique_ptr<CCallbackGo> cb(new CCallbackGo(size, callback_type_id, api_call_id, game_server));
+	CallbackID_t callback_id = next_callback_id++;
 
-	CallbackID_t callback_id = cb->GetICallback();
+	std::unique_ptr<CCallbackGo> cb(new CCallbackGo(callback_id, size, callback_type_id, api_call_id, game_server));
 
 	{
 		std::lock_guard<std::mutex> lock(callbacks_lock);
@@ -5,23 +5,58 @@
 package internal
 
 /*
-#include "api.gen.h"
+#include "api.h"
 #include "callback.h"
 #include <stdlib.h>
 */
 import "C"
 import (
 	"runtime"
+	"runtime/debug"
+	"sort"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 )
 
 var (
-	callbackLock sync.Mutex
-	callbacks    = make(map[C.CallbackID_t]func(unsafe.Pointer, uintptr, bool, SteamAPICall))
+	callbackLock   sync.Mutex
+	callbacks      = make(map[C.CallbackID_t]*callbackEntry)
+	recordCallback func(callbackType int32, apiCallID SteamAPICall, ioFailure bool, data []byte)
 )
 
+type callbackEntry struct {
+	fn           func(unsafe.Pointer, uintptr, bool, SteamAPICall)
+	size         uintptr
+	callbackType int32
+	apiCallID    SteamAPICall
+	gameServer   bool
+	registered   time.Time
+	pcs          []uintptr
+}
+
+// Side selects the game client or the game server for functions and
+// callbacks that are available on both.
+type Side uint8
+
+const (
+	// SideDefault uses the game server if only the game server is
+	// initialized, and the game client otherwise. SteamAPI_RunCallbacks and
+	// SteamAPI_Shutdown affect both.
+	SideDefault Side = iota
+	// SideClient uses the game client.
+	SideClient
+	// SideServer uses the game server.
+	SideServer
+)
+
+// GameServer returns true if s refers to the game server.
+func (s Side) GameServer() bool {
+	return s == SideServer || (s == SideDefault && IsGameServer && !IsGameClient)
+}
+
 // Cleanup should be called as follows:
 //
 //    defer internal.Cleanup()()
@@ -30,28 +65,168 @@ var (
 func Cleanup() func() {
 	runtime.LockOSThread()
 
+	trace := loadTraceCleanup()
+	if trace == nil {
+		return releaseThread
+	}
+
+	done := trace()
 	return func() {
-		SteamAPI_ReleaseCurrentThreadMemory()
-		runtime.UnlockOSThread()
+		releaseThread()
+		done()
 	}
 }
 
+func releaseThread() {
+	SteamAPI_ReleaseCurrentThreadMemory()
+	runtime.UnlockOSThread()
+}
+
 //export onCallback
 func onCallback(cbid C.CallbackID_t, data unsafe.Pointer, dataLength uintptr, ioFailure bool, apiCallID SteamAPICall) {
 	callbackLock.Lock()
 	cb := callbacks[cbid]
+	record := recordCallback
+	callbackLock.Unlock()
+
+	if cb == nil {
+		return
+	}
+
+	// Taps (which have no function) see every broadcast callback, and each
+	// call result only has one registration, so each callback is recorded
+	// once no matter how many handlers are registered for it.
+	if record != nil && (cb.fn == nil || cb.apiCallID != 0) {
+		record(cb.callbackType, apiCallID, ioFailure, C.GoBytes(data, C.int(dataLength)))
+	}
+
+	if cb.fn == nil {
+		return
+	}
+
+	if Dispatch == nil {
+		atomic.AddUint64(&Ran, 1)
+		protect(cb.callbackType, func() {
+			cb.fn(data, dataLength, ioFailure, apiCallID)
+		})
+		return
+	}
+
+	// The callback data is only valid until onCallback returns, so copy
+	// it in case Dispatch runs the callback later.
+	dispatchCopy(cb, C.GoBytes(data, C.int(dataLength)), ioFailure, apiCallID)
+}
+
+func dispatchCopy(cb *callbackEntry, buf []byte, ioFailure bool, apiCallID SteamAPICall) {
+	run := func() {
+		var copied unsafe.Pointer
+		if len(buf) != 0 {
+			copied = unsafe.Pointer(&buf[0])
+		}
+		protect(cb.callbackType, func() {
+			cb.fn(copied, uintptr(len(buf)), ioFailure, apiCallID)
+		})
+	}
+
+	if Dispatch == nil {
+		run()
+	} else {
+		Dispatch(run)
+	}
+}
+
+// Dispatch, if non-nil, is called with each callback instead of running it
+// immediately. (overwritten by steamworks while an executor is configured)
+var Dispatch func(func())
+
+// Ran counts the callbacks run immediately because Dispatch is nil, so that
+// the callback goroutine can tell whether a poll found anything.
+var Ran uint64
+
+// OnPanic is called with the value and stack trace of a panic recovered from
+// a callback. (overwritten by steamworks)
+var OnPanic = func(callbackType int32, value interface{}, stack []byte) {}
+
+// protect runs f, recovering any panic so that it does not unwind through
+// the C++ code that called onCallback.
+func protect(callbackType int32, f func()) {
+	if callbackType != 0 {
+		defer startDispatching(callbackType)()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			OnPanic(callbackType, r, debug.Stack())
+		}
+	}()
+
+	f()
+}
+
+// SetRecordCallback sets a function to be called with every callback Steam
+// delivers, or removes it if f is nil. Broadcast callbacks are only seen if
+// a tap is registered for their type using RegisterTap.
+func SetRecordCallback(f func(callbackType int32, apiCallID SteamAPICall, ioFailure bool, data []byte)) {
+	callbackLock.Lock()
+	recordCallback = f
+	callbackLock.Unlock()
+}
+
+// RegisterTap registers a callback of the given type that does nothing except
+// pass the callback to the function set by SetRecordCallback.
+func RegisterTap(callbackType int32, side Side) registeredCallback {
+	return registerCallback(nil, CallbackSizes[callbackType], callbackType, 0, side.GameServer())
+}
+
+// Replay delivers recorded callback data to the handlers registered for the
+// callback type, or to the call result handler for apiCallID if it is
+// non-zero, in the order they were registered. Data shorter than the callback
+// struct is padded with zeroes. Replay returns the number of handlers.
+func Replay(callbackType int32, apiCallID SteamAPICall, ioFailure bool, data []byte) int {
+	callbackLock.Lock()
+	var ids []C.CallbackID_t
+	for id, cb := range callbacks {
+		if cb.fn != nil && cb.callbackType == callbackType && cb.apiCallID == apiCallID {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	entries := make([]*callbackEntry, len(ids))
+	for i, id := range ids {
+		entries[i] = callbacks[id]
+	}
 	callbackLock.Unlock()
 
-	if cb != nil {
-		cb(data, dataLength, ioFailure, apiCallID)
+	for _, cb := range entries {
+		buf := make([]byte, cb.size)
+		if len(data) > len(buf) {
+			buf = make([]byte, len(data))
+		}
+		copy(buf, data)
+
+		dispatchCopy(cb, buf, ioFailure, apiCallID)
 	}
+
+	return len(entries)
 }
 
 func registerCallback(cb func(unsafe.Pointer, uintptr, bool, SteamAPICall), size uintptr, callbackType int32, apiCallID SteamAPICall, gameServer bool) registeredCallback {
+	// skip runtime.Callers and registerCallback
+	var pcs [32]uintptr
+	n := runtime.Callers(2, pcs[:])
+
 	cbid := C.Register_Callback(C.size_t(size), C.int(callbackType), apiCallID, C.bool(gameServer))
 
 	callbackLock.Lock()
-	callbacks[cbid] = cb
+	callbacks[cbid] = &callbackEntry{
+		fn:           cb,
+		size:         size,
+		callbackType: callbackType,
+		apiCallID:    apiCallID,
+		gameServer:   gameServer,
+		registered:   time.Now(),
+		pcs:          append([]uintptr(nil), pcs[:n]...),
+	}
 	callbackLock.Unlock()
 
 	return registeredCallback(cbid)
@@ -59,27 +234,66 @@ func registerCallback(cb func(unsafe.Pointer, uintptr, bool, SteamAPICall), size
 
 type registeredCallback C.CallbackID_t
 
+// Unregister unregisters the callback. Calling Unregister more than once has
+// no effect, as callback IDs are never reused.
 func (r registeredCallback) Unregister() {
 	cbid := C.CallbackID_t(r)
 
 	callbackLock.Lock()
+	_, ok := callbacks[cbid]
 	delete(callbacks, cbid)
 	callbackLock.Unlock()
 
-	C.Unregister_Callback(cbid)
+	if ok {
+		C.Unregister_Callback(cbid)
+	}
+}
+
+// RegisteredCallback describes a live callback registration.
+type RegisteredCallback struct {
+	ID           int32
+	CallbackType int32
+	APICallID    SteamAPICall
+	GameServer   bool
+	Registered   time.Time
+	PCs          []uintptr
+}
+
+// Registrations returns every live callback registration, in the order they
+// were registered.
+func Registrations() []RegisteredCallback {
+	callbackLock.Lock()
+	regs := make([]RegisteredCallback, 0, len(callbacks))
+	for id, cb := range callbacks {
+		regs = append(regs, RegisteredCallback{
+			ID:           int32(id),
+			CallbackType: cb.callbackType,
+			APICallID:    cb.apiCallID,
+			GameServer:   cb.gameServer,
+			Registered:   cb.registered,
+			PCs:          cb.pcs,
+		})
+	}
+	callbackLock.Unlock()
+
+	sort.Slice(regs, func(i, j int) bool { return regs[i].ID < regs[j].ID })
+
+	return regs
 }
 
 //export warningMessageHook
 func warningMessageHook(severity C.int, debugText *C.char) {
 	msg := C.GoString(debugText)
-	switch severity {
-	case 0:
-		OnDebugMessage(msg)
-	case 1:
-		OnWarningMessage(msg)
-	default:
-		panic("steamworks: unexpected message level " + strconv.FormatInt(int64(severity), 10) + ": " + msg)
-	}
+	protect(0, func() {
+		switch severity {
+		case 0:
+			OnDebugMessage(msg)
+		case 1:
+			OnWarningMessage(msg)
+		default:
+			OnWarningMessage("unexpected message level " + strconv.FormatInt(int64(severity), 10) + ": " + msg)
+		}
+	})
 }
 
 // Message hook stubs (overwritten by steamutils)
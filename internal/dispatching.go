@@ -0,0 +1,21 @@
+package internal
+
+import "sync/atomic"
+
+var dispatching int32 // accessed atomically
+
+// Dispatching returns the type of the callback whose handler is running, or
+// 0 if no handler is running. If handlers are running on more than one
+// goroutine, it returns the most recent one to start.
+func Dispatching() int32 {
+	return atomic.LoadInt32(&dispatching)
+}
+
+// startDispatching records that a handler for callbackType is running and
+// returns a function that records that it has finished.
+func startDispatching(callbackType int32) func() {
+	prev := atomic.SwapInt32(&dispatching, callbackType)
+	return func() {
+		atomic.StoreInt32(&dispatching, prev)
+	}
+}
@@ -1,6 +1,4 @@
 // Code generated by "go generate"; DO NOT EDIT.
-// +build windows linux darwin
-// +build 386 amd64
 
 package internal
 
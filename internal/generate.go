@@ -5,6 +5,7 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -13,34 +14,63 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
+)
+
+// flatTag is the build tag that selects the files generated from a newer
+// Steamworks SDK with -flat.
+const flatTag = "steamworks_flat"
+
+var (
+	flat     = flag.Bool("flat", false, "generate from a Steamworks SDK with the newer flat API (1.58 or later) in the sdk folder")
+	stringer = flag.Bool("stringer", true, "generate the String methods for enums with stringer")
 )
 
 func main() {
-	apiData := readAPIData()
-	apiData.Enums = append(apiData.Enums, &struct {
-		Enumname string
-		Values   []*struct {
-			Name, Value string
-		}
-	}{
-		Enumname: "EServerMode",
-		Values: []*struct{ Name, Value string }{
-			{"eServerModeInvalid", "0"},
-			{"eServerModeNoAuthentication", "1"},
-			{"eServerModeAuthentication", "2"},
-			{"eServerModeAuthenticationAndSecure", "3"},
-		},
-	})
-	callbacks := findCallbackDefs()
+	flag.Parse()
+
+	var apiData APIData
+	var callbacks []*CallbackDef
+	if *flat {
+		apiData, callbacks = readFlatAPIData()
+	} else {
+		apiData = readAPIData()
+		callbacks = findCallbackDefs()
+	}
+	for _, e := range fixupEnums {
+		addMissingEnum(&apiData, e)
+	}
 	addMissingCallbackStructs(&apiData, callbacks)
-	writeFile(apiData, callbacks)
-	if err := exec.Command("gofmt", "-r", "(x) -> x", "-w", "-s", "api.gen.go").Run(); err != nil {
+	interfaces := writeFile(apiData, callbacks)
+	writeInterfaces(apiData, interfaces)
+	writeEvents(apiData, callbacks)
+	if err := exec.Command("gofmt", "-r", "(x) -> x", "-w", "-s", genName("api.gen.go"), genName("../steamapi/interfaces.gen.go"), genName("../steamapi/steam.gen.go"), genName("../steamapi/steam_other.gen.go"), genName("consts.gen.go"), genName("types_other.gen.go"), genName("../events.gen.go"), genName("../events_steam.gen.go")).Run(); err != nil {
 		panic(err)
 	}
+	if *flat {
+		// Building with the flat tag fails until the files for the
+		// newer SDK exist, which would stop stringer from loading the
+		// package.
+		if err := os.Remove("noflat.go"); err != nil && !os.IsNotExist(err) {
+			panic(err)
+		}
+	}
+	if *stringer {
+		writeEnums(apiData)
+	}
+
+	if *flat {
+		fmt.Fprintln(os.Stderr, "generate: run go generate again without -flat so that the v1.42 files are excluded by the "+flatTag+" tag")
+	}
+}
+
+// writeEnums writes the String methods for the enums in apiData using
+// stringer.
+func writeEnums(apiData APIData) {
 	if err := exec.Command("go", "get", "golang.org/x/tools/cmd/stringer").Run(); err != nil {
 		panic(err)
 	}
-	f, err := os.Create("enums.gen.go")
+	f, err := os.Create(genName("enums.gen.go"))
 	if err != nil {
 		panic(err)
 	}
@@ -51,8 +81,7 @@ func main() {
 	}()
 
 	if _, err = f.WriteString("// Code generated by \"go generate\"; DO NOT EDIT.\n" +
-		"// +build windows linux darwin\n" +
-		"// +build 386 amd64\n" +
+		buildConstraint("") +
 		"\n" +
 		"package internal\n" +
 		"\n" +
@@ -67,7 +96,11 @@ func main() {
 	}()
 
 	for _, e := range apiData.Enums {
-		if err := exec.Command("stringer", "-output", "enums.gen.go.tmp", "-type", e.Enumname, "-trimprefix", e.Enumname+"_").Run(); err != nil {
+		args := []string{"-output", "enums.gen.go.tmp", "-type", e.Enumname, "-trimprefix", e.Enumname + "_"}
+		if *flat {
+			args = append(args, "-tags", flatTag)
+		}
+		if err := exec.Command("stringer", args...).Run(); err != nil {
 			panic(err)
 		}
 
@@ -83,8 +116,98 @@ func main() {
 	}
 }
 
-func writeFile(apiData APIData, callbacks []*CallbackDef) {
-	f, err := os.Create("api.gen.go")
+// sdkTag returns the build tag expression that selects the files being
+// generated. The files for the v1.42 SDK are only excluded by the flat tag
+// if the files for a newer SDK have been generated; otherwise, there would
+// be nothing to build with the tag.
+func sdkTag() string {
+	if *flat {
+		return flatTag
+	}
+	if _, err := os.Stat(strings.Replace("consts.gen.go", ".gen.", "_flat.gen.", 1)); err == nil {
+		return "!" + flatTag
+	}
+	return ""
+}
+
+// buildConstraint returns the build constraint lines for a generated file
+// that is built when goBuild is satisfied and the SDK being generated for is
+// selected. plusBuild is goBuild in the older // +build syntax.
+func buildConstraint(goBuild string, plusBuild ...string) string {
+	if tag := sdkTag(); tag != "" {
+		if goBuild == "" {
+			goBuild = tag
+		} else {
+			goBuild += " && " + tag
+		}
+		plusBuild = append(plusBuild, tag)
+	}
+
+	if goBuild == "" {
+		return ""
+	}
+
+	constraint := "//go:build " + goBuild + "\n"
+	for _, line := range plusBuild {
+		constraint += "// +build " + line + "\n"
+	}
+	return constraint
+}
+
+// genName returns the name of the generated file for the SDK being generated
+// for. The files for the newer SDK have a _flat suffix so that both can be
+// checked in.
+func genName(name string) string {
+	if *flat {
+		return strings.Replace(name, ".gen.", "_flat.gen.", 1)
+	}
+	return name
+}
+
+// sdkDir returns the folder containing the include and lib folders of the SDK
+// being generated for.
+func sdkDir() string {
+	if *flat {
+		return "sdk"
+	}
+	return "."
+}
+
+// fixupEnums are enums that are missing from some versions of
+// steam_api.json.
+var fixupEnums = []*Enum{
+	{
+		Enumname: "EServerMode",
+		Values: []*EnumValue{
+			{"eServerModeInvalid", "0"},
+			{"eServerModeNoAuthentication", "1"},
+			{"eServerModeAuthentication", "2"},
+			{"eServerModeAuthenticationAndSecure", "3"},
+		},
+	},
+}
+
+// enumFields are callback fields that hold an enum or a bitfield of enum
+// values but are declared as a plain integer, keyed by the struct and the
+// name of the Go field.
+var enumFields = map[string]string{
+	"PersonaStateChange_t.ChangeFlags":        "EPersonaChange",
+	"LobbyChatUpdate_t.ChatMemberStateChange": "EChatMemberStateChange",
+}
+
+func addMissingEnum(apiData *APIData, enum *Enum) {
+	for _, e := range apiData.Enums {
+		if e.Enumname == enum.Enumname {
+			return
+		}
+	}
+	apiData.Enums = append(apiData.Enums, enum)
+}
+
+// writeFile writes the bindings for the flat API and returns the Steam
+// interfaces they belong to.
+func writeFile(apiData APIData, callbacks []*CallbackDef) []*Interface {
+	f, err := os.Create(genName("api.gen.go"))
 	if err != nil {
 		panic(err)
 	}
@@ -93,7 +216,7 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			panic(err)
 		}
 	}()
-	cppf, err := os.Create("api.gen.cpp")
+	cppf, err := os.Create(genName("api.gen.cpp"))
 	if err != nil {
 		panic(err)
 	}
@@ -102,7 +225,7 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			panic(err)
 		}
 	}()
-	hf, err := os.Create("api.gen.h")
+	hf, err := os.Create(genName("api.gen.h"))
 	if err != nil {
 		panic(err)
 	}
@@ -111,6 +234,24 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			panic(err)
 		}
 	}()
+	constf, err := os.Create(genName("consts.gen.go"))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := constf.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	otherf, err := os.Create(genName("types_other.gen.go"))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := otherf.Close(); err != nil {
+			panic(err)
+		}
+	}()
 	writef := func(format string, args ...interface{}) {
 		if _, err := fmt.Fprintf(f, format, args...); err != nil {
 			panic(err)
@@ -126,11 +267,38 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			panic(err)
 		}
 	}
+	writeconstf := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(constf, format, args...); err != nil {
+			panic(err)
+		}
+	}
+	writeotherf := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(otherf, format, args...); err != nil {
+			panic(err)
+		}
+	}
+
+	writeconstf("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writeconstf("%s", buildConstraint(""))
+	writeconstf("\n")
+	writeconstf("package internal\n")
+
+	// Stand-ins for the C types, used when the Steamworks SDK cannot be
+	// linked. Only the typedefs are needed; nothing that uses the structs
+	// is available in these builds.
+	writeotherf("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writeotherf("%s", buildConstraint("(!cgo || !(386 || amd64) || !(windows || linux || darwin))", "!cgo !386,!amd64 !windows,!linux,!darwin"))
+	writeotherf("\n")
+	writeotherf("package internal\n")
 
 	writecppf("// This code is generated by go generate; DO NOT EDIT\n")
+	writecppf("%s", buildConstraint(""))
 	writecppf("\n")
 	writecppf("#include \"shim.h\"\n")
 	writecppf("#include <steam/steam_api.h>\n")
+	if *flat {
+		writecppf("#include <steam/steam_api_flat.h>\n")
+	}
 	writecppf("#include <steam/steam_gameserver.h>\n")
 	writecppf("\n")
 	writecppf("typedef long long intp;\n")
@@ -141,26 +309,37 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 	writehf("\n")
 
 	writef("// Code generated by \"go generate\"; DO NOT EDIT.\n")
-	writef("// +build windows linux darwin\n")
-	writef("// +build 386 amd64\n")
-	writef("//go:generate go run generate.go\n")
+	writef("%s", buildConstraint("(windows || linux || darwin) && (386 || amd64)", "windows linux darwin", "386 amd64"))
+	if *flat {
+		writef("//go:generate go run generate.go -flat\n")
+	} else {
+		writef("//go:generate go run generate.go\n")
+	}
 	writef("\n")
 	writef("package internal\n")
 	writef("\n")
 	writef("/*\n")
 	writef("#cgo CXXFLAGS: -std=c++11\n")
-	writef("#cgo CPPFLAGS: -isystem ${SRCDIR}/include\n")
-	writef("#cgo windows LDFLAGS: -L ${SRCDIR}/lib/windows\n")
-	writef("#cgo linux,386 LDFLAGS: -L ${SRCDIR}/lib/linux32\n")
-	writef("#cgo linux,amd64 LDFLAGS: -L ${SRCDIR}/lib/linux64\n")
+	if *flat {
+		writef("#cgo CPPFLAGS: -isystem ${SRCDIR}/sdk/include -DSTEAMWORKS_FLAT\n")
+		writef("#cgo windows LDFLAGS: -L ${SRCDIR}/sdk/lib/windows\n")
+		writef("#cgo linux,386 LDFLAGS: -L ${SRCDIR}/sdk/lib/linux32\n")
+		writef("#cgo linux,amd64 LDFLAGS: -L ${SRCDIR}/sdk/lib/linux64\n")
+	} else {
+		writef("#cgo CPPFLAGS: -isystem ${SRCDIR}/include\n")
+		writef("#cgo windows LDFLAGS: -L ${SRCDIR}/lib/windows\n")
+		writef("#cgo linux,386 LDFLAGS: -L ${SRCDIR}/lib/linux32\n")
+		writef("#cgo linux,amd64 LDFLAGS: -L ${SRCDIR}/lib/linux64\n")
+	}
 	writef("#cgo linux windows,386 darwin LDFLAGS: -lsteam_api\n")
 	writef("#cgo windows,amd64 LDFLAGS: -lsteam_api64\n")
 	writef("\n")
-	writef("#include \"api.gen.h\"\n")
+	writef("#include \"%s\"\n", genName("api.gen.h"))
 	writehf("#include <stdbool.h>\n")
 	writehf("#include <stdint.h>\n")
 	writehf("typedef int CallbackID_t;\n")
 	var exportTypes []string
+	typedefTypes := make(map[string]string)
 	longTypes := make(map[string]bool)
 	ulongTypes := make(map[string]bool)
 	for _, t := range apiData.Typedefs {
@@ -205,8 +384,49 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			ulongTypes[t.Typedef] = true
 		}
 
+		typedefTypes[t.Typedef] = t.Type
 		writehf("typedef %s %s;\n", t.Type, t.Typedef)
 	}
+	goBaseTypes := map[string]string{
+		"int8_t":             "int8",
+		"uint8_t":            "uint8",
+		"int16_t":            "int16",
+		"uint16_t":           "uint16",
+		"int32_t":            "int32",
+		"uint32_t":           "uint32",
+		"int64_t":            "int64",
+		"uint64_t":           "uint64",
+		"intptr_t":           "int",
+		"uintptr_t":          "uintptr",
+		"int":                "int32",
+		"unsigned int":       "uint32",
+		"short":              "int16",
+		"unsigned short":     "uint16",
+		"long long":          "int64",
+		"unsigned long long": "uint64",
+	}
+	// publicTypes are the Go types of the exported typedefs without cgo,
+	// which the steamapi package uses in place of the C types.
+	publicTypes := map[string]string{
+		"SteamID": "uint64",
+		"GameID":  "uint64",
+	}
+	writeotherf("\ntype (\n")
+	for _, t := range exportTypes {
+		ctype := t
+		for goBaseTypes[ctype] == "" {
+			next, ok := typedefTypes[ctype]
+			if !ok {
+				panic("generate: cannot resolve typedef " + t)
+			}
+			ctype = next
+		}
+		writeotherf("\t%s %s\n", strings.TrimSuffix(t, "_t"), goBaseTypes[ctype])
+		publicTypes[strings.TrimSuffix(t, "_t")] = goBaseTypes[ctype]
+	}
+	writeotherf("\tSteamID uint64\n")
+	writeotherf("\tGameID uint64\n")
+	writeotherf(")\n")
 	writehf("typedef uint64 CSteamID;\n")
 	ulongTypes["CSteamID"] = true
 	writehf("typedef uint64 CGameID;\n")
@@ -266,12 +486,23 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 	}
 	writehf("#pragma pack(pop)\n")
 	writehf("typedef int EServerMode;\n")
-	writehf("extern bool SteamInternal_GameServer_Init(uint32 unIP, uint16 usPort, uint16 usGamePort, uint16 usQueryPort, EServerMode eServerMode, const char *pchVersionString);\n")
+	if *flat {
+		// The game server init function is inline in newer SDKs, and
+		// the flat API has its own init function for clients.
+		writehf("extern bool SteamGameServer_Init_Wrapper(uint32 unIP, uint16 usGamePort, uint16 usQueryPort, EServerMode eServerMode, const char *pchVersionString); // wrapper\n")
+		writecppf("extern \"C\" bool SteamGameServer_Init_Wrapper(uint32 unIP, uint16 usGamePort, uint16 usQueryPort, int eServerMode, const char *pchVersionString) { return SteamGameServer_Init(unIP, usGamePort, usQueryPort, EServerMode(eServerMode), pchVersionString); }\n")
+	} else {
+		writehf("extern bool SteamInternal_GameServer_Init(uint32 unIP, uint16 usPort, uint16 usGamePort, uint16 usQueryPort, EServerMode eServerMode, const char *pchVersionString);\n")
+	}
 	writehf("extern void SteamGameServer_Shutdown();\n")
 	writehf("extern void SteamGameServer_RunCallbacks();\n")
 	writehf("extern bool SteamGameServer_BSecure();\n")
 	writehf("extern uint64 SteamGameServer_GetSteamID();\n")
-	writehf("extern bool SteamAPI_Init();\n")
+	if *flat {
+		writehf("extern int SteamAPI_InitFlat(char *pOutErrMsg);\n")
+	} else {
+		writehf("extern bool SteamAPI_Init();\n")
+	}
 	writehf("extern void SteamAPI_Shutdown();\n")
 	writehf("extern bool SteamAPI_RestartAppIfNecessary(uint32 unOwnAppID);\n")
 	writehf("extern void SteamAPI_ReleaseCurrentThreadMemory();\n")
@@ -281,6 +512,33 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 	writecppf("extern \"C\" bool SteamID_IsValid(uint64 id) { return CSteamID(id).IsValid(); }\n")
 	classes := []string{"SteamClient"}
 	gameServerClasses := []string{"Client", "Utils", "Networking", "HTTP", "Inventory", "UGC", "Apps"}
+	// globalClasses have a single instance shared by game clients and game
+	// servers.
+	globalClasses := make(map[string]bool)
+	// accessorNames are the names of the flat API functions that return
+	// each interface, which are versioned in newer SDKs.
+	accessorNames := make(map[string]string)
+	if apiData.accessors != nil {
+		classes, gameServerClasses = nil, nil
+		for _, a := range apiData.accessors {
+			accessorNames[a.Name] = a.Flat
+			switch {
+			case a.Dual:
+				gameServerClasses = append(gameServerClasses, strings.TrimPrefix(a.Name, "SteamGameServer"))
+			case a.Kind == "global":
+				globalClasses[a.Name] = true
+				classes = append(classes, a.Name)
+			default:
+				classes = append(classes, a.Name)
+			}
+		}
+	}
+	accessor := func(name string) string {
+		if flatName, ok := accessorNames[name]; ok {
+			return flatName
+		}
+		return name
+	}
 	abstractClasses := []string{"ISteamMatchmakingPingResponse", "ISteamMatchmakingServerListResponse", "ISteamMatchmakingPingResponse", "ISteamMatchmakingPlayersResponse", "ISteamMatchmakingRulesResponse"}
 	methodSuffixes := make(map[[2]string]int)
 	for _, m := range apiData.Methods {
@@ -290,6 +548,11 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			m.skip = true
 			continue
 		}
+		if apiData.accessors != nil && strings.HasPrefix(m.Returntype, "ISteam") && strings.HasSuffix(m.Returntype, " *") {
+			// Interfaces are returned by the accessors instead.
+			m.skip = true
+			continue
+		}
 		if m.Methodname == "SetWarningMessageHook" {
 			m.skip = true
 			continue
@@ -301,7 +564,7 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 				break
 			}
 			for _, p := range m.Params {
-				if p.Paramtype == "class "+a+" *" {
+				if strings.TrimPrefix(p.Paramtype, "class ") == a+" *" {
 					isAbstract = true
 					break
 				}
@@ -324,7 +587,10 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			m.suffix = strconv.Itoa(s)
 			methodSuffixes[[2]string{m.Classname, m.Methodname}] = s + 1
 		}
-		writehf("extern %s SteamAPI_%s_%s%v(intp instancePtr", m.Returntype, m.Classname, m.Methodname, m.suffix)
+		if m.flatname == "" {
+			m.flatname = fmt.Sprintf("SteamAPI_%s_%s%s", m.Classname, m.Methodname, m.suffix)
+		}
+		writehf("extern %s %s(intp instancePtr", m.Returntype, m.flatname)
 		for _, p := range m.Params {
 			p.Paramtype = strings.TrimPrefix(p.Paramtype, "class ")
 			p.Paramtype = strings.TrimPrefix(p.Paramtype, "ISteamHTMLSurface::")
@@ -338,12 +604,12 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 
 	for _, c := range classes {
 		writehf("extern intp Get%[1]s(); // wrapper\n", c)
-		writecppf("extern \"C\" intp Get%[1]s() { return reinterpret_cast<intp>(%[1]s()); }\n", c)
+		writecppf("extern \"C\" intp Get%s() { return reinterpret_cast<intp>(%s()); }\n", c, accessor(c))
 	}
 	for _, gsc := range gameServerClasses {
 		c := "SteamGameServer" + gsc
 		writehf("extern intp Get%[1]s(); // wrapper\n", c)
-		writecppf("extern \"C\" intp Get%[1]s() { return reinterpret_cast<intp>(%[1]s()); }\n", c)
+		writecppf("extern \"C\" intp Get%s() { return reinterpret_cast<intp>(%s()); }\n", c, accessor(c))
 	}
 
 	writef("*/\n")
@@ -361,7 +627,7 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 	writef(")\n")
 
 	for _, e := range apiData.Enums {
-		writef("\ntype %s int32\n\nconst (\n", e.Enumname)
+		writeconstf("\ntype %s int32\n\nconst (\n", e.Enumname)
 		for _, v := range e.Values {
 			v.Name = strings.TrimPrefix(v.Name, "k_")
 			v.Name = strings.TrimPrefix(v.Name, "dc_")
@@ -374,11 +640,11 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 				v.Name = strings.TrimPrefix(v.Name, "EFeature")
 			}
 			v.Name = strings.TrimPrefix(v.Name, "_")
-			writef("\t%s_%s %s = %s\n", e.Enumname, v.Name, e.Enumname, v.Value)
+			writeconstf("\t%s_%s %s = %s\n", e.Enumname, v.Name, e.Enumname, v.Value)
 		}
-		writef(")\n")
+		writeconstf(")\n")
 	}
-	writef("\nconst (\n")
+	writeconstf("\nconst (\n")
 	for _, c := range apiData.Consts {
 		c.Constname = strings.TrimLeft(c.Constname, "abcdefghijklmnopqrstuvwxyz_")
 		if strings.HasSuffix(c.Constname, "Callbacks") && c.Constname[0] == 'I' && c.Constname[1] >= 'A' && c.Constname[1] <= 'Z' {
@@ -395,20 +661,28 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 		if c.Consttype == "int" {
 			c.Consttype = ""
 		}
-		writef("\t%s %s = %s\n", c.Constname, c.Consttype, c.Constval)
+		writeconstf("\t%s %s = %s\n", c.Constname, c.Consttype, c.Constval)
 	}
-	writef(")\n")
+	writeconstf(")\n")
 	writef("var IsGameClient bool\n")
 	writef("var IsGameServer bool\n")
-	writef("func SteamAPI_Init() bool { if IsGameServer { panic(\"steamworks: InitClient must be called before InitServer if both are called\") }; IsGameClient = true; return bool(C.SteamAPI_Init()) }\n")
-	writef("func SteamGameServer_Init(ip uint32, steamPort, gamePort, queryPort uint16, serverMode EServerMode, versionString *C.char) bool { IsGameServer = true; return bool(C.SteamInternal_GameServer_Init(C.uint32(ip), C.uint16(steamPort), C.uint16(gamePort), C.uint16(queryPort), C.EServerMode(serverMode), versionString)) }\n")
-	writef("func SteamAPI_Shutdown() { if IsGameServer { SteamGameServer_Shutdown(); if !IsGameClient { return } }; C.SteamAPI_Shutdown(); IsGameClient = false }\n")
-	writef("func SteamGameServer_Shutdown() { C.SteamGameServer_Shutdown(); IsGameServer = false }\n")
+	if *flat {
+		// Newer SDKs no longer take the Steam port.
+		writef("func SteamAPI_Init() bool { if IsGameServer { panic(\"steamworks: InitClient must be called before InitServer if both are called\") }; IsGameClient = C.SteamAPI_InitFlat(nil) == 0; return IsGameClient }\n")
+		writef("func SteamGameServer_Init(ip uint32, steamPort, gamePort, queryPort uint16, serverMode EServerMode, versionString *C.char) bool { IsGameServer = bool(C.SteamGameServer_Init_Wrapper(C.uint32(ip), C.uint16(gamePort), C.uint16(queryPort), C.EServerMode(serverMode), versionString)); return IsGameServer }\n")
+	} else {
+		writef("func SteamAPI_Init() bool { if IsGameServer { panic(\"steamworks: InitClient must be called before InitServer if both are called\") }; IsGameClient = bool(C.SteamAPI_Init()); return IsGameClient }\n")
+		writef("func SteamGameServer_Init(ip uint32, steamPort, gamePort, queryPort uint16, serverMode EServerMode, versionString *C.char) bool { IsGameServer = bool(C.SteamInternal_GameServer_Init(C.uint32(ip), C.uint16(steamPort), C.uint16(gamePort), C.uint16(queryPort), C.EServerMode(serverMode), versionString)); return IsGameServer }\n")
+	}
+	writef("func SteamAPI_Shutdown(side Side) { if side != SideClient && IsGameServer { C.SteamGameServer_Shutdown(); IsGameServer = false }; if side != SideServer && IsGameClient { C.SteamAPI_Shutdown(); IsGameClient = false } }\n")
 	writef("func SteamAPI_RestartAppIfNecessary(unOwnAppID uint32) bool { return bool(C.SteamAPI_RestartAppIfNecessary(C.uint32(unOwnAppID))) }\n")
 	writef("func SteamAPI_ReleaseCurrentThreadMemory() { C.SteamAPI_ReleaseCurrentThreadMemory() }\n")
-	writef("func SteamAPI_RunCallbacks() { if IsGameServer { C.SteamGameServer_RunCallbacks() }; if IsGameClient { C.SteamAPI_RunCallbacks() } }\n")
+	writef("func SteamAPI_RunCallbacks(side Side) { if side != SideClient && IsGameServer { C.SteamGameServer_RunCallbacks() }; if side != SideServer && IsGameClient { C.SteamAPI_RunCallbacks() } }\n")
 	writef("func SteamAPI_IsSteamRunning() bool { return bool(C.SteamAPI_IsSteamRunning()) }\n")
 	writef("func SteamID_IsValid(id SteamID) bool { return bool(C.SteamID_IsValid(id)) } // wrapper\n")
+	// dualClasses are the interfaces that have both a game client and a
+	// game server instance. Their functions take a Side.
+	dualClasses := make(map[string]bool)
 	for _, c := range classes {
 		isClient, isGameServer := true, false
 		if strings.HasPrefix(c, "SteamGameServer") {
@@ -421,24 +695,29 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 				}
 			}
 		}
-		writef("func get%s() C.intp {\n", c)
-		writef("\tif IsGameServer {\n")
-		if isGameServer {
-			if isClient {
-				writef("\t\tif !IsGameClient {\n")
-				writef("\t\t\treturn C.GetSteamGameServer%s()\n", c[len("Steam"):])
-				writef("\t\t}\n")
-			} else {
-				writef("\t\treturn C.Get%s()\n", c)
-			}
-		} else {
+		switch {
+		case globalClasses[c]:
+			writef("func get%s() C.intp {\n", c)
+			writef("\treturn C.Get%s()\n", c)
+		case isClient && isGameServer:
+			dualClasses["I"+c] = true
+			writef("func get%s(side Side) C.intp {\n", c)
+			writef("\tif side.GameServer() {\n")
+			writef("\t\treturn C.GetSteamGameServer%s()\n", c[len("Steam"):])
+			writef("\t}\n")
+			writef("\treturn C.Get%s()\n", c)
+		case isClient:
+			writef("func get%s() C.intp {\n", c)
+			writef("\tif IsGameServer && !IsGameClient {\n")
 			writef("\t\tpanic(%q)\n", "steamworks/internal: the I"+c+" interface is not available on dedicated servers")
-		}
-		writef("\t}\n")
-		if isClient {
+			writef("\t}\n")
+			writef("\treturn C.Get%s()\n", c)
+		default:
+			writef("func get%s() C.intp {\n", c)
+			writef("\tif !IsGameServer {\n")
+			writef("\t\tpanic(%q)\n", "steamworks/internal: the I"+c+" interface is not available on clients")
+			writef("\t}\n")
 			writef("\treturn C.Get%s()\n", c)
-		} else {
-			writef("\tpanic(%q)\n", "steamworks/internal: the I"+c+" interface is not available on clients")
 		}
 		writef("}\n")
 	}
@@ -537,10 +816,118 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 		return ""
 	}
 
+	// publicType returns the type of a parameter or result in the steamapi
+	// package and the internal type it converts to, if it differs.
+	publicType := func(ctype string) (pub, conv string, ok bool) {
+		if ctype == "const char *" {
+			return "string", "", true
+		}
+		ptr, goType := toGoType(ctype)
+		if len(ptr) > 1 {
+			return "", "", false
+		}
+		switch goType {
+		case "bool", "float32", "float64", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+			return ptr + goType, "", true
+		}
+		if base, ok := publicTypes[goType]; ok {
+			return ptr + base, goType, true
+		}
+		for _, e := range apiData.Enums {
+			if e.Enumname == goType {
+				return ptr + goType, "", true
+			}
+		}
+		return "", "", false
+	}
+	// bufferTypes are the parameter types that are passed as a []byte when
+	// they are followed by their length.
+	bufferTypes := map[string]bool{
+		"void *":       true,
+		"const void *": true,
+		"char *":       true,
+		"uint8 *":      true,
+	}
+	// publicSignature fills in the steamapi signature of method. It returns
+	// false if a parameter or the result has no Go equivalent.
+	publicSignature := func(m *Method, method *InterfaceMethod) bool {
+		for i := 0; i < len(m.Params); i++ {
+			p := m.Params[i]
+			if bufferTypes[p.Paramtype] && i+1 < len(m.Params) {
+				sizePtr, sizeType := toGoType(m.Params[i+1].Paramtype)
+				if sizePtr == "" && (sizeType == "int32" || sizeType == "uint32") {
+					ptr := "bytesPointer(" + p.Paramname + ")"
+					switch _, goType := toGoType(p.Paramtype); goType {
+					case "uint8":
+						ptr = "(*uint8)(" + ptr + ")"
+					case "C.char":
+						ptr = "(*internal.CChar)(" + ptr + ")"
+					}
+					method.Params = append(method.Params, p.Paramname+" []byte")
+					method.Args = append(method.Args, p.Paramname)
+					method.CallArgs = append(method.CallArgs, ptr, sizeType+"(len("+p.Paramname+"))")
+					i++
+					continue
+				}
+			}
+
+			pub, conv, ok := publicType(p.Paramtype)
+			if !ok {
+				return false
+			}
+			method.Params = append(method.Params, p.Paramname+" "+pub)
+			method.Args = append(method.Args, p.Paramname)
+			switch {
+			case pub == "string":
+				c := "c" + strings.ToUpper(p.Paramname[:1]) + p.Paramname[1:]
+				method.Setup = append(method.Setup, c+" := internal.CString("+p.Paramname+")", "defer internal.Free(unsafe.Pointer("+c+"))")
+				method.CallArgs = append(method.CallArgs, c)
+			case conv != "" && pub[0] == '*':
+				method.CallArgs = append(method.CallArgs, "(*internal."+conv+")(unsafe.Pointer("+p.Paramname+"))")
+			case conv != "":
+				method.CallArgs = append(method.CallArgs, "internal."+conv+"("+p.Paramname+")")
+			default:
+				method.CallArgs = append(method.CallArgs, p.Paramname)
+			}
+		}
+
+		switch m.Returntype {
+		case "void":
+		case "const char *":
+			method.Result, method.Convert = "string", "internal.GoString(%s)"
+		default:
+			pub, conv, ok := publicType(m.Returntype)
+			if !ok || pub[0] == '*' {
+				return false
+			}
+			method.Result, method.Convert = pub, "%s"
+			if conv != "" {
+				method.Convert = pub + "(%s)"
+			}
+		}
+		return true
+	}
+
+	var interfaces []*Interface
+	interfacesByName := make(map[string]*Interface)
 	for _, m := range apiData.Methods {
 		if m.skip {
 			continue
 		}
+		iface, ok := interfacesByName[m.Classname]
+		if !ok {
+			iface = &Interface{Name: m.Classname, Dual: dualClasses[m.Classname]}
+			interfaces = append(interfaces, iface)
+			interfacesByName[m.Classname] = iface
+		}
+		method := &InterfaceMethod{
+			Name: m.Methodname + m.suffix,
+			Func: m.flatname,
+		}
+		if name := strings.TrimPrefix(m.flatname, "SteamAPI_"+m.Classname+"_"); name != m.flatname {
+			method.Name = name
+		}
+
 		argTypes := make([]string, len(m.Params))
 		for i, p := range m.Params {
 			if p.Paramname == "type" {
@@ -549,12 +936,20 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 			ptr, goType := toGoType(p.Paramtype)
 			argTypes[i] = p.Paramname + " " + ptr + goType
 		}
+		if publicSignature(m, method) {
+			iface.Methods = append(iface.Methods, method)
+		}
+		getSide := ""
+		if dualClasses[m.Classname] {
+			argTypes = append([]string{"side Side"}, argTypes...)
+			getSide = "side"
+		}
 		retPtr, returnType := toGoType(m.Returntype)
-		writef("func SteamAPI_%s_%s%s(%s) %s%s {\n\t", m.Classname, m.Methodname, m.suffix, strings.Join(argTypes, ", "), retPtr, returnType)
+		writef("func %s(%s) %s%s {\n\t", m.flatname, strings.Join(argTypes, ", "), retPtr, returnType)
 		if returnType != "" {
 			writef("return %s(", convertToGo(m.Returntype))
 		}
-		writef("C.SteamAPI_%s_%s%s(get%s()", m.Classname, m.Methodname, m.suffix, m.Classname[1:])
+		writef("C.%s(get%s(%s)", m.flatname, m.Classname[1:], getSide)
 		for _, p := range m.Params {
 			writef(", %s(%s)", convertToC(p.Paramtype), p.Paramname)
 		}
@@ -564,71 +959,790 @@ func writeFile(apiData APIData, callbacks []*CallbackDef) {
 		writef(")\n}\n")
 	}
 
+	writef("// CallbackSizes is the size of the data for each callback type.\n")
+	writef("var CallbackSizes = map[int32]uintptr{\n")
+	seenCallbacks := make(map[[2]string]bool)
 	for _, c := range callbacks {
-		writef("func RegisterCallback_%[1]s(f func(*%[1]s, bool), apiCall SteamAPICall) registeredCallback { var cb registeredCallback; cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) { f((*%[1]s)(cdata), ioFailure); if apiCall != 0 { cb.Unregister() } }, unsafe.Sizeof(%[1]s{}), %[2]s + %[3]s, apiCall, !IsGameClient); return cb }\n", strings.TrimSuffix(c.Name, "_t"), c.Category, c.Offset)
+		// A few callbacks share an ID, such as GSStatsUnloaded_t and
+		// UserStatsUnloaded_t. The first one is at least as large as the
+		// others.
+		if seenCallbacks[[2]string{c.Category, c.Offset}] {
+			continue
+		}
+		seenCallbacks[[2]string{c.Category, c.Offset}] = true
+		writef("\t%[2]s + %[3]s: unsafe.Sizeof(%[1]s{}),\n", strings.TrimSuffix(c.Name, "_t"), c.Category, c.Offset)
+	}
+	writef("}\n")
+	for _, c := range callbacks {
+		writef("func RegisterCallback_%[1]s(f func(*%[1]s, bool), apiCall SteamAPICall, side Side) registeredCallback { var cb registeredCallback; cb = registerCallback(func(cdata unsafe.Pointer, _ uintptr, ioFailure bool, _ SteamAPICall) { f((*%[1]s)(cdata), ioFailure); if apiCall != 0 { cb.Unregister() } }, unsafe.Sizeof(%[1]s{}), %[2]s + %[3]s, apiCall, side.GameServer()); return cb }\n", strings.TrimSuffix(c.Name, "_t"), c.Category, c.Offset)
 	}
+
+	return interfaces
 }
 
-type APIData struct {
-	Typedefs []*struct {
-		Typedef string
-		Type    string
-	}
-	Enums []*struct {
-		Enumname string
-		Values   []*struct {
-			Name  string
-			Value string
-		}
-	}
-	Consts []*struct {
-		Constname string
-		Consttype string
-		Constval  string
-	}
-	Structs []*struct {
-		Struct string
-		Fields []*struct {
-			Fieldname string
-			Fieldtype string
-		}
-	}
-	Methods []*struct {
-		Classname  string
-		Methodname string
-		Callresult string
-		Callback   string
-		Returntype string
-		Desc       string
-		skip       bool
-		suffix     string
-		Params     []*struct {
-			Paramname string
-			// single space or omitted (?)
-			Out_struct string
-			// single space or omitted (?)
-			Out_string string
-			// two+ item comma-separated list of:
-			// parameter holding length
-			// method to call to get length
-			// arguments to length method
-			Out_array_call string
-			// "length of array" parameter name
-			Array_count string
-			// "length of array" parameter name
-			Out_array_count string
-			// "length of string" parameter name
-			Out_string_count string
-			// "length of buffer" parameter name
-			Buffer_count string
-			// "length of buffer" parameter name
-			Out_buffer_count string
-			Paramtype        string
-			Desc             string
+// Interface is a Steam interface, such as ISteamUser.
+type Interface struct {
+	Name string
+	// Dual is true if the interface has both a game client and a game
+	// server instance.
+	Dual bool
+	// Methods are the methods that can be called with Go types. The others
+	// are left out of the steamapi package.
+	Methods []*InterfaceMethod
+}
+
+// InterfaceMethod is a method of a Steam interface, as it appears in the
+// steamapi package.
+type InterfaceMethod struct {
+	Name string
+	// Func is the generated function in this package that calls the
+	// method.
+	Func string
+	// Params are the parameters of the method, as "name type".
+	Params []string
+	// Args are the names of the parameters.
+	Args []string
+	// Setup are the statements that run before Func is called, such as
+	// converting a string to C.
+	Setup []string
+	// CallArgs are the arguments passed to Func.
+	CallArgs []string
+	Result   string
+	// Convert converts the result of Func, which replaces the %s, to
+	// Result.
+	Convert string
+}
+
+// writeInterfaces writes the steamapi package: a Go interface for each Steam
+// interface, along with an implementation that calls the functions generated
+// by writeFile and a mock that records its calls.
+//
+// It must be called after writeFile, which normalizes the names in apiData.
+func writeInterfaces(apiData APIData, interfaces []*Interface) {
+	create := func(name string) (*os.File, func(string, ...interface{})) {
+		f, err := os.Create(genName("../steamapi/" + name))
+		if err != nil {
+			panic(err)
+		}
+		return f, func(format string, args ...interface{}) {
+			if _, err := fmt.Fprintf(f, format, args...); err != nil {
+				panic(err)
+			}
+		}
+	}
+	closeFile := func(f *os.File) {
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}
+
+	f, writef := create("interfaces.gen.go")
+	defer closeFile(f)
+	sf, writesf := create("steam.gen.go")
+	defer closeFile(sf)
+	of, writeof := create("steam_other.gen.go")
+	defer closeFile(of)
+
+	writef("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writef("%s", buildConstraint(""))
+	writef("\npackage steamapi\n")
+	writesf("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writesf("%s", buildConstraint("cgo && (windows || linux || darwin) && (386 || amd64)", "cgo", "windows linux darwin", "386 amd64"))
+	writesf("\npackage steamapi\n")
+	var usesUnsafe bool
+	for _, iface := range interfaces {
+		for _, m := range iface.Methods {
+			code := strings.Join(m.Setup, "\n") + strings.Join(m.CallArgs, ", ")
+			usesUnsafe = usesUnsafe || strings.Contains(code, "unsafe.")
+		}
+	}
+	if usesUnsafe {
+		writesf("\nimport (\n\t\"unsafe\"\n\n\t\"github.com/BenLubar/steamworks/internal\"\n)\n")
+	} else {
+		writesf("\nimport \"github.com/BenLubar/steamworks/internal\"\n")
+	}
+	writeof("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writeof("%s", buildConstraint("(!cgo || !(386 || amd64) || !(windows || linux || darwin))", "!cgo !386,!amd64 !windows,!linux,!darwin"))
+	writeof("\npackage steamapi\n")
+
+	var enums []*Enum
+	for _, e := range apiData.Enums {
+		var used bool
+		for _, iface := range interfaces {
+			for _, m := range iface.Methods {
+				for _, p := range append(m.Params, "ret "+m.Result) {
+					if strings.TrimPrefix(p[strings.IndexByte(p, ' ')+1:], "*") == e.Enumname {
+						used = true
+					}
+				}
+			}
+		}
+		if used {
+			enums = append(enums, e)
+		}
+	}
+	if len(enums) != 0 {
+		writef("\nimport \"github.com/BenLubar/steamworks/internal\"\n")
+	}
+	for _, e := range enums {
+		writef("\n// %s is the Steamworks SDK's %s enum.\n", e.Enumname, e.Enumname)
+		writef("type %s = internal.%s\n", e.Enumname, e.Enumname)
+		writef("\nconst (\n")
+		for _, v := range e.Values {
+			writef("\t%s_%s = internal.%s_%s\n", e.Enumname, v.Name, e.Enumname, v.Name)
+		}
+		writef(")\n")
+	}
+
+	for _, iface := range interfaces {
+		if len(iface.Methods) == 0 {
+			continue
+		}
+
+		impl := "steam" + iface.Name[len("ISteam"):]
+		constructor := iface.Name[1:]
+		mock := "Mock" + iface.Name
+
+		writef("\n// %s is the %s interface. %s returns the\n", iface.Name, iface.Name, constructor)
+		writef("// implementation that calls into the Steamworks SDK, and %s\n", mock)
+		writef("// records its calls for tests.\n")
+		writef("type %s interface {\n", iface.Name)
+		for _, m := range iface.Methods {
+			writef("\t%s(%s) %s\n", m.Name, strings.Join(m.Params, ", "), m.Result)
+		}
+		writef("}\n")
+
+		if iface.Dual {
+			writesf("\ntype %s struct{ side internal.Side }\n", impl)
+			writesf("\n// %s returns the %s for the game client or the game server.\n", constructor, iface.Name)
+			writesf("func %s(gameServer bool) %s { return %s{side(gameServer)} }\n", constructor, iface.Name, impl)
+			writeof("\n// %s returns the %s for the game client or the game server.\n", constructor, iface.Name)
+			writeof("func %s(gameServer bool) %s { return &%s{} }\n", constructor, iface.Name, mock)
+		} else {
+			writesf("\ntype %s struct{}\n", impl)
+			writesf("\n// %s returns the %s.\n", constructor, iface.Name)
+			writesf("func %s() %s { return %s{} }\n", constructor, iface.Name, impl)
+			writeof("\n// %s returns the %s.\n", constructor, iface.Name)
+			writeof("func %s() %s { return &%s{} }\n", constructor, iface.Name, mock)
+		}
+		for _, m := range iface.Methods {
+			args := m.CallArgs
+			if iface.Dual {
+				args = append([]string{"s.side"}, args...)
+			}
+			call := m.Func + "(" + strings.Join(args, ", ") + ")"
+			result := m.Result
+			if result != "" {
+				result = "(ret " + result + ")"
+			}
+			writesf("\nfunc (s %s) %s(%s) %s {\n", impl, m.Name, strings.Join(m.Params, ", "), result)
+			writesf("\tinternal.Call(func() {\n")
+			for _, stmt := range m.Setup {
+				writesf("\t\t%s\n", stmt)
+			}
+			if m.Result != "" {
+				writesf("\t\tret = %s\n", fmt.Sprintf(m.Convert, "internal."+call))
+			} else {
+				writesf("\t\tinternal.%s\n", call)
+			}
+			writesf("\t})\n")
+			if m.Result != "" {
+				writesf("\treturn\n")
+			}
+			writesf("}\n")
+		}
+
+		writef("\n// %s is an %s that records each call to Recorder\n", mock, iface.Name)
+		writef("// and returns the result of the matching Func field, or the zero value if\n")
+		writef("// it is nil.\n")
+		writef("type %s struct {\n", mock)
+		writef("\tRecorder *Recorder\n")
+		writef("\n")
+		for _, m := range iface.Methods {
+			writef("\t%sFunc func(%s) %s\n", m.Name, strings.Join(m.Params, ", "), m.Result)
+		}
+		writef("}\n")
+		for _, m := range iface.Methods {
+			result := m.Result
+			if result != "" {
+				result = "(ret " + result + ")"
+			}
+			writef("\n// %s implements %s.\n", m.Name, iface.Name)
+			writef("func (m *%s) %s(%s) %s {\n", mock, m.Name, strings.Join(m.Params, ", "), result)
+			writef("\tm.Recorder.Record(%q, %q", iface.Name, m.Name)
+			for _, a := range m.Args {
+				writef(", %s", a)
+			}
+			writef(")\n")
+			writef("\tif m.%sFunc != nil {\n\t\t", m.Name)
+			if m.Result != "" {
+				writef("ret = ")
+			}
+			writef("m.%sFunc(%s)\n", m.Name, strings.Join(m.Args, ", "))
+			writef("\t}\n")
+			if m.Result != "" {
+				writef("\treturn\n")
+			}
+			writef("}\n")
 		}
 	}
 }
 
+// writeEvents writes the Go event types for each callback to the steamworks
+// package, along with the code that converts them from the C structs.
+//
+// It must be called after writeFile, which normalizes the names in apiData.
+func writeEvents(apiData APIData, callbacks []*CallbackDef) {
+	f, err := os.Create(genName("../events.gen.go"))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	cf, err := os.Create(genName("../events_steam.gen.go"))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := cf.Close(); err != nil {
+			panic(err)
+		}
+	}()
+	// The imports of events.gen.go depend on the fields, so it is written
+	// once the rest of the file is known.
+	var body bytes.Buffer
+	writef := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(&body, format, args...); err != nil {
+			panic(err)
+		}
+	}
+	writecf := func(format string, args ...interface{}) {
+		if _, err := fmt.Fprintf(cf, format, args...); err != nil {
+			panic(err)
+		}
+	}
+	var usesTime bool
+	defer func() {
+		if _, err := fmt.Fprintf(f, "// Code generated by \"go generate\"; DO NOT EDIT.\n%s\npackage steamworks\n\nimport (\n", buildConstraint("")); err != nil {
+			panic(err)
+		}
+		if usesTime {
+			if _, err := fmt.Fprintf(f, "\t\"time\"\n\n"); err != nil {
+				panic(err)
+			}
+		}
+		if _, err := fmt.Fprintf(f, "\t\"github.com/BenLubar/steamworks/internal\"\n)\n"); err != nil {
+			panic(err)
+		}
+		if _, err := body.WriteTo(f); err != nil {
+			panic(err)
+		}
+	}()
+
+	writecf("// Code generated by \"go generate\"; DO NOT EDIT.\n")
+	writecf("%s", buildConstraint("cgo && (windows || linux || darwin) && (386 || amd64)", "cgo", "windows linux darwin", "386 amd64"))
+	writecf("\n")
+	writecf("package steamworks\n")
+	writecf("\n")
+	writecf("import \"github.com/BenLubar/steamworks/internal\"\n")
+
+	// The same typedef resolution as writeFile, which decides which fields
+	// are wrapped in uint64aligned/int64aligned.
+	typedefTypes := make(map[string]string)
+	alignedTypes := map[string]string{"CSteamID": "uint64", "CGameID": "uint64"}
+	for _, t := range apiData.Typedefs {
+		if strings.ContainsAny(t.Type, "[(") {
+			continue
+		}
+		typedefTypes[t.Typedef] = t.Type
+		switch t.Type {
+		case "int64", "int64_t", "lint64", "long long", "long int":
+			alignedTypes[t.Typedef] = "int64"
+		case "uint64", "uint64_t", "ulint64", "unsigned long long", "unsigned long int":
+			alignedTypes[t.Typedef] = "uint64"
+		}
+	}
+	goBaseTypes := map[string]string{
+		"int8_t":   "int8",
+		"uint8_t":  "uint8",
+		"int16_t":  "int16",
+		"uint16_t": "uint16",
+		"int32_t":  "int32",
+		"uint32_t": "uint32",
+		"int64_t":  "int64",
+		"uint64_t": "uint64",
+		"int":      "int32",
+		"float":    "float32",
+		"double":   "float64",
+	}
+	isEnum := func(name string) bool {
+		for _, e := range apiData.Enums {
+			if e.Enumname == name {
+				return true
+			}
+		}
+		return false
+	}
+	structsByName := make(map[string]int)
+	for i, s := range apiData.Structs {
+		structsByName[s.Struct] = i
+	}
+
+	// enumName returns the name of the alias for an enum in the steamworks
+	// package, such as Result for EResult.
+	enumName := func(name string) string {
+		return strings.TrimPrefix(name, "E")
+	}
+	enumNames := make(map[string]bool)
+	for _, e := range apiData.Enums {
+		enumNames[enumName(e.Enumname)] = true
+	}
+	// goName returns the name of the Go type for a struct. Structs that
+	// would have the same name as an enum, such as CheckFileSignature_t,
+	// have a Result suffix.
+	goName := func(name string) string {
+		name = strings.Replace(strings.TrimSuffix(name, "_t"), "_", "", -1)
+		if enumNames[name] {
+			name += "Result"
+		}
+		return name
+	}
+
+	// goType returns the Go type of a field and a function that converts
+	// an element of the C field to that type.
+	var needStructs, needEnums []string
+	seenStructs := make(map[string]bool)
+	seenEnums := make(map[string]bool)
+	goType := func(ctype, field string) (string, func(string) string) {
+		switch ctype {
+		case "CSteamID":
+			return "SteamID", func(x string) string { return "SteamID(" + x + ".Get())" }
+		case "CGameID":
+			return "GameID", func(x string) string { return "GameID(" + x + ".Get())" }
+		case "AppId_t":
+			return "AppID", func(x string) string { return "AppID(" + x + ")" }
+		case "bool", "_Bool":
+			return "bool", func(x string) string { return "bool(" + x + ")" }
+		case "const char *":
+			return "string", func(x string) string { return "internal.GoString(" + x + ")" }
+		}
+		if isEnum(ctype) {
+			if !seenEnums[ctype] {
+				seenEnums[ctype] = true
+				needEnums = append(needEnums, ctype)
+			}
+			return enumName(ctype), func(x string) string { return "internal." + ctype + "(" + x + ")" }
+		}
+		if _, ok := structsByName[ctype]; ok {
+			if !seenStructs[ctype] {
+				seenStructs[ctype] = true
+				needStructs = append(needStructs, ctype)
+			}
+			return goName(ctype), func(x string) string { return "convert" + goName(ctype) + "(&" + x + ")" }
+		}
+		if base, ok := alignedTypes[ctype]; ok {
+			if base == "uint64" && strings.Contains(field, "SteamID") {
+				return "SteamID", func(x string) string { return "SteamID(" + x + ".Get())" }
+			}
+			return base, func(x string) string { return base + "(" + x + ".Get())" }
+		}
+		resolved := ctype
+		for goBaseTypes[resolved] == "" {
+			next, ok := typedefTypes[resolved]
+			if !ok {
+				panic("generate: cannot resolve callback field type " + ctype)
+			}
+			resolved = next
+		}
+		base := goBaseTypes[resolved]
+		if base == "uint32" && (ctype == "RTime32" || strings.HasPrefix(field, "Rtime") || strings.Contains(field, "RTime")) {
+			// Steam's timestamps are seconds since the Unix epoch.
+			usesTime = true
+			return "time.Time", func(x string) string { return "unixTime(uint32(" + x + "))" }
+		}
+		return base, func(x string) string { return base + "(" + x + ")" }
+	}
+
+	// layout returns the size and alignment of a C type with the 4-byte
+	// packing used on Linux and macOS, and the alignment Go requires for
+	// the same type on 64-bit platforms.
+	var layout func(ctype string) (size, align, goAlign int)
+	layout = func(ctype string) (int, int, int) {
+		switch ctype {
+		case "bool", "_Bool", "char":
+			return 1, 1, 1
+		case "const char *":
+			return 8, 4, 8
+		case "CSteamID", "CGameID":
+			return 8, 4, 4
+		}
+		if isEnum(ctype) {
+			return 4, 4, 4
+		}
+		if i, ok := structsByName[ctype]; ok {
+			size, align := 0, 1
+			for _, field := range apiData.Structs[i].Fields {
+				ftype, array := splitFieldType(field.Fieldtype)
+				fsize, falign, _ := layout(ftype)
+				size = (size+falign-1)/falign*falign + fsize*arrayLen(array)
+				if falign > align {
+					align = falign
+				}
+			}
+			return (size + align - 1) / align * align, align, align
+		}
+		if _, ok := alignedTypes[ctype]; ok {
+			return 8, 4, 4
+		}
+		resolved := ctype
+		for goBaseTypes[resolved] == "" {
+			next, ok := typedefTypes[resolved]
+			if !ok {
+				panic("generate: cannot resolve callback field type " + ctype)
+			}
+			resolved = next
+		}
+		switch goBaseTypes[resolved] {
+		case "int8", "uint8":
+			return 1, 1, 1
+		case "int16", "uint16":
+			return 2, 2, 2
+		case "float64":
+			return 8, 4, 8
+		default:
+			return 4, 4, 4
+		}
+	}
+
+	// writeStruct writes the Go form of a struct, documented using the
+	// comments in the headers if it is a callback.
+	writeStruct := func(name string, def *CallbackDef) {
+		s := apiData.Structs[structsByName[name]]
+		gname := goName(name)
+
+		fieldComments := make(map[string][]string)
+		if def != nil {
+			writef("\n// %s is the %s callback.\n", gname, name)
+			if lines := commentLines(def.Comment); len(lines) != 0 {
+				writef("//\n")
+				for _, line := range lines {
+					writef("// %s\n", line)
+				}
+			}
+			for _, field := range def.Fields {
+				if field != nil {
+					fieldComments[strings.Title(strings.TrimPrefix(field.Name, "m_"))] = commentLines(field.Comment)
+				}
+			}
+		} else {
+			writef("\n// %s is the Go form of %s.\n", gname, name)
+		}
+		if len(s.Fields) == 0 {
+			writef("type %s struct{}\n", gname)
+		} else {
+			writef("type %s struct {\n", gname)
+		}
+		writecf("\nfunc convert%[1]s(c *internal.%[2]s) %[1]s {\n", gname, strings.TrimSuffix(name, "_t"))
+		if len(s.Fields) == 0 {
+			writecf("\treturn %s{}\n", gname)
+		} else {
+			writecf("\tvar e %s\n", gname)
+		}
+		fieldNames := make(map[string]bool)
+		var offset int
+		for _, field := range s.Fields {
+			ctype, array := splitFieldType(field.Fieldtype)
+
+			size, align, goAlign := layout(ctype)
+			offset = (offset + align - 1) / align * align
+			fieldOffset := offset
+			offset += size * arrayLen(array)
+			if fieldOffset%goAlign != 0 {
+				// cgo omits fields that are not aligned the way Go
+				// expects, which happens on 64-bit platforms because
+				// of the 4-byte packing.
+				continue
+			}
+
+			for _, line := range fieldComments[field.Fieldname] {
+				writef("\t// %s\n", line)
+			}
+			prefix, name := fieldName(field.Fieldname)
+			if fieldNames[name] {
+				prefix, name = "", field.Fieldname
+			}
+			fieldNames[name] = true
+			tag := "`json:\"" + snakeCase(name) + "\"`"
+
+			if array != "" && ctype == "char" {
+				writef("\t%s string %s\n", name, tag)
+				writecf("\te.%s = goStringArray(c.%s[:])\n", name, field.Fieldname)
+				continue
+			}
+
+			t, convert := goType(ctype, field.Fieldname)
+			switch t {
+			case "int8", "uint8", "int16", "uint16", "int32", "uint32":
+				// Some flags and enums are stored in a smaller
+				// integer type than bool or the enum.
+				if prefix == "b" {
+					t, convert = "bool", func(x string) string { return x + " != 0" }
+				} else if prefix == "e" && isEnum("E"+name) {
+					t, convert = goType("E"+name, name)
+				} else if e, ok := enumFields[s.Struct+"."+name]; ok && isEnum(e) {
+					t, convert = goType(e, name)
+				} else if t == "uint32" && strings.EqualFold(name, "AppID") {
+					// Some app IDs are declared as uint32
+					// instead of AppId_t.
+					t, convert = goType("AppId_t", name)
+				}
+			}
+			writef("\t%s %s%s %s\n", name, array, t, tag)
+			if array != "" {
+				writecf("\tfor i := range c.%s {\n", field.Fieldname)
+				writecf("\t\te.%s[i] = %s\n", name, convert("c."+field.Fieldname+"[i]"))
+				writecf("\t}\n")
+			} else {
+				writecf("\te.%s = %s\n", name, convert("c."+field.Fieldname))
+			}
+		}
+		if len(s.Fields) != 0 {
+			writecf("\treturn e\n")
+			writef("}\n")
+		}
+		writecf("}\n")
+	}
+
+	for _, c := range callbacks {
+		writeStruct(c.Name, c)
+		writef("\n// CallbackID implements Event.\n")
+		writef("func (%s) CallbackID() CallbackID { return internal.%s + %s }\n", goName(c.Name), c.Category, c.Offset)
+	}
+	for i := 0; i < len(needStructs); i++ {
+		writeStruct(needStructs[i], nil)
+	}
+
+	for _, name := range needEnums {
+		for _, e := range apiData.Enums {
+			if e.Enumname != name {
+				continue
+			}
+
+			writef("\n// %s is the %s enum. Its String method returns the\n", enumName(name), name)
+			writef("// name of the value.\n")
+			writef("type %s = internal.%s\n", enumName(name), name)
+			writef("\n// Values of %s.\n", enumName(name))
+			writef("const (\n")
+			for _, v := range e.Values {
+				writef("\t%s%s = internal.%s_%s\n", enumName(name), strings.Title(v.Name), name, v.Name)
+			}
+			writef(")\n")
+		}
+	}
+
+	writef("\n// allEvents has a zero value of every Event type.\n")
+	writef("var allEvents = [...]Event{\n")
+	for _, c := range callbacks {
+		writef("\t%s{},\n", goName(c.Name))
+	}
+	writef("}\n")
+
+	writecf("\n// eventRegistrations registers a callback for each Event type.\n")
+	writecf("var eventRegistrations = map[CallbackID]func(internal.Side, func(Event)) Registration{\n")
+	for _, c := range callbacks {
+		writecf("\t%[1]s{}.CallbackID(): func(side internal.Side, f func(Event)) Registration { return internal.RegisterCallback_%[2]s(func(c *internal.%[2]s, _ bool) { f(convert%[1]s(c)) }, 0, side) },\n", goName(c.Name), strings.TrimSuffix(c.Name, "_t"))
+	}
+	writecf("}\n")
+}
+
+// commentLines returns the text of a comment from the headers, without the
+// comment markers, the decorative lines around it, or the "Purpose:" label.
+func commentLines(comment string) []string {
+	var lines []string
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimSpace(strings.TrimLeft(line, "/"))
+		if strings.Trim(line, "-=/* ") == "" {
+			continue
+		}
+		line = strings.TrimSpace(strings.TrimPrefix(line, "Purpose:"))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// hungarianPrefixes are the type prefixes of the field names in the
+// Steamworks SDK, such as the n in m_nAppID.
+var hungarianPrefixes = map[string]bool{
+	"b": true, "c": true, "cch": true, "cub": true, "d": true, "e": true,
+	"f": true, "fl": true, "h": true, "i": true, "n": true, "pch": true,
+	"rg": true, "rgch": true, "rgf": true, "sz": true, "u": true,
+	"ub": true, "ul": true, "un": true, "us": true,
+}
+
+// fieldName returns the name of a field of a Go event type, which is the name
+// of the C field without its type prefix, such as AppID for NAppID, and the
+// prefix it removed. Steam's timestamps, such as RtimeCreated, are named
+// TimeCreated.
+func fieldName(field string) (prefix, name string) {
+	name = field
+	if i := strings.IndexFunc(field[1:], unicode.IsUpper) + 1; i > 0 && (i > 1 || len(field)-i > 1) {
+		if p := strings.ToLower(field[:i]); hungarianPrefixes[p] {
+			prefix, name = p, field[i:]
+		}
+	}
+	for _, timePrefix := range []string{"Rtime", "RTime"} {
+		if strings.HasPrefix(name, timePrefix) && len(name) > len(timePrefix) {
+			name = "Time" + name[len(timePrefix):]
+		}
+	}
+	return prefix, name
+}
+
+// snakeCase converts a Go name to snake case, keeping initialisms together:
+// SteamIDLobby becomes steam_id_lobby and P2PSessionError becomes
+// p2p_session_error.
+func snakeCase(name string) string {
+	name = strings.Replace(name, "IDs", "Ids", -1)
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// splitFieldType splits a struct field type into the element type and the
+// array suffix, if any.
+func splitFieldType(fieldtype string) (ctype, array string) {
+	ctype = fieldtype
+	for _, prefix := range []string{"enum ", "class ", "struct "} {
+		ctype = strings.TrimPrefix(ctype, prefix)
+	}
+	if i := strings.Index(ctype, " ["); i != -1 {
+		ctype, array = ctype[:i], ctype[i+1:]
+	}
+	return
+}
+
+// arrayLen returns the number of elements in a field with the given array
+// suffix.
+func arrayLen(array string) int {
+	if array == "" {
+		return 1
+	}
+	n, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(array, "["), "]"))
+	if err != nil {
+		panic("generate: unsupported array length " + array)
+	}
+	return n
+}
+
+type APIData struct {
+	Typedefs []*Typedef
+	Enums    []*Enum
+	Consts   []*Const
+	Structs  []*Struct
+	Methods  []*Method
+
+	// accessors are the functions that return each interface, in SDKs
+	// with the newer flat API.
+	accessors []*Accessor
+}
+
+type Typedef struct {
+	Typedef string
+	Type    string
+}
+
+type Enum struct {
+	Enumname string
+	Values   []*EnumValue
+}
+
+type EnumValue struct {
+	Name  string
+	Value string
+}
+
+type Const struct {
+	Constname string
+	Consttype string
+	Constval  string
+}
+
+type Struct struct {
+	Struct string
+	Fields []*Field
+}
+
+type Field struct {
+	Fieldname string
+	Fieldtype string
+}
+
+type Method struct {
+	Classname  string
+	Methodname string
+	Callresult string
+	Callback   string
+	Returntype string
+	Desc       string
+	skip       bool
+	suffix     string
+	// flatname is the name of the function in SDKs with the newer flat
+	// API, which no longer follows the class and method name.
+	flatname string
+	Params   []*Param
+}
+
+type Param struct {
+	Paramname string
+	// single space or omitted (?)
+	Out_struct string
+	// single space or omitted (?)
+	Out_string string
+	// two+ item comma-separated list of:
+	// parameter holding length
+	// method to call to get length
+	// arguments to length method
+	Out_array_call string
+	// "length of array" parameter name
+	Array_count string
+	// "length of array" parameter name
+	Out_array_count string
+	// "length of string" parameter name
+	Out_string_count string
+	// "length of buffer" parameter name
+	Buffer_count string
+	// "length of buffer" parameter name
+	Out_buffer_count string
+	Paramtype        string
+	Desc             string
+}
+
+// Accessor is a function that returns an interface.
+type Accessor struct {
+	// Name is the name of the C++ accessor, such as SteamUser or
+	// SteamGameServerUtils.
+	Name string
+	// Flat is the name of the accessor in the flat API, such as
+	// SteamAPI_SteamUser_v021.
+	Flat string
+	// Kind is "user", "gameserver", or "global".
+	Kind string
+	// Dual is true if the interface has both a user and a gameserver
+	// accessor.
+	Dual bool
+}
+
 func addMissingCallbackStructs(apiData *APIData, callbacks []*CallbackDef) {
 	for _, c := range callbacks {
 		var found bool
@@ -641,23 +1755,18 @@ func addMissingCallbackStructs(apiData *APIData, callbacks []*CallbackDef) {
 		if found {
 			continue
 		}
-		fields := make([]*struct{ Fieldname, Fieldtype string }, len(c.Fields))
+		fields := make([]*Field, len(c.Fields))
 		for i, f := range c.Fields {
 			var array string
 			if f.Array != "" {
 				array = " [" + f.Array + "]"
 			}
-			fields[i] = &struct {
-				Fieldname, Fieldtype string
-			}{
+			fields[i] = &Field{
 				Fieldname: f.Name,
 				Fieldtype: f.Type + array,
 			}
 		}
-		apiData.Structs = append(apiData.Structs, &struct {
-			Struct string
-			Fields []*struct{ Fieldname, Fieldtype string }
-		}{
+		apiData.Structs = append(apiData.Structs, &Struct{
 			Struct: c.Name,
 			Fields: fields,
 		})
@@ -665,7 +1774,7 @@ func addMissingCallbackStructs(apiData *APIData, callbacks []*CallbackDef) {
 }
 
 func readAPIData() (apiData APIData) {
-	f, err := os.Open("include/steam/steam_api.json")
+	f, err := os.Open(filepath.Join(sdkDir(), "include/steam/steam_api.json"))
 	if err != nil {
 		panic(err)
 	}
@@ -683,8 +1792,233 @@ func readAPIData() (apiData APIData) {
 	return
 }
 
-var headers = func() []string {
-	names, err := filepath.Glob("include/steam/*.h")
+// flatAPIData is the layout of steam_api.json in Steamworks SDK 1.58 and
+// later. Methods are grouped by interface and give the name of the function
+// that implements them in the flat API, and each interface lists the
+// functions that return it.
+type flatAPIData struct {
+	Callback_structs []*flatStruct
+	Consts           []*Const
+	Enums            []*flatEnum
+	Interfaces       []*struct {
+		Classname string
+		Accessors []*struct {
+			Kind      string
+			Name      string
+			Name_flat string
+		}
+		Methods []*struct {
+			Methodname      string
+			Methodname_flat string
+			Callresult      string
+			Callback        string
+			Returntype      string
+			Returntype_flat string
+			Params          []*struct {
+				Paramname      string
+				Paramtype      string
+				Paramtype_flat string
+			}
+		}
+		Enums []*flatEnum
+	}
+	Structs  []*flatStruct
+	Typedefs []*Typedef
+}
+
+type flatStruct struct {
+	Struct      string
+	Callback_id int
+	Fields      []*Field
+	Consts      []*Const
+	Enums       []*flatEnum
+}
+
+type flatEnum struct {
+	Enumname string
+	Values   []*struct {
+		Name  string
+		Value json.Number
+	}
+}
+
+// readFlatAPIData reads steam_api.json from the SDK in the sdk folder and
+// converts it to the layout used by v1.42, along with the callbacks.
+func readFlatAPIData() (APIData, []*CallbackDef) {
+	f, err := os.Open(filepath.Join(sdkDir(), "include/steam/steam_api.json"))
+	if err != nil {
+		panic(err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			panic(err)
+		}
+	}()
+
+	// Valve adds fields to the newer layout in most releases, so unknown
+	// fields are not an error here.
+	var data flatAPIData
+	if err := json.NewDecoder(f).Decode(&data); err != nil {
+		panic(err)
+	}
+
+	apiData := APIData{
+		Typedefs: data.Typedefs,
+		Consts:   data.Consts,
+	}
+	addEnums := func(enums []*flatEnum) {
+		for _, e := range enums {
+			enum := &Enum{Enumname: e.Enumname}
+			for _, v := range e.Values {
+				enum.Values = append(enum.Values, &EnumValue{Name: v.Name, Value: v.Value.String()})
+			}
+			apiData.Enums = append(apiData.Enums, enum)
+		}
+	}
+	addStruct := func(s *flatStruct) {
+		addEnums(s.Enums)
+		apiData.Consts = append(apiData.Consts, s.Consts...)
+		apiData.Structs = append(apiData.Structs, &Struct{Struct: s.Struct, Fields: s.Fields})
+	}
+
+	addEnums(data.Enums)
+	for _, s := range data.Structs {
+		addStruct(s)
+	}
+	for _, i := range data.Interfaces {
+		addEnums(i.Enums)
+
+		var hasUser bool
+		for _, a := range i.Accessors {
+			if a.Kind == "user" {
+				hasUser = true
+			}
+		}
+		for _, a := range i.Accessors {
+			apiData.accessors = append(apiData.accessors, &Accessor{
+				Name: a.Name,
+				Flat: a.Name_flat,
+				Kind: a.Kind,
+				Dual: hasUser && a.Kind == "gameserver",
+			})
+		}
+
+		for _, m := range i.Methods {
+			method := &Method{
+				Classname:  i.Classname,
+				Methodname: m.Methodname,
+				Callresult: m.Callresult,
+				Callback:   m.Callback,
+				Returntype: flatType(m.Returntype, m.Returntype_flat),
+				flatname:   m.Methodname_flat,
+			}
+			for _, p := range m.Params {
+				method.Params = append(method.Params, &Param{
+					Paramname: p.Paramname,
+					Paramtype: flatType(p.Paramtype, p.Paramtype_flat),
+				})
+			}
+			apiData.Methods = append(apiData.Methods, method)
+		}
+	}
+
+	// The newer layout gives each callback's ID as a number. The headers
+	// still define it as an offset from a constant such as
+	// k_iSteamUserCallbacks, which is used when the callback can be found.
+	headerCallbacks := findCallbackDefs()
+	headerDefs := make(map[string]*CallbackDef)
+	for _, c := range headerCallbacks {
+		headerDefs[c.Name] = c
+	}
+	bases := findCallbackBases(&apiData)
+
+	var callbacks []*CallbackDef
+	for _, s := range data.Callback_structs {
+		addStruct(s)
+
+		c := &CallbackDef{Name: s.Struct}
+		if h, ok := headerDefs[s.Struct]; ok {
+			c.Comment, c.Category, c.Offset = h.Comment, h.Category, h.Offset
+		} else {
+			base := s.Callback_id / 100 * 100
+			category, ok := bases[base]
+			if !ok {
+				panic("generate: cannot find callback base for " + s.Struct)
+			}
+			c.Category, c.Offset = category, strconv.Itoa(s.Callback_id-base)
+		}
+		callbacks = append(callbacks, c)
+		delete(headerDefs, s.Struct)
+	}
+
+	// Callbacks that are only in the headers are added the same way as
+	// for v1.42.
+	for _, c := range headerCallbacks {
+		if _, ok := headerDefs[c.Name]; ok {
+			callbacks = append(callbacks, c)
+		}
+	}
+
+	return apiData, callbacks
+}
+
+// flatType returns the type to use for a parameter or return value of a
+// function in the newer flat API, which passes references as pointers and
+// CSteamID and CGameID as integers.
+func flatType(ctype, flat string) string {
+	switch flat {
+	case "":
+		return ctype
+	case "uint64_steamid":
+		return "CSteamID"
+	case "uint64_gameid":
+		return "CGameID"
+	}
+	return flat
+}
+
+// findCallbackBases returns the constants callback IDs are offsets from,
+// such as SteamUserCallbacks, by value. Constants that are missing from
+// apiData are added.
+func findCallbackBases(apiData *APIData) map[int]string {
+	re := regexp.MustCompile(`\bk_[iI]([A-Za-z]+Callbacks) = ([0-9]+)`)
+
+	bases := make(map[int]string)
+	for _, h := range readHeaders() {
+		for _, match := range re.FindAllStringSubmatch(h, -1) {
+			value, err := strconv.Atoi(match[2])
+			if err != nil {
+				panic(err)
+			}
+
+			// Some constants share a value, such as
+			// k_iClientRemoteStorageCallbacks. Prefer the public name.
+			if name, ok := bases[value]; !ok || (!strings.HasPrefix(name, "Steam") && strings.HasPrefix(match[1], "Steam")) {
+				bases[value] = match[1]
+			}
+
+			var found bool
+			for _, c := range apiData.Consts {
+				if strings.TrimLeft(c.Constname, "abcdefghijklmnopqrstuvwxyz_") == match[1] || strings.TrimLeft(c.Constname, "abcdefghijklmnopqrstuvwxyz_") == "I"+match[1] {
+					found = true
+					break
+				}
+			}
+			if !found {
+				apiData.Consts = append(apiData.Consts, &Const{
+					Constname: "k_i" + match[1],
+					Consttype: "int",
+					Constval:  match[2],
+				})
+			}
+		}
+	}
+
+	return bases
+}
+
+func readHeaders() []string {
+	names, err := filepath.Glob(filepath.Join(sdkDir(), "include/steam/*.h"))
 	if err != nil {
 		panic(err)
 	}
@@ -700,7 +2034,7 @@ var headers = func() []string {
 	}
 
 	return data
-}()
+}
 
 type CallbackDef struct {
 	Comment string
@@ -721,11 +2055,11 @@ type CallbackField struct {
 
 func findCallbackDefs() []*CallbackDef {
 	re1 := regexp.MustCompile(`(?m)^((?://.*\n)*)struct ([A-Za-z0-9_]+_t)\n\{ ?\n\tenum \{ k_iCallback = k_[iI]([A-Za-z]+) \+ ([0-9]+) \};\n((?:\t.*\n|\n)*)\};$`)
-	re2 := regexp.MustCompile(`(?m)^((?://.*\n)*)DEFINE_CALLBACK\( ?([A-Za-z0-9_]+_t), k_[iI]([A-Za-z]+) \+ ([0-9]+) ?\);?\n((?:[ \t]*CALLBACK_MEMBER\(.*\)[ \t]*(?://.*)?\n)*)END_DEFINE_CALLBACK_[0-9]+\(\)$`)
+	re2 := regexp.MustCompile(`(?m)^((?://.*\n)*)(?:DEFINE_CALLBACK|STEAM_CALLBACK_BEGIN)\( ?([A-Za-z0-9_]+_t), k_[iI]([A-Za-z]+) \+ ([0-9]+) ?\);?\n((?:[ \t]*(?:STEAM_)?CALLBACK_MEMBER\(.*\)[ \t]*(?://.*)?\n)*)(?:END_DEFINE_CALLBACK_[0-9]+\(\)|STEAM_CALLBACK_END\([0-9]+\))$`)
 
 	var defs []*CallbackDef
 
-	for _, h := range headers {
+	for _, h := range readHeaders() {
 		for _, match := range re1.FindAllStringSubmatch(h, -1) {
 			defs = append(defs, &CallbackDef{
 				Comment:  match[1],
@@ -816,6 +2150,7 @@ func parseCallbackField2(field string) *CallbackField {
 		}
 	}
 	field = strings.TrimSpace(field)
+	field = strings.TrimPrefix(field, "STEAM_")
 	field = strings.TrimPrefix(field, "CALLBACK_MEMBER(")
 	field = strings.TrimSuffix(field, ")")
 	field = strings.TrimSpace(field)
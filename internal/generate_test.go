@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestGenerateFlat runs generate.go -flat on the SDK in testdata/flat/sdk,
+// which has steam_api.json in the layout used by SDK 1.58 and later, and
+// compares the generated files with testdata/flat/golden.
+func TestGenerateFlat(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping go run in short mode")
+	}
+	goTool := filepath.Join(runtime.GOROOT(), "bin", "go")
+	if _, err := os.Stat(goTool); err != nil {
+		t.Skip(err)
+	}
+	if _, err := exec.LookPath("gofmt"); err != nil {
+		t.Skip(err)
+	}
+
+	// generate.go writes to ../steamapi and the module root, so it is
+	// run in a copy of that layout.
+	root := t.TempDir()
+	dir := filepath.Join(root, "internal")
+	if err := os.MkdirAll(filepath.Join(root, "steamapi"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	copyFile(t, "generate.go", filepath.Join(dir, "generate.go"))
+	if err := filepath.Walk(filepath.Join("testdata", "flat", "sdk"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(filepath.Join("testdata", "flat"), path)
+		if err != nil {
+			return err
+		}
+		copyFile(t, path, filepath.Join(dir, rel))
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goTool, "run", "generate.go", "-flat", "-stringer=false")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go run generate.go -flat: %v\n%s", err, out)
+	}
+
+	golden := filepath.Join("testdata", "flat", "golden")
+	generated := make(map[string]bool)
+	if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !strings.Contains(info.Name(), "_flat.gen.") {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		generated[filepath.ToSlash(rel)] = true
+
+		got, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name := filepath.Join(golden, rel+".golden")
+		if *update {
+			if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+				return err
+			}
+			return ioutil.WriteFile(name, got, 0o644)
+		}
+
+		expected, err := ioutil.ReadFile(name)
+		if err != nil {
+			t.Errorf("%s: %v", rel, err)
+			return nil
+		}
+		if !bytes.Equal(got, expected) {
+			t.Errorf("%s differs from %s; run go test -run TestGenerateFlat -update and check the differences\n%s", rel, name, got)
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := filepath.Walk(golden, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(golden, path)
+		if err != nil {
+			return err
+		}
+		rel = strings.TrimSuffix(filepath.ToSlash(rel), ".golden")
+		if !generated[rel] {
+			if *update {
+				return os.Remove(path)
+			}
+			t.Errorf("%s was not generated", rel)
+		}
+		return nil
+	}); err != nil && !(*update && os.IsNotExist(err)) {
+		t.Fatal(err)
+	}
+
+	// Every generated file is only built with the flat tag, except the
+	// header, which is only included by api_flat.gen.go.
+	for rel := range generated {
+		if strings.HasSuffix(rel, ".h") {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(root, rel))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(b, []byte("//go:build ")) || !bytes.Contains(b, []byte("steamworks_flat\n")) {
+			t.Errorf("%s is not constrained to the steamworks_flat tag", rel)
+		}
+	}
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	b, err := ioutil.ReadFile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(dst, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,10 @@
+//go:build steamworks_flat
+// +build steamworks_flat
+
+package internal
+
+// The files generated from a newer Steamworks SDK for the steamworks_flat tag
+// are not checked in, and without them the tag would build the v1.42 files.
+// Running "go run generate.go -flat" in this folder generates them and
+// removes this file. See README.md.
+const _ = steamworks_flat_requires_running_generate_go_with_flat
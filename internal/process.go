@@ -0,0 +1,37 @@
+package internal
+
+import "sync"
+
+// Process describes the game client or game server that is running. It is
+// recorded by the steamworks package when a handle is initialized or shut
+// down, so that reading it does not call into the Steamworks API, which may
+// not be initialized.
+type Process struct {
+	// Live is true if a game client or game server is initialized.
+	Live bool
+	// AppID is the app ID of the process, if Live is true.
+	AppID uint32
+	// GameServer is true if the game server is the only one initialized.
+	GameServer bool
+}
+
+var (
+	processLock sync.Mutex
+	process     Process
+)
+
+// SetProcess records the running game client or game server.
+func SetProcess(p Process) {
+	processLock.Lock()
+	process = p
+	processLock.Unlock()
+}
+
+// CurrentProcess returns the game client or game server recorded by
+// SetProcess.
+func CurrentProcess() Process {
+	processLock.Lock()
+	defer processLock.Unlock()
+
+	return process
+}
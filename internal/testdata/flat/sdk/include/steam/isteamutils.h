@@ -0,0 +1,16 @@
+// A subset of isteamutils.h, for testing the -flat generator.
+
+#ifndef ISTEAMUTILS_H
+#define ISTEAMUTILS_H
+
+enum { k_iSteamUtilsCallbacks = 700 };
+
+//-----------------------------------------------------------------------------
+// Purpose: The country of the user changed
+//-----------------------------------------------------------------------------
+struct IPCountry_t
+{
+	enum { k_iCallback = k_iSteamUtilsCallbacks + 1 };
+};
+
+#endif // ISTEAMUTILS_H
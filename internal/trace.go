@@ -0,0 +1,22 @@
+package internal
+
+import "sync/atomic"
+
+// traceFunc is the type stored in traceCleanup. atomic.Value requires every
+// stored value to have the same type, including nil.
+type traceFunc func() func()
+
+var traceCleanup atomic.Value // traceFunc
+
+// SetTraceCleanup sets the function that is called when Cleanup locks the OS
+// thread, or when the worker thread starts a job. The function it returns is
+// called after the thread is unlocked or the job is finished. A nil f stops
+// tracing. It is safe to call SetTraceCleanup concurrently with Cleanup.
+func SetTraceCleanup(f func() func()) {
+	traceCleanup.Store(traceFunc(f))
+}
+
+func loadTraceCleanup() func() func() {
+	f, _ := traceCleanup.Load().(traceFunc)
+	return f
+}
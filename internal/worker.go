@@ -0,0 +1,173 @@
+// +build windows linux darwin
+// +build 386 amd64
+
+package internal
+
+/*
+#ifdef _WIN32
+#include <windows.h>
+static unsigned long long currentThread(void) { return (unsigned long long)GetCurrentThreadId(); }
+#else
+#include <pthread.h>
+static unsigned long long currentThread(void) { return (unsigned long long)pthread_self(); }
+#endif
+*/
+import "C"
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// worker is a goroutine locked to an OS thread that makes every call into
+// the Steam API on behalf of Call.
+type worker struct {
+	jobs    chan *job
+	thread  C.ulonglong
+	stopped chan struct{}
+}
+
+type job struct {
+	f        func()
+	done     chan struct{}
+	panicked bool
+	value    interface{}
+}
+
+var (
+	workerLock    sync.RWMutex
+	currentWorker unsafe.Pointer // *worker; use loadWorker
+	jobPool       = sync.Pool{New: func() interface{} { return &job{done: make(chan struct{}, 1)} }}
+)
+
+func loadWorker() *worker {
+	return (*worker)(atomic.LoadPointer(&currentWorker))
+}
+
+// StartWorker starts the worker thread if it is not already running. The
+// thread's Steam API memory is released at most once per releaseInterval, or
+// after every call if releaseInterval is 0.
+func StartWorker(releaseInterval time.Duration) {
+	workerLock.Lock()
+	defer workerLock.Unlock()
+
+	if loadWorker() != nil {
+		return
+	}
+
+	w := &worker{
+		jobs:    make(chan *job),
+		stopped: make(chan struct{}),
+	}
+
+	started := make(chan struct{})
+	go w.run(releaseInterval, started)
+	<-started
+
+	atomic.StorePointer(&currentWorker, unsafe.Pointer(w))
+}
+
+// StopWorker waits for calls in progress to finish and stops the worker
+// thread. Later calls to Call run on the calling goroutine. If StopWorker is
+// called from the worker thread, for example by a callback handler, the
+// worker stops after the current call returns.
+func StopWorker() {
+	if w := loadWorker(); w != nil && w.onThread() {
+		go StopWorker()
+		return
+	}
+
+	workerLock.Lock()
+	w := (*worker)(atomic.SwapPointer(&currentWorker, nil))
+	workerLock.Unlock()
+
+	if w != nil {
+		close(w.jobs)
+		<-w.stopped
+	}
+}
+
+func (w *worker) run(releaseInterval time.Duration, started chan<- struct{}) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	w.thread = C.currentThread()
+	close(started)
+
+	lastRelease := time.Now()
+	for j := range w.jobs {
+		// The worker's thread is always locked, so the tracer installed by
+		// SetTraceCleanup sees the time spent on each job instead.
+		var done func()
+		if trace := loadTraceCleanup(); trace != nil {
+			done = trace()
+		}
+
+		j.run()
+
+		if now := time.Now(); now.Sub(lastRelease) >= releaseInterval {
+			SteamAPI_ReleaseCurrentThreadMemory()
+			lastRelease = now
+		}
+
+		if done != nil {
+			done()
+		}
+	}
+
+	SteamAPI_ReleaseCurrentThreadMemory()
+	close(w.stopped)
+}
+
+func (j *job) run() {
+	defer func() {
+		if r := recover(); r != nil {
+			j.panicked = true
+			j.value = r
+		}
+		j.done <- struct{}{}
+	}()
+
+	j.f()
+}
+
+func (w *worker) onThread() bool {
+	return C.currentThread() == w.thread
+}
+
+// Call runs f, which calls into the Steam API. If the worker thread is
+// running, f runs on it and Call waits for it to return; calls made by f run
+// directly. Otherwise, f runs on the calling goroutine with the OS thread
+// locked, as if by Cleanup. A panic in f is re-raised by Call.
+func Call(f func()) {
+	if w := loadWorker(); w != nil && w.onThread() {
+		f()
+		return
+	}
+
+	workerLock.RLock()
+	w := loadWorker()
+	if w == nil {
+		workerLock.RUnlock()
+
+		defer Cleanup()()
+		f()
+		return
+	}
+
+	j := jobPool.Get().(*job)
+	j.f = f
+	w.jobs <- j
+	<-j.done
+	workerLock.RUnlock()
+
+	panicked, value := j.panicked, j.value
+	*j = job{done: j.done}
+	jobPool.Put(j)
+
+	if panicked {
+		panic(value)
+	}
+}
@@ -0,0 +1,165 @@
+package steamworks
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the latency histogram buckets kept
+// by Metrics.
+var LatencyBuckets = [...]time.Duration{
+	10 * time.Microsecond,
+	50 * time.Microsecond,
+	100 * time.Microsecond,
+	500 * time.Microsecond,
+	time.Millisecond,
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+}
+
+// CallStats are the measurements kept by Metrics for one API.
+type CallStats struct {
+	// Calls is the number of calls that have returned.
+	Calls uint64
+	// Errors is the number of failed calls, by type of failure. The type
+	// is the Result of a *CallError, the Cause of an *InitError, or the
+	// text of any other error.
+	Errors map[string]uint64
+	// Latency is a histogram of the duration of calls. Latency[i] is the
+	// number of calls that took longer than LatencyBuckets[i-1] and at
+	// most LatencyBuckets[i]. The last element counts calls that took
+	// longer than every bucket.
+	Latency [len(LatencyBuckets) + 1]uint64
+	// TotalLatency is the sum of the duration of every call.
+	TotalLatency time.Duration
+}
+
+// Metrics is an Instrumentation that keeps CallStats for each API in memory.
+// The zero value is ready to use. Exporters can read the measurements
+// periodically using Stats:
+//
+//    var metrics steamworks.Metrics
+//    steamworks.SetBackend(steamworks.Instrument(steamworks.GetBackend(), &metrics))
+//    defer steamworks.TraceLockOSThread(&metrics).Unregister()
+//
+// All methods on Metrics are safe to call concurrently.
+type Metrics struct {
+	lock  sync.Mutex
+	stats map[string]*CallStats
+}
+
+// StartCall implements Instrumentation.
+func (m *Metrics) StartCall(api string) func(err error) {
+	start := time.Now()
+
+	return func(err error) {
+		m.observe(api, time.Since(start), err)
+	}
+}
+
+func (m *Metrics) observe(api string, d time.Duration, err error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.stats == nil {
+		m.stats = make(map[string]*CallStats)
+	}
+	s, ok := m.stats[api]
+	if !ok {
+		s = &CallStats{}
+		m.stats[api] = s
+	}
+
+	s.Calls++
+	s.TotalLatency += d
+
+	bucket := len(LatencyBuckets)
+	for i, max := range LatencyBuckets {
+		if d <= max {
+			bucket = i
+			break
+		}
+	}
+	s.Latency[bucket]++
+
+	if err != nil {
+		if s.Errors == nil {
+			s.Errors = make(map[string]uint64)
+		}
+		s.Errors[errorType(err)]++
+	}
+}
+
+// Stats returns a copy of the measurements for each API that has been
+// called, keyed by the api passed to StartCall.
+func (m *Metrics) Stats() map[string]CallStats {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	stats := make(map[string]CallStats, len(m.stats))
+	for api, s := range m.stats {
+		c := *s
+		if s.Errors != nil {
+			c.Errors = make(map[string]uint64, len(s.Errors))
+			for t, n := range s.Errors {
+				c.Errors[t] = n
+			}
+		}
+		stats[api] = c
+	}
+
+	return stats
+}
+
+func errorType(err error) string {
+	var callErr *CallError
+	if errors.As(err, &callErr) {
+		return callErr.Result
+	}
+
+	var initErr *InitError
+	if errors.As(err, &initErr) {
+		return initErr.Cause.String()
+	}
+
+	return err.Error()
+}
+
+// PendingCallResult is an asynchronous API call whose result has not been
+// delivered yet.
+type PendingCallResult struct {
+	// Call is the API call.
+	Call APICall
+	// CallbackID is the type of the result.
+	CallbackID CallbackID
+	// Age is how long ago the call result was registered.
+	Age time.Duration
+}
+
+// PendingCallResults returns the API calls that are waiting for a result,
+// oldest first. Call results that have been pending for a long time usually
+// mean that RunCallbacks is not being called, or that a CallResult was never
+// waited for or canceled.
+func PendingCallResults() []PendingCallResult {
+	now := time.Now()
+
+	var pending []PendingCallResult
+	for _, r := range Registrations() {
+		if r.Call == 0 {
+			continue
+		}
+
+		pending = append(pending, PendingCallResult{
+			Call:       r.Call,
+			CallbackID: r.CallbackID,
+			Age:        now.Sub(r.Registered),
+		})
+	}
+
+	return pending
+}
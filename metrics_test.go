@@ -0,0 +1,79 @@
+package steamworks
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMetricsLatencyBuckets(t *testing.T) {
+	for _, tt := range []struct {
+		d      time.Duration
+		bucket int
+	}{
+		{0, 0},
+		{10 * time.Microsecond, 0},
+		{10*time.Microsecond + 1, 1},
+		{50 * time.Microsecond, 1},
+		{time.Millisecond, 4},
+		{2 * time.Millisecond, 5},
+		{time.Second, len(LatencyBuckets) - 1},
+		{time.Second + 1, len(LatencyBuckets)},
+		{time.Hour, len(LatencyBuckets)},
+	} {
+		var m Metrics
+		m.observe("Test", tt.d, nil)
+
+		s := m.Stats()["Test"]
+		if s.Calls != 1 || s.TotalLatency != tt.d {
+			t.Errorf("%v: Calls = %d, TotalLatency = %v, expected 1 and %v", tt.d, s.Calls, s.TotalLatency, tt.d)
+		}
+		for i, n := range s.Latency {
+			expected := uint64(0)
+			if i == tt.bucket {
+				expected = 1
+			}
+			if n != expected {
+				t.Errorf("%v: Latency[%d] = %d, expected %d", tt.d, i, n, expected)
+			}
+		}
+	}
+}
+
+func TestMetricsErrors(t *testing.T) {
+	var m Metrics
+	m.observe("Test", time.Millisecond, nil)
+	m.observe("Test", time.Millisecond, &CallError{API: "Test", Result: "false"})
+	m.observe("Test", time.Millisecond, &CallError{API: "Test", Result: "false"})
+	m.observe("Test", time.Millisecond, &InitError{Cause: InitCauseSteamNotRunning})
+	m.observe("Test", time.Millisecond, errors.New("other"))
+	m.observe("Other", time.Millisecond, nil)
+
+	stats := m.Stats()
+	s := stats["Test"]
+	if s.Calls != 5 || s.TotalLatency != 5*time.Millisecond {
+		t.Errorf("Calls = %d, TotalLatency = %v, expected 5 and 5ms", s.Calls, s.TotalLatency)
+	}
+	expected := map[string]uint64{
+		"false":                           2,
+		InitCauseSteamNotRunning.String(): 1,
+		"other":                           1,
+	}
+	if len(s.Errors) != len(expected) {
+		t.Errorf("Errors = %v, expected %v", s.Errors, expected)
+	}
+	for errType, n := range expected {
+		if s.Errors[errType] != n {
+			t.Errorf("Errors[%q] = %d, expected %d", errType, s.Errors[errType], n)
+		}
+	}
+	if other := stats["Other"]; other.Calls != 1 || other.Errors != nil {
+		t.Errorf("Other: Calls = %d, Errors = %v, expected 1 call and no errors", other.Calls, other.Errors)
+	}
+
+	// Stats returns a copy.
+	s.Errors["false"] = 100
+	if n := m.Stats()["Test"].Errors["false"]; n != 2 {
+		t.Errorf("modifying the result of Stats changed Errors to %d", n)
+	}
+}
@@ -0,0 +1,72 @@
+package steamworks
+
+import (
+	"log"
+	"runtime/debug"
+)
+
+// CallbackPanic describes a panic recovered from a callback handler.
+type CallbackPanic struct {
+	// CallbackID is the type of the callback whose handler panicked. It is
+	// 0 for the debug and warning message hooks, and for handlers run by
+	// a Backend other than the default, such as the steamtest fake.
+	CallbackID CallbackID
+	// Value is the value passed to panic.
+	Value interface{}
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// OnCallbackPanic is called when a callback handler panics, instead of
+// letting the panic crash the process. Handlers are called from C++ code in
+// the Steamworks SDK, which cannot be unwound by a Go panic.
+//
+// The default logs the panic and its stack trace using the log package, and
+// callbacks continue to be delivered. To crash on panics, as an unhandled
+// panic would, set it to a function that calls os.Exit.
+//
+// OnCallbackPanic is not called while a handler registered with
+// HandleCallbackPanics is registered. Assigning to it is not synchronized,
+// so it must be set before InitClient or InitServer is called; use
+// HandleCallbackPanics to change the handler later.
+var OnCallbackPanic = func(p CallbackPanic) {
+	log.Printf("steamworks: recovered from panic in handler for callback %d: %v\n%s", p.CallbackID, p.Value, p.Stack)
+}
+
+var panicHandlers handlerStack[CallbackPanic]
+
+// HandleCallbackPanics sends each recovered panic to f instead of
+// OnCallbackPanic until the returned Registration is unregistered. If more
+// than one handler is registered, the most recent one receives the panics.
+// It is safe to call HandleCallbackPanics at any time.
+func HandleCallbackPanics(f func(CallbackPanic)) Registration {
+	return panicHandlers.push(f)
+}
+
+// reportPanic passes p to the most recent handler registered with
+// HandleCallbackPanics, or to OnCallbackPanic if there is none.
+func reportPanic(p CallbackPanic) {
+	if f := panicHandlers.top(); f != nil {
+		f(p)
+		return
+	}
+
+	OnCallbackPanic(p)
+}
+
+// protect returns fn wrapped so that panics are passed to reportPanic.
+func protect(id CallbackID, fn func()) func() {
+	return func() {
+		defer func() {
+			if r := recover(); r != nil {
+				reportPanic(CallbackPanic{
+					CallbackID: id,
+					Value:      r,
+					Stack:      debug.Stack(),
+				})
+			}
+		}()
+
+		fn()
+	}
+}
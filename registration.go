@@ -0,0 +1,94 @@
+package steamworks
+
+import (
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RegistrationInfo describes a live callback registration, for finding
+// registrations that are never unregistered.
+type RegistrationInfo struct {
+	// ID identifies the registration among the live registrations of a
+	// Backend.
+	ID uint64
+	// CallbackID is the type of callback the registration receives.
+	CallbackID CallbackID
+	// Call is the API call the registration is waiting for, or 0 if it
+	// receives every callback of its type.
+	Call APICall
+	// GameServer is true if the registration receives callbacks for the
+	// game server rather than the game client.
+	GameServer bool
+	// Registered is the time the registration was made.
+	Registered time.Time
+	// PCs are the program counters of the stack of the goroutine that
+	// made the registration, as returned by runtime.Callers.
+	PCs []uintptr
+}
+
+// Stack returns the stack trace of the goroutine that made the registration,
+// formatted like a panic stack trace.
+func (r RegistrationInfo) Stack() string {
+	if len(r.PCs) == 0 {
+		return ""
+	}
+
+	var buf strings.Builder
+
+	frames := runtime.CallersFrames(r.PCs)
+	for {
+		frame, more := frames.Next()
+		buf.WriteString(frame.Function)
+		buf.WriteString("()\n\t")
+		buf.WriteString(frame.File)
+		buf.WriteByte(':')
+		buf.WriteString(strconv.Itoa(frame.Line))
+		buf.WriteByte('\n')
+		if !more {
+			break
+		}
+	}
+
+	return buf.String()
+}
+
+// Registrations returns the live callback registrations of the current
+// Backend, in the order they were made.
+func Registrations() []RegistrationInfo {
+	regs := GetBackend().Registrations()
+
+	sort.SliceStable(regs, func(i, j int) bool { return regs[i].ID < regs[j].ID })
+
+	return regs
+}
+
+var (
+	callbackNamesOnce sync.Once
+	callbackNames     map[CallbackID]string
+)
+
+// String returns the name of the Event type for the callback ID, followed by
+// the ID in parentheses, such as "P2PSessionRequest(1202)". Callbacks that
+// have no Event type are formatted as "CallbackID(1202)".
+func (id CallbackID) String() string {
+	callbackNamesOnce.Do(func() {
+		callbackNames = make(map[CallbackID]string, len(allEvents))
+		for _, e := range allEvents {
+			if _, ok := callbackNames[e.CallbackID()]; !ok {
+				callbackNames[e.CallbackID()] = reflect.TypeOf(e).Name()
+			}
+		}
+	})
+
+	name, ok := callbackNames[id]
+	if !ok {
+		name = "CallbackID"
+	}
+
+	return name + "(" + strconv.Itoa(int(id)) + ")"
+}
@@ -0,0 +1,7 @@
+//go:build !steamworks_release
+// +build !steamworks_release
+
+package steamworks
+
+// releaseBuild is true in builds with the steamworks_release build tag.
+const releaseBuild = false
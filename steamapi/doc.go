@@ -0,0 +1,22 @@
+// Package steamapi is a Go interface to each of the Steamworks SDK's ISteam*
+// interfaces, for code that needs a method the wrapper packages do not
+// cover, or wants to replace one in tests.
+//
+// Each interface has a constructor, such as SteamUser, that returns the
+// implementation calling into the Steamworks SDK, and a mock, such as
+// MockISteamUser, that records its calls to a Recorder:
+//
+//    rec := new(steamapi.Recorder)
+//    user := &steamapi.MockISteamUser{
+//        Recorder:      rec,
+//        BLoggedOnFunc: func() bool { return true },
+//    }
+//
+// The methods take Go types: strings for C strings, []byte for a buffer and
+// its length, and the underlying integer types for handles and Steam IDs.
+// Methods that take or return C structs, callbacks or other interfaces are
+// left out.
+//
+// Without cgo, or on a platform the SDK does not support, the constructors
+// return a mock with no Recorder, whose methods return zero values.
+package steamapi
@@ -0,0 +1,67 @@
+package steamapi
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// RecordedCall is a call made to one of the generated mock interfaces.
+type RecordedCall struct {
+	// Interface is the name of the Steam interface, such as ISteamUser.
+	Interface string
+	// Method is the name of the method, such as GetSteamID.
+	Method string
+	// Args are the arguments the method was called with.
+	Args []interface{}
+}
+
+// String returns the call in the form ISteamUser.GetSteamID().
+func (c RecordedCall) String() string {
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = fmt.Sprint(arg)
+	}
+
+	return c.Interface + "." + c.Method + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Recorder records the calls made to the generated mock interfaces, in order.
+// Several mocks can share a Recorder to record the calls made to each of them.
+// The zero value is ready to use, and a nil *Recorder discards the calls.
+//
+// All methods on Recorder are safe to call concurrently.
+type Recorder struct {
+	lock  sync.Mutex
+	calls []RecordedCall
+}
+
+// Record records a call. It is called by the generated mocks.
+func (r *Recorder) Record(iface, method string, args ...interface{}) {
+	if r == nil {
+		return
+	}
+
+	r.lock.Lock()
+	r.calls = append(r.calls, RecordedCall{
+		Interface: iface,
+		Method:    method,
+		Args:      args,
+	})
+	r.lock.Unlock()
+}
+
+// Calls returns the calls recorded so far.
+func (r *Recorder) Calls() []RecordedCall {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]RecordedCall(nil), r.calls...)
+}
+
+// Reset forgets the calls recorded so far.
+func (r *Recorder) Reset() {
+	r.lock.Lock()
+	r.calls = nil
+	r.lock.Unlock()
+}
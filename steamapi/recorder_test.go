@@ -0,0 +1,47 @@
+package steamapi_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/steamworks/steamapi"
+)
+
+func TestRecorder(t *testing.T) {
+	rec := new(steamapi.Recorder)
+	user := &steamapi.MockISteamUser{
+		Recorder:      rec,
+		BLoggedOnFunc: func() bool { return true },
+	}
+	friends := &steamapi.MockISteamFriends{Recorder: rec}
+
+	if !user.BLoggedOn() {
+		t.Error("BLoggedOn() = false, expected the result of BLoggedOnFunc")
+	}
+	if name := friends.GetFriendPersonaName(76561197960287930); name != "" {
+		t.Errorf("GetFriendPersonaName() = %q, expected the zero value", name)
+	}
+	user.GetUserDataFolder(make([]byte, 4))
+
+	expected := []string{
+		"ISteamUser.BLoggedOn()",
+		"ISteamFriends.GetFriendPersonaName(76561197960287930)",
+		"ISteamUser.GetUserDataFolder([0 0 0 0])",
+	}
+	calls := rec.Calls()
+	if len(calls) != len(expected) {
+		t.Fatalf("recorded %d calls, expected %d: %v", len(calls), len(expected), calls)
+	}
+	for i, call := range calls {
+		if call.String() != expected[i] {
+			t.Errorf("call %d: got %v, expected %s", i, call, expected[i])
+		}
+	}
+
+	rec.Reset()
+	if calls := rec.Calls(); len(calls) != 0 {
+		t.Errorf("recorded %v after Reset, expected nothing", calls)
+	}
+
+	var discard *steamapi.Recorder
+	discard.Record("ISteamUser", "BLoggedOn")
+}
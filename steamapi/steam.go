@@ -0,0 +1,28 @@
+//go:build cgo && (windows || linux || darwin) && (386 || amd64)
+// +build cgo
+// +build windows linux darwin
+// +build 386 amd64
+
+package steamapi
+
+import (
+	"unsafe"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// side returns the side that the generated implementations call.
+func side(gameServer bool) internal.Side {
+	if gameServer {
+		return internal.SideServer
+	}
+	return internal.SideClient
+}
+
+// bytesPointer returns a pointer to the first byte of b, or nil if b is empty.
+func bytesPointer(b []byte) unsafe.Pointer {
+	if len(b) == 0 {
+		return nil
+	}
+	return unsafe.Pointer(&b[0])
+}
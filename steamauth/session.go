@@ -1,6 +1,9 @@
 // Package steamauth wraps Steam's user authentication API.
 //
-// This package is available on both clients and servers.
+// This package is available on both clients and servers. The package-level
+// functions use steamworks.Global(). A process that runs both a game client
+// and a game server should use For with each steamworks.Client and
+// steamworks.Server instead, so that each has its own sessions.
 //
 // See the Steam User Authentication documentation for more details.
 // <https://partner.steamgames.com/doc/features/auth>
@@ -8,18 +11,74 @@ package steamauth
 
 import (
 	"errors"
-	"os"
 	"runtime"
 	"sync"
-	"unsafe"
 
 	"github.com/BenLubar/steamworks"
 	"github.com/BenLubar/steamworks/internal"
 )
 
-// All session mutations are protected by this global lock.
-var sessionLock sync.Mutex
-var sessions = make(map[steamworks.SteamID]*sessionData)
+// Auth is the authentication API of a game client or game server. Each Auth
+// has its own sessions.
+type Auth struct {
+	h steamworks.Handle
+
+	// All session mutations are protected by this lock.
+	lock     sync.Mutex
+	sessions map[steamworks.SteamID]*sessionData
+	reg      steamworks.Registration
+	closed   bool
+}
+
+var authsLock sync.Mutex
+var auths = make(map[steamworks.Handle]*Auth)
+
+// For returns the authentication API for h, such as a *steamworks.Client or
+// a *steamworks.Server. The package-level functions use the Auth for
+// steamworks.Global().
+//
+// When h is shut down, the sessions of its Auth are closed.
+func For(h steamworks.Handle) *Auth {
+	authsLock.Lock()
+	a := auths[h]
+	if a != nil {
+		authsLock.Unlock()
+		return a
+	}
+
+	a = &Auth{
+		h:        h,
+		sessions: make(map[steamworks.SteamID]*sessionData),
+	}
+	auths[h] = a
+	authsLock.Unlock()
+
+	h.OnShutdown(a.shutdown)
+
+	return a
+}
+
+func (a *Auth) shutdown() {
+	authsLock.Lock()
+	if auths[a.h] == a {
+		delete(auths, a.h)
+	}
+	authsLock.Unlock()
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if a.reg != nil {
+		a.reg.Unregister()
+		a.reg = nil
+	}
+
+	for claimedID, sdata := range a.sessions {
+		a.endSession(claimedID, sdata)
+	}
+
+	a.closed = true
+}
 
 // Session represents a Steam authentication session. All methods on Session
 // are safe to call concurrently.
@@ -27,6 +86,7 @@ var sessions = make(map[steamworks.SteamID]*sessionData)
 // Sessions must be closed when they are no longer in use. Failing to do so
 // will result in a message being written to the standard error stream.
 type Session struct {
+	auth      *Auth
 	claimedID steamworks.SteamID
 	data      *sessionData
 	closed    bool
@@ -55,8 +115,8 @@ func (s *Session) ClaimedID() steamworks.SteamID {
 // This may be different than ClaimedID if the game is shared. This function's
 // return value may be zero or invalid if Status was never StatusOK.
 func (s *Session) OwnerID() steamworks.SteamID {
-	sessionLock.Lock()
-	defer sessionLock.Unlock()
+	s.auth.lock.Lock()
+	defer s.auth.lock.Unlock()
 
 	return s.data.ownerID
 }
@@ -66,8 +126,8 @@ func (s *Session) OwnerID() steamworks.SteamID {
 // See the documentation for SessionStatus for information on what the various
 // possible values mean.
 func (s *Session) Status() SessionStatus {
-	sessionLock.Lock()
-	defer sessionLock.Unlock()
+	s.auth.lock.Lock()
+	defer s.auth.lock.Unlock()
 
 	return s.data.status
 }
@@ -83,15 +143,7 @@ func (s *Session) Change() <-chan SessionStatus {
 // OwnsDLC returns true if the user owns the specified DLC, or false if the user
 // does not own the DLC or if the session is not authenticated.
 func (s *Session) OwnsDLC(dlc steamworks.AppID) bool {
-	defer internal.Cleanup()()
-
-	var result internal.EUserHasLicenseForAppResult
-
-	if internal.IsGameServer {
-		result = internal.SteamAPI_ISteamGameServer_UserHasLicenseForApp(internal.SteamID(s.claimedID), internal.AppId(dlc))
-	} else {
-		result = internal.SteamAPI_ISteamUser_UserHasLicenseForApp(internal.SteamID(s.claimedID), internal.AppId(dlc))
-	}
+	result := s.auth.h.Backend().Auth().UserHasLicenseForApp(s.claimedID, dlc)
 
 	return result == internal.EUserHasLicenseForAppResult_EUserHasLicenseResultHasLicense
 }
@@ -123,22 +175,37 @@ var (
 // If the error returned by this function is ErrDuplicateRequest, the session
 // may or may not be nil. If the error is nil, the session will not be nil.
 // In any other case, the session is nil.
+//
+// If the Steamworks API is not available in this build, BeginSession returns
+// steamworks.ErrUnsupported.
 func BeginSession(ticket []byte, claimedID steamworks.SteamID) (*Session, error) {
-	sessionLock.Lock()
-	defer sessionLock.Unlock()
+	return For(steamworks.Global()).BeginSession(ticket, claimedID)
+}
 
-	defer internal.Cleanup()()
+// ErrShutdown is returned by Auth.BeginSession if the handle the Auth belongs
+// to has been shut down.
+var ErrShutdown = errors.New("steamworks/steamauth: the game client or game server has been shut down")
 
-	initOnce.Do(doInit)
+// BeginSession is like the package-level BeginSession, but the session
+// belongs to a.
+func (a *Auth) BeginSession(ticket []byte, claimedID steamworks.SteamID) (*Session, error) {
+	if !steamworks.Supported() {
+		return nil, steamworks.ErrUnsupported
+	}
 
-	var result internal.EBeginAuthSessionResult
+	a.lock.Lock()
+	defer a.lock.Unlock()
 
-	if internal.IsGameServer {
-		result = internal.SteamAPI_ISteamGameServer_BeginAuthSession(unsafe.Pointer(&ticket[0]), int32(len(ticket)), internal.SteamID(claimedID))
-	} else {
-		result = internal.SteamAPI_ISteamGameServer_BeginAuthSession(unsafe.Pointer(&ticket[0]), int32(len(ticket)), internal.SteamID(claimedID))
+	if a.closed {
+		return nil, ErrShutdown
 	}
 
+	if a.reg == nil {
+		a.reg = a.h.Backend().Auth().OnValidateAuthTicketResponse(a.onValidateAuthTicketResponse)
+	}
+
+	result := a.h.Backend().Auth().BeginAuthSession(ticket, claimedID)
+
 	var sdata *sessionData
 	var err error
 
@@ -148,11 +215,11 @@ func BeginSession(ticket []byte, claimedID steamworks.SteamID) (*Session, error)
 			status: StatusUnknown,
 			change: make(chan SessionStatus, 1),
 		}
-		sessions[claimedID] = sdata
+		a.sessions[claimedID] = sdata
 	case internal.EBeginAuthSessionResult_InvalidTicket:
 		err = ErrInvalidTicket
 	case internal.EBeginAuthSessionResult_DuplicateRequest:
-		sdata = sessions[claimedID]
+		sdata = a.sessions[claimedID]
 		if sdata != nil {
 			sdata.refs++
 		}
@@ -170,6 +237,7 @@ func BeginSession(ticket []byte, claimedID steamworks.SteamID) (*Session, error)
 	var sess *Session
 	if sdata != nil {
 		sess = &Session{
+			auth:      a,
 			claimedID: claimedID,
 			data:      sdata,
 		}
@@ -189,10 +257,10 @@ var ErrSessionAlreadyClosed = errors.New("steamworks/steamauth: session was alre
 //
 // Close must be called when the session ends.
 func (s *Session) Close() error {
-	sessionLock.Lock()
-	defer sessionLock.Unlock()
+	s.auth.lock.Lock()
+	defer s.auth.lock.Unlock()
 
-	if s.data != sessions[s.claimedID] || s.closed {
+	if s.data != s.auth.sessions[s.claimedID] || s.closed {
 		// This session was already closed.
 		return ErrSessionAlreadyClosed
 	}
@@ -202,29 +270,28 @@ func (s *Session) Close() error {
 }
 
 func (s *Session) close() {
-	defer internal.Cleanup()()
+	s.auth.endSession(s.claimedID, s.data)
+	runtime.SetFinalizer(s, nil)
 
-	if internal.IsGameServer {
-		internal.SteamAPI_ISteamGameServer_EndAuthSession(internal.SteamID(s.claimedID))
-	} else {
-		internal.SteamAPI_ISteamUser_EndAuthSession(internal.SteamID(s.claimedID))
-	}
+	s.closed = true
+}
 
-	delete(sessions, s.claimedID)
-	runtime.SetFinalizer(s, nil)
+// endSession ends the session for claimedID. a.lock must be held.
+func (a *Auth) endSession(claimedID steamworks.SteamID, sdata *sessionData) {
+	a.h.Backend().Auth().EndAuthSession(claimedID)
 
-	s.data.status = StatusClosed
-	close(s.data.change)
+	delete(a.sessions, claimedID)
 
-	s.closed = true
+	sdata.status = StatusClosed
+	close(sdata.change)
 }
 
 func (s *Session) complain() {
-	sessionLock.Lock()
-	defer sessionLock.Unlock()
+	s.auth.lock.Lock()
 
-	if s.data != sessions[s.claimedID] || s.closed {
+	if s.data != s.auth.sessions[s.claimedID] || s.closed {
 		// This session was already closed.
+		s.auth.lock.Unlock()
 		return
 	}
 
@@ -232,13 +299,15 @@ func (s *Session) complain() {
 	if s.data.refs != 0 {
 		s.data.refs--
 		s.closed = true
+		s.auth.lock.Unlock()
 		return
 	}
 
 	s.close()
-	// Don't handle an error writing to Stderr because there's nothing we
-	// can do about it.
+	s.auth.lock.Unlock()
 
-	// nolint: gosec
-	_, _ = os.Stderr.WriteString("[DEVELOPER ERROR] steamworks/steamauth: Sessions must be closed when they are no longer in use!\n")
+	steamworks.ReportDiagnostic(steamworks.Diagnostic{
+		Package: "steamworks/steamauth",
+		Message: "Sessions must be closed when they are no longer in use!",
+	})
 }
@@ -64,28 +64,22 @@ const (
 	StatusPublisherIssuedBan SessionStatus = SessionStatus(internal.EAuthSessionResponse_PublisherIssuedBan + 2)
 )
 
-var initOnce internal.Once
+func (a *Auth) onValidateAuthTicketResponse(claimedID, ownerID steamworks.SteamID, response internal.EAuthSessionResponse) {
+	status := SessionStatus(response + 2)
 
-func doInit() {
-	internal.RegisterCallback_ValidateAuthTicketResponse(func(data *internal.ValidateAuthTicketResponse, _ bool) {
-		claimedID := steamworks.SteamID(data.SteamID.Get())
-		ownerID := steamworks.SteamID(data.OwnerSteamID.Get())
-		status := SessionStatus(data.EAuthSessionResponse + 2)
+	a.lock.Lock()
+	defer a.lock.Unlock()
 
-		sessionLock.Lock()
-		defer sessionLock.Unlock()
+	sess := a.sessions[claimedID]
+	if sess == nil {
+		return
+	}
 
-		sess := sessions[claimedID]
-		if sess == nil {
-			return
-		}
-
-		sess.ownerID = ownerID
-		sess.status = status
-		select {
-		case <-sess.change:
-			sess.change <- status
-		case sess.change <- status:
-		}
-	}, 0)
+	sess.ownerID = ownerID
+	sess.status = status
+	select {
+	case <-sess.change:
+		sess.change <- status
+	case sess.change <- status:
+	}
 }
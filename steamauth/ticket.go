@@ -1,10 +1,6 @@
 package steamauth
 
-import (
-	"unsafe"
-
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks"
 
 // CreateTicket generates a sequence of bytes that verifies your identity and
 // ownership of a game to another Steam user or server.
@@ -12,21 +8,18 @@ import (
 // The ticket can only be used once, and cancel should be called when the ticket
 // is no longer in use - that is, when the session ends.
 func CreateTicket() (ticket []byte, cancel func()) {
-	var handle internal.HAuthTicket
-	var buffer [1024]byte
-	var actualLength uint32
+	return For(steamworks.Global()).CreateTicket()
+}
 
-	if internal.IsGameServer {
-		handle = internal.SteamAPI_ISteamGameServer_GetAuthSessionTicket(unsafe.Pointer(&buffer[0]), int32(len(buffer)), &actualLength)
-	} else {
-		handle = internal.SteamAPI_ISteamUser_GetAuthSessionTicket(unsafe.Pointer(&buffer[0]), int32(len(buffer)), &actualLength)
-	}
+// CreateTicket is like the package-level CreateTicket, but the ticket
+// identifies the game client or game server a belongs to.
+func (a *Auth) CreateTicket() (ticket []byte, cancel func()) {
+	auth := a.h.Backend().Auth()
+
+	var buffer [1024]byte
+	handle, actualLength := auth.GetAuthSessionTicket(buffer[:])
 
 	return buffer[:actualLength], func() {
-		if internal.IsGameServer {
-			internal.SteamAPI_ISteamGameServer_CancelAuthTicket(handle)
-		} else {
-			internal.SteamAPI_ISteamUser_CancelAuthTicket(handle)
-		}
+		auth.CancelAuthTicket(handle)
 	}
 }
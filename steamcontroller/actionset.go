@@ -1,10 +1,6 @@
 package steamcontroller
 
-import (
-	"unsafe"
-
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks/internal"
 
 // ActionSetHandle is used to refer to specific in-game actions or action sets.
 type ActionSetHandle = internal.ControllerActionSetHandle
@@ -35,9 +31,7 @@ type ActionSetHandle = internal.ControllerActionSetHandle
 //        }
 //    }
 func ActivateActionSet(controller Handle, actionSet ActionSetHandle) {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_ActivateActionSet(controller, actionSet)
+	backend().ActivateActionSet(controller, actionSet)
 }
 
 // GetActionSetHandle looks up the handle for an Action Set. Best to do this
@@ -45,18 +39,11 @@ func ActivateActionSet(controller Handle, actionSet ActionSetHandle) {
 //
 // The name refers to an identifier in the game's VDF file.
 func GetActionSetHandle(name string) ActionSetHandle {
-	defer cleanup()()
-
-	cname := internal.CString(name)
-	defer internal.Free(unsafe.Pointer(cname))
-
-	return internal.SteamAPI_ISteamController_GetActionSetHandle(cname)
+	return backend().GetActionSetHandle(name)
 }
 
 // GetCurrentActionSet returns the current action set for the specified
 // controller.
 func GetCurrentActionSet(controller Handle) ActionSetHandle {
-	defer cleanup()()
-
-	return internal.SteamAPI_ISteamController_GetCurrentActionSet(controller)
+	return backend().GetCurrentActionSet(controller)
 }
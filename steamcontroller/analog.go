@@ -1,10 +1,6 @@
 package steamcontroller
 
-import (
-	"unsafe"
-
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks/internal"
 
 // AnalogActionHandle is a handle to an analog action. This can be obtained
 // from GetAnalogActionHandle.
@@ -30,11 +26,7 @@ type AnalogActionHandle = internal.ControllerAnalogActionHandle
 // In the case of single-axis analog inputs (such as analog triggers), only the
 // x axis will contain data; the y axis will always be zero.
 func GetAnalogActionData(controller Handle, analogAction AnalogActionHandle) (x, y float32, mode SourceMode, active bool) {
-	defer cleanup()()
-
-	data := internal.SteamAPI_ISteamController_GetAnalogActionData(controller, analogAction)
-
-	return float32(data.X), float32(data.Y), SourceMode(data.EMode), bool(data.BActive)
+	return backend().GetAnalogActionData(controller, analogAction)
 }
 
 // GetAnalogActionHandle gets the handle of the specified analog action.
@@ -47,23 +39,16 @@ func GetAnalogActionData(controller Handle, analogAction AnalogActionHandle) (x,
 //
 // The name refers to an identifier in the game's VDF file.
 func GetAnalogActionHandle(name string) AnalogActionHandle {
-	defer cleanup()()
-
-	cname := internal.CString(name)
-	defer internal.Free(unsafe.Pointer(cname))
-
-	return internal.SteamAPI_ISteamController_GetAnalogActionHandle(cname)
+	return backend().GetAnalogActionHandle(name)
 }
 
 // GetAnalogActionOrigins returns a slice containing the origin(s) for an
 // analog action within an action set. Use this to display the appropriate
 // on-screen prompt for the action.
 func GetAnalogActionOrigins(controller Handle, actionSet ActionSetHandle, analogAction AnalogActionHandle) []ActionOrigin {
-	defer cleanup()()
-
 	var originsOut [maxOrigins]ActionOrigin
 
-	count := internal.SteamAPI_ISteamController_GetAnalogActionOrigins(controller, actionSet, analogAction, &originsOut[0])
+	count := backend().GetAnalogActionOrigins(controller, actionSet, analogAction, originsOut[:])
 
 	return originsOut[:count]
 }
@@ -75,7 +60,5 @@ func GetAnalogActionOrigins(controller Handle, actionSet ActionSetHandle, analog
 // situations where you want to indicate to the user that the limit of an
 // action has been reached, such as spinning a carousel or scrolling a webpage.
 func StopAnalogActionMomentum(controller Handle, analogAction AnalogActionHandle) {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_StopAnalogActionMomentum(controller, analogAction)
+	backend().StopAnalogActionMomentum(controller, analogAction)
 }
@@ -9,25 +9,28 @@
 // <https://partner.steamgames.com/doc/features/steam_controller>
 package steamcontroller
 
-import "github.com/BenLubar/steamworks/internal"
+import (
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+)
 
 // STEAM_CONTROLLER_MAX_COUNT
 const maxControllers = 16
 
 var initOnce internal.Once
 
-func cleanup() func() {
-	c := internal.Cleanup()
+func backend() steamworks.ControllerBackend {
+	b := steamworks.GetBackend().Controller()
 
 	initOnce.Do(func() {
-		internal.SteamAPI_ISteamController_Init()
+		b.Init()
 
 		internal.OnShutdown(func() {
-			internal.SteamAPI_ISteamController_Shutdown()
+			b.Shutdown()
 		})
 	})
 
-	return c
+	return b
 }
 
 // RunFrame synchronizes API state with the latest Steam Controller inputs
@@ -35,9 +38,7 @@ func cleanup() func() {
 // for the absolute lowest possible latency, you can call this directly before
 // reading controller state.
 func RunFrame() {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_RunFrame()
+	backend().RunFrame()
 }
 
 // Handle consistently identifies a controller, even if it is disconnected and
@@ -50,11 +51,9 @@ const AllControllers Handle = Handle(^uint64(0))
 
 // GetConnectedControllers enumerates currently connected controllers.
 func GetConnectedControllers() []Handle {
-	defer cleanup()()
-
 	var handlesOut [maxControllers]Handle
 
-	count := internal.SteamAPI_ISteamController_GetConnectedControllers(&handlesOut[0])
+	count := backend().GetConnectedControllers(handlesOut[:])
 
 	return handlesOut[:count]
 }
@@ -62,17 +61,13 @@ func GetConnectedControllers() []Handle {
 // GetControllerForGamepadIndex returns the associated controller handle for the
 // specified emulated gamepad.
 func GetControllerForGamepadIndex(index int) Handle {
-	defer cleanup()()
-
-	return internal.SteamAPI_ISteamController_GetControllerForGamepadIndex(int32(index))
+	return backend().GetControllerForGamepadIndex(int32(index))
 }
 
 // GetGamepadIndexForController returns the associated gamepad index for the
 // specified controller, if emulating a gamepad.
 func GetGamepadIndexForController(controller Handle) int {
-	defer cleanup()()
-
-	return int(internal.SteamAPI_ISteamController_GetGamepadIndexForController(controller))
+	return int(backend().GetGamepadIndexForController(controller))
 }
 
 // ControllerMotionData represents the current state of a device's motion
@@ -95,27 +90,12 @@ type ControllerMotionData struct {
 
 // GetMotionData returns raw motion data for the specified controller.
 func GetMotionData(controller Handle) ControllerMotionData {
-	defer cleanup()()
-
-	data := internal.SteamAPI_ISteamController_GetMotionData(controller)
+	rotQuat, posAccel, rotVel := backend().GetMotionData(controller)
 
 	return ControllerMotionData{
-		RotQuat: [4]float32{
-			float32(data.RotQuatX),
-			float32(data.RotQuatY),
-			float32(data.RotQuatZ),
-			float32(data.RotQuatW),
-		},
-		PosAccel: [3]float32{
-			float32(data.PosAccelX),
-			float32(data.PosAccelY),
-			float32(data.PosAccelZ),
-		},
-		RotVel: [3]float32{
-			float32(data.RotVelX),
-			float32(data.RotVelY),
-			float32(data.RotVelZ),
-		},
+		RotQuat:  rotQuat,
+		PosAccel: posAccel,
+		RotVel:   rotVel,
 	}
 }
 
@@ -126,17 +106,13 @@ func GetMotionData(controller Handle) ControllerMotionData {
 // LED. The DS4 responds to full color information and uses the values to set
 // the color and brightness of the lightbar.
 func SetLEDColor(controller Handle, r, g, b uint8) {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_SetLEDColor(controller, r, g, b, uint32(internal.ESteamControllerLEDFlag_SetColor))
+	backend().SetLEDColor(controller, r, g, b, internal.ESteamControllerLEDFlag_SetColor)
 }
 
 // ResetLEDColor restores the out-of-game default color for the specified
 // controller.
 func ResetLEDColor(controller Handle) {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_SetLEDColor(controller, 0, 0, 0, uint32(internal.ESteamControllerLEDFlag_RestoreUserDefault))
+	backend().SetLEDColor(controller, 0, 0, 0, internal.ESteamControllerLEDFlag_RestoreUserDefault)
 }
 
 // ShowBindingPanel invokes the Steam overlay and brings up the binding screen.
@@ -144,7 +120,5 @@ func ResetLEDColor(controller Handle) {
 // Returns true for success; false if the overlay is disabled or unavailable,
 // or if the user is not in Big Picture mode.
 func ShowBindingPanel(controller Handle) bool {
-	defer cleanup()()
-
-	return internal.SteamAPI_ISteamController_ShowBindingPanel(controller)
+	return backend().ShowBindingPanel(controller)
 }
@@ -1,10 +1,6 @@
 package steamcontroller
 
-import (
-	"unsafe"
-
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks/internal"
 
 // DigitalActionHandle is a handle to a digital action. This can be obtained
 // from GetDigitalActionHandle.
@@ -13,11 +9,7 @@ type DigitalActionHandle = internal.ControllerDigitalActionHandle
 // GetDigitalActionData returns the current state of the specified digital game
 // action.
 func GetDigitalActionData(controller Handle, digitalAction DigitalActionHandle) (state, active bool) {
-	defer cleanup()()
-
-	data := internal.SteamAPI_ISteamController_GetDigitalActionData(controller, digitalAction)
-
-	return bool(data.BState), bool(data.BActive)
+	return backend().GetDigitalActionData(controller, digitalAction)
 }
 
 // GetDigitalActionHandle gets the handle of the specified digital action.
@@ -30,23 +22,16 @@ func GetDigitalActionData(controller Handle, digitalAction DigitalActionHandle)
 //
 // The name refers to an identifier in the game's VDF file.
 func GetDigitalActionHandle(name string) DigitalActionHandle {
-	defer cleanup()()
-
-	cname := internal.CString(name)
-	defer internal.Free(unsafe.Pointer(cname))
-
-	return internal.SteamAPI_ISteamController_GetDigitalActionHandle(cname)
+	return backend().GetDigitalActionHandle(name)
 }
 
 // GetDigitalActionOrigins returns a slice containing the origin(s) for a
 // digital action within an action set. Use this to display the appropriate
 // on-screen prompt for the action.
 func GetDigitalActionOrigins(controller Handle, actionSet ActionSetHandle, digitalAction DigitalActionHandle) []ActionOrigin {
-	defer cleanup()()
-
 	var originsOut [maxOrigins]ActionOrigin
 
-	count := internal.SteamAPI_ISteamController_GetDigitalActionOrigins(controller, actionSet, digitalAction, &originsOut[0])
+	count := backend().GetDigitalActionOrigins(controller, actionSet, digitalAction, originsOut[:])
 
 	return originsOut[:count]
 }
@@ -38,9 +38,7 @@ func micros(duration time.Duration) uint16 {
 // be thought of as a low-level primitive meant to be repeatedly used in
 // higher-level user functions to generate more sophisticated behavior.
 func TriggerHapticPulse(controller Handle, targetPad Pad, duration time.Duration) {
-	defer cleanup()()
-
-	internal.SteamAPI_ISteamController_TriggerHapticPulse(controller, targetPad, micros(duration))
+	backend().TriggerHapticPulse(controller, targetPad, micros(duration))
 }
 
 // TriggerRepeatedHapticPulse triggers a repeated haptic pulse on supported
@@ -55,7 +53,7 @@ func TriggerHapticPulse(controller Handle, targetPad Pad, duration time.Duration
 // Changing the duration and off parameters will change the "texture" of the
 // haptic pulse. The maximum value for either parameter is 0.065535 seconds.
 func TriggerRepeatedHapticPulse(controller Handle, targetPad Pad, duration, off time.Duration, repeats uint16) {
-	internal.SteamAPI_ISteamController_TriggerRepeatedHapticPulse(controller, targetPad, micros(duration), micros(off), repeats, 0)
+	backend().TriggerRepeatedHapticPulse(controller, targetPad, micros(duration), micros(off), repeats)
 }
 
 // TriggerVibration triggers a vibration event on supported controllers.
@@ -67,5 +65,5 @@ func TriggerRepeatedHapticPulse(controller Handle, targetPad Pad, duration, off
 // leftSpeed and rightSpeed are the period of the corresponding rumble motor's
 // vibration. The maximum value for either parameter is 0.065535 seconds.
 func TriggerVibration(controller Handle, leftSpeed, rightSpeed time.Duration) {
-	internal.SteamAPI_ISteamController_TriggerVibration(controller, micros(leftSpeed), micros(rightSpeed))
+	backend().TriggerVibration(controller, micros(leftSpeed), micros(rightSpeed))
 }
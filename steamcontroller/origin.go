@@ -215,15 +215,11 @@ const (
 // GetGlyphForActionOrigin returns a local path to art for on-screen glyph for
 // a particular origin. The returned path refers to a PNG file.
 func GetGlyphForActionOrigin(origin ActionOrigin) string {
-	defer cleanup()()
-
-	return internal.GoString(internal.SteamAPI_ISteamController_GetGlyphForActionOrigin(origin))
+	return backend().GetGlyphForActionOrigin(origin)
 }
 
 // GetStringForActionOrigin returns a localized string (from Steam's language
 // setting) for the specified origin.
 func GetStringForActionOrigin(origin ActionOrigin) string {
-	defer cleanup()()
-
-	return internal.GoString(internal.SteamAPI_ISteamController_GetStringForActionOrigin(origin))
+	return backend().GetStringForActionOrigin(origin)
 }
@@ -0,0 +1,80 @@
+// Package steamdebug serves information about the state of the steamworks
+// packages over HTTP, for finding callback registrations that are never
+// unregistered.
+//
+// Like net/http/pprof, importing this package for its side effects registers
+// its handler with http.DefaultServeMux:
+//
+//    import _ "github.com/BenLubar/steamworks/steamdebug"
+//
+// The handler is served at /debug/steamworks/registrations and lists each
+// live callback registration, grouped by callback type, with the stack trace
+// of the code that made it. The number of live registrations of each type is
+// also published to expvar as "steamworks.registrations".
+//
+// Programs that do not use http.DefaultServeMux can serve Handler themselves.
+package steamdebug
+
+import (
+	"expvar"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+func init() {
+	http.Handle("/debug/steamworks/registrations", Handler())
+	expvar.Publish("steamworks.registrations", expvar.Func(counts))
+}
+
+// Handler returns an http.Handler that lists the live callback registrations
+// of the current Backend as plain text.
+func Handler() http.Handler {
+	return http.HandlerFunc(serveRegistrations)
+}
+
+func serveRegistrations(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	regs := steamworks.Registrations()
+	byType := make(map[steamworks.CallbackID][]steamworks.RegistrationInfo)
+	var ids []steamworks.CallbackID
+	for _, reg := range regs {
+		if _, ok := byType[reg.CallbackID]; !ok {
+			ids = append(ids, reg.CallbackID)
+		}
+		byType[reg.CallbackID] = append(byType[reg.CallbackID], reg)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	now := time.Now()
+
+	fmt.Fprintf(w, "%d live callback registrations\n", len(regs))
+	for _, id := range ids {
+		fmt.Fprintf(w, "\n%v: %d\n", id, len(byType[id]))
+		for _, reg := range byType[id] {
+			side := "client"
+			if reg.GameServer {
+				side = "server"
+			}
+			fmt.Fprintf(w, "\n# registration %d, %s, %v ago", reg.ID, side, now.Sub(reg.Registered).Round(time.Millisecond))
+			if reg.Call != 0 {
+				fmt.Fprintf(w, ", waiting for call %d", reg.Call)
+			}
+			fmt.Fprintf(w, "\n%s", reg.Stack())
+		}
+	}
+}
+
+// counts returns the number of live registrations of each callback type.
+func counts() interface{} {
+	m := make(map[string]int)
+	for _, reg := range steamworks.Registrations() {
+		m[reg.CallbackID.String()]++
+	}
+	return m
+}
@@ -0,0 +1,44 @@
+// Package steamgameserver wraps the parts of Steam's game server API that are
+// only available to game servers.
+//
+// The package-level functions use steamworks.Global(). A process that runs
+// both a game client and a game server should use For with the
+// steamworks.Server instead.
+//
+// See the Steam Game Servers documentation for more details.
+// <https://partner.steamgames.com/doc/features/multiplayer/game_servers>
+package steamgameserver
+
+import (
+	"errors"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// ErrNotGameServer is returned if the handle is not an initialized game
+// server.
+var ErrNotGameServer = errors.New("steamgameserver: the Steamworks API is not initialized as a game server")
+
+// GameServer is the game server API of a handle.
+type GameServer struct {
+	h steamworks.Handle
+}
+
+// For returns the game server API for h, which should be a
+// *steamworks.Server.
+func For(h steamworks.Handle) *GameServer {
+	return &GameServer{h: h}
+}
+
+func (g *GameServer) backend() (steamworks.Backend, error) {
+	if !steamworks.Supported() {
+		return nil, steamworks.ErrUnsupported
+	}
+
+	b := g.h.Backend()
+	if !b.IsGameServer() {
+		return nil, ErrNotGameServer
+	}
+
+	return b, nil
+}
@@ -0,0 +1,169 @@
+package steamgameserver
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// maxOutgoingPacket is the buffer size Valve recommends for
+// GetNextOutgoingPacket.
+const maxOutgoingPacket = 16 * 1024
+
+// SocketShare is a net.PacketConn for the game port of a game server
+// initialized with steamworks.UseGameSocketShare as the query port. It shares
+// the game's socket with Steam: master server queries received on the socket
+// are passed to Steam instead of being returned by ReadFrom, and the packets
+// Steam wants to send are sent on the socket.
+//
+// Query packets are recognized by their 0xFFFFFFFF header. They are only
+// passed to Steam while the game calls ReadFrom, which it would do anyway to
+// receive its own packets. Steam's outgoing packets are sent after each query
+// and once per call to steamworks.RunCallbacks.
+//
+// While the game server is shut down, queries are dropped and nothing is sent
+// for Steam. The game's own packets are not affected.
+type SocketShare struct {
+	conn net.PacketConn
+	g    *GameServer
+	tick steamworks.Registration
+
+	// sendLock keeps the outgoing packets in order and protects buf.
+	sendLock sync.Mutex
+	buf      []byte
+}
+
+// ShareSocket returns a SocketShare that wraps conn, which must be the UDP
+// socket for the game port. Closing the SocketShare closes conn.
+//
+// ShareSocket returns ErrNotGameServer if the game server is not initialized.
+// It does not check that the game server is in GameSocketShare mode.
+func ShareSocket(conn net.PacketConn) (*SocketShare, error) {
+	return For(steamworks.Global()).ShareSocket(conn)
+}
+
+// ShareSocket is like the package-level ShareSocket, but uses g.
+func (g *GameServer) ShareSocket(conn net.PacketConn) (*SocketShare, error) {
+	if _, err := g.backend(); err != nil {
+		return nil, err
+	}
+
+	s := &SocketShare{
+		conn: conn,
+		g:    g,
+		buf:  make([]byte, maxOutgoingPacket),
+	}
+	s.tick = steamworks.OnRunCallbacks(s.sendOutgoing)
+
+	return s, nil
+}
+
+// ReadFrom reads the next packet that is not a Steam query. See
+// net.PacketConn.
+func (s *SocketShare) ReadFrom(p []byte) (int, net.Addr, error) {
+	for {
+		n, addr, err := s.conn.ReadFrom(p)
+		if err != nil || !s.handleQuery(p[:n], addr) {
+			return n, addr, err
+		}
+	}
+}
+
+// gameServer returns the game server API, or nil if the game server is not
+// initialized.
+func (s *SocketShare) gameServer() steamworks.GameServerBackend {
+	b, err := s.g.backend()
+	if err != nil {
+		return nil
+	}
+
+	return b.GameServer()
+}
+
+// handleQuery passes packet to Steam if it is a query, and returns true if it
+// was a query.
+func (s *SocketShare) handleQuery(packet []byte, addr net.Addr) bool {
+	if len(packet) < 4 || binary.LittleEndian.Uint32(packet) != 0xFFFFFFFF {
+		return false
+	}
+
+	udpAddr, ok := addr.(*net.UDPAddr)
+	if !ok {
+		return false
+	}
+	ip4 := udpAddr.IP.To4()
+	if ip4 == nil {
+		// Steam only handles IPv4.
+		return false
+	}
+
+	gameServer := s.gameServer()
+	if gameServer == nil {
+		// Steam is not running to answer the query.
+		return true
+	}
+
+	gameServer.HandleIncomingPacket(packet, binary.BigEndian.Uint32(ip4), uint16(udpAddr.Port))
+	s.sendOutgoing()
+
+	return true
+}
+
+// sendOutgoing sends the packets Steam has queued.
+func (s *SocketShare) sendOutgoing() {
+	gameServer := s.gameServer()
+	if gameServer == nil {
+		return
+	}
+
+	s.sendLock.Lock()
+	defer s.sendLock.Unlock()
+
+	for {
+		size, ip, port := gameServer.GetNextOutgoingPacket(s.buf)
+		if size <= 0 {
+			return
+		}
+
+		addr := &net.UDPAddr{IP: make(net.IP, 4), Port: int(port)}
+		binary.BigEndian.PutUint32(addr.IP, ip)
+
+		// Like any UDP packet, a query response that cannot be sent is
+		// lost, and the client will retry.
+		_, _ = s.conn.WriteTo(s.buf[:size], addr)
+	}
+}
+
+// WriteTo sends a packet from the game. See net.PacketConn.
+func (s *SocketShare) WriteTo(p []byte, addr net.Addr) (int, error) {
+	return s.conn.WriteTo(p, addr)
+}
+
+// Close stops sharing the socket and closes it.
+func (s *SocketShare) Close() error {
+	s.tick.Unregister()
+	return s.conn.Close()
+}
+
+// LocalAddr returns the address of the game port.
+func (s *SocketShare) LocalAddr() net.Addr {
+	return s.conn.LocalAddr()
+}
+
+// SetDeadline implements net.PacketConn.
+func (s *SocketShare) SetDeadline(t time.Time) error {
+	return s.conn.SetDeadline(t)
+}
+
+// SetReadDeadline implements net.PacketConn.
+func (s *SocketShare) SetReadDeadline(t time.Time) error {
+	return s.conn.SetReadDeadline(t)
+}
+
+// SetWriteDeadline implements net.PacketConn.
+func (s *SocketShare) SetWriteDeadline(t time.Time) error {
+	return s.conn.SetWriteDeadline(t)
+}
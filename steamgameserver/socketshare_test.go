@@ -0,0 +1,93 @@
+package steamgameserver_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamgameserver"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+// packetConn is a net.PacketConn that returns queued packets.
+type packetConn struct {
+	incoming []steamtest.ServerPacket
+	sent     []steamtest.ServerPacket
+}
+
+func (c *packetConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	if len(c.incoming) == 0 {
+		return 0, nil, net.ErrClosed
+	}
+
+	packet := c.incoming[0]
+	c.incoming = c.incoming[1:]
+
+	return copy(p, packet.Data), packet.Addr, nil
+}
+
+func (c *packetConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	c.sent = append(c.sent, steamtest.ServerPacket{
+		Addr: addr.(*net.UDPAddr),
+		Data: append([]byte(nil), p...),
+	})
+
+	return len(p), nil
+}
+
+func (c *packetConn) Close() error                       { return nil }
+func (c *packetConn) LocalAddr() net.Addr                { return &net.UDPAddr{} }
+func (c *packetConn) SetDeadline(t time.Time) error      { return nil }
+func (c *packetConn) SetReadDeadline(t time.Time) error  { return nil }
+func (c *packetConn) SetWriteDeadline(t time.Time) error { return nil }
+
+func TestSocketShareShutdown(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitServer(nil, 0, 27015, steamworks.UseGameSocketShare, steamworks.Authentication, "1.0", false); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &net.UDPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 27005}
+	query := []byte{0xFF, 0xFF, 0xFF, 0xFF, 'T'}
+	conn := &packetConn{}
+
+	s, err := steamgameserver.ShareSocket(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	conn.incoming = []steamtest.ServerPacket{{Addr: client, Data: query}, {Addr: client, Data: []byte("game")}}
+	fake.QueueOutgoingServerPacket(client, []byte("reply"))
+
+	p := make([]byte, 64)
+	if n, _, err := s.ReadFrom(p); err != nil || string(p[:n]) != "game" {
+		t.Errorf("ReadFrom: got (%q, %v), expected \"game\"", p[:n], err)
+	}
+	if incoming := fake.TakeIncomingServerPackets(); len(incoming) != 1 {
+		t.Errorf("%d queries passed to Steam, expected 1", len(incoming))
+	}
+	if len(conn.sent) != 1 || string(conn.sent[0].Data) != "reply" {
+		t.Errorf("sent packets: got %+v, expected the reply", conn.sent)
+	}
+
+	steamworks.Shutdown()
+
+	conn.incoming = []steamtest.ServerPacket{{Addr: client, Data: query}, {Addr: client, Data: []byte("game")}}
+	fake.QueueOutgoingServerPacket(client, []byte("late"))
+	conn.sent = nil
+
+	if n, _, err := s.ReadFrom(p); err != nil || string(p[:n]) != "game" {
+		t.Errorf("ReadFrom after Shutdown: got (%q, %v), expected \"game\"", p[:n], err)
+	}
+	steamworks.RunCallbacks()
+	if incoming := fake.TakeIncomingServerPackets(); len(incoming) != 0 {
+		t.Errorf("%d queries passed to Steam after Shutdown, expected 0", len(incoming))
+	}
+	if len(conn.sent) != 0 {
+		t.Errorf("sent packets after Shutdown: got %+v, expected none", conn.sent)
+	}
+}
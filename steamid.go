@@ -69,13 +69,30 @@ const (
 // SteamID is a 64-bit ID representing an object within the Steam "multiverse".
 type SteamID uint64
 
+// NewSteamID packs the components of a SteamID. The instance is truncated to
+// 20 bits and the account type to 4 bits.
+//
+// Individual accounts normally use InstanceDesktop, and clan and game server
+// accounts normally use instance 0.
+func NewSteamID(universe AccountUniverse, accountType AccountType, instance AccountInstance, accountID uint32) SteamID {
+	return SteamID(accountID) | SteamID(instance&0xFFFFF)<<32 | SteamID(accountType&0xF)<<52 | SteamID(universe&0xFF)<<56
+}
+
+// NewLobbyID returns the SteamID of a matchmaking lobby, which is a chat
+// account with the InstanceFlagLobby and InstanceFlagMMSLobby flags set.
+func NewLobbyID(universe AccountUniverse, accountID uint32) SteamID {
+	return NewSteamID(universe, AccountTypeChat, InstanceFlagLobby|InstanceFlagMMSLobby, accountID)
+}
+
 func (id SteamID) String() string {
 	return id.Steam2String()
 }
 
 // Steam2String returns the Steam2 string representation of this ID.
 //
-// If the account type is AccountTypeInvalid or AccountTypeIndividual
+// If the account type is AccountTypeInvalid or AccountTypeIndividual, the ID
+// is formatted as STEAM_X:Y:Z. Other account types cannot be represented in
+// the Steam2 format, so the 64-bit decimal representation is used instead.
 func (id SteamID) Steam2String() string {
 	if id.Type() == AccountTypeInvalid || id.Type() == AccountTypeIndividual {
 		universe := uint64(id.Universe())
@@ -115,15 +132,92 @@ func (id SteamID) Universe() AccountUniverse {
 // IsValid returns true if the SteamID has a valid format. It does not check
 // whether the target of the ID exists.
 func (id SteamID) IsValid() bool {
-	return internal.SteamID_IsValid(internal.SteamID(id))
+	// This is a port of CSteamID::IsValid from the Steamworks SDK.
+
+	if id.Type() <= AccountTypeInvalid || id.Type() >= internal.EAccountType_Max {
+		return false
+	}
+
+	if id.Universe() <= UniverseInvalid || id.Universe() >= internal.EUniverse_Max {
+		return false
+	}
+
+	switch id.Type() {
+	case AccountTypeIndividual:
+		if id.AccountID() == 0 || id.Instance() > InstanceWeb {
+			return false
+		}
+	case AccountTypeClan:
+		if id.AccountID() == 0 || id.Instance() != 0 {
+			return false
+		}
+	case AccountTypeGameServer:
+		if id.AccountID() == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsIndividual returns true if this SteamID is a user account, including
+// console users.
+func (id SteamID) IsIndividual() bool {
+	return id.Type() == AccountTypeIndividual || id.Type() == AccountTypeConsoleUser
+}
+
+// IsGameServer returns true if this SteamID is a persistent or anonymous game
+// server account.
+func (id SteamID) IsGameServer() bool {
+	return id.Type() == AccountTypeGameServer || id.Type() == AccountTypeAnonGameServer
+}
+
+// IsClan returns true if this SteamID is a clan (Steam group) account.
+func (id SteamID) IsClan() bool {
+	return id.Type() == AccountTypeClan
+}
+
+// IsChat returns true if this SteamID is a chat account. Lobbies and clan
+// chat rooms are both chat accounts.
+func (id SteamID) IsChat() bool {
+	return id.Type() == AccountTypeChat
+}
+
+// IsLobby returns true if this SteamID is a chat account with the
+// InstanceFlagLobby flag set.
+func (id SteamID) IsLobby() bool {
+	return id.Type() == AccountTypeChat && id.Instance()&InstanceFlagLobby != 0
+}
+
+// ChatID returns the chat room of a clan. If id is already a chat account, it
+// is returned unchanged. Other account types return SteamIDNil.
+func (id SteamID) ChatID() SteamID {
+	switch id.Type() {
+	case AccountTypeChat:
+		return id
+	case AccountTypeClan:
+		return NewSteamID(id.Universe(), AccountTypeChat, InstanceFlagClan, id.AccountID())
+	default:
+		return SteamIDNil
+	}
+}
+
+// ClanID returns the clan that owns a clan chat room. If id is already a clan
+// account, it is returned unchanged. Chat accounts without InstanceFlagClan,
+// including lobbies, and other account types return SteamIDNil.
+func (id SteamID) ClanID() SteamID {
+	switch {
+	case id.Type() == AccountTypeClan:
+		return id
+	case id.Type() == AccountTypeChat && id.Instance()&InstanceFlagClan != 0:
+		return NewSteamID(id.Universe(), AccountTypeClan, 0, id.AccountID())
+	default:
+		return SteamIDNil
+	}
 }
 
 // GetSteamID returns the Steam ID associated with the current user or game
 // server.
 func GetSteamID() SteamID {
-	if internal.IsGameServer {
-		return SteamID(internal.SteamAPI_ISteamGameServer_GetSteamID())
-	}
-
-	return SteamID(internal.SteamAPI_ISteamUser_GetSteamID())
+	return GetBackend().SteamID()
 }
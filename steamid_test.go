@@ -0,0 +1,148 @@
+package steamworks_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+)
+
+func TestSteamIDComponents(t *testing.T) {
+	id := steamworks.NewSteamID(steamworks.UniverseBeta, steamworks.AccountTypeChat, steamworks.InstanceFlagLobby|3, 0x89ABCDEF)
+
+	if id != 0x0284_0003_89AB_CDEF {
+		t.Errorf("NewSteamID = %#x, expected %#x", uint64(id), uint64(0x0284000389ABCDEF))
+	}
+	if universe := id.Universe(); universe != steamworks.UniverseBeta {
+		t.Errorf("Universe() = %v, expected %v", universe, steamworks.UniverseBeta)
+	}
+	if accountType := id.Type(); accountType != steamworks.AccountTypeChat {
+		t.Errorf("Type() = %v, expected %v", accountType, steamworks.AccountTypeChat)
+	}
+	if instance := id.Instance(); instance != steamworks.InstanceFlagLobby|3 {
+		t.Errorf("Instance() = %#x, expected %#x", instance, steamworks.InstanceFlagLobby|3)
+	}
+	if accountID := id.AccountID(); accountID != 0x89ABCDEF {
+		t.Errorf("AccountID() = %#x, expected %#x", accountID, 0x89ABCDEF)
+	}
+}
+
+// TestSteamIDIsValid checks IsValid against the rules of CSteamID::IsValid
+// in steamclientpublic.h.
+func TestSteamIDIsValid(t *testing.T) {
+	const public = steamworks.UniversePublic
+
+	for _, tt := range []struct {
+		name  string
+		id    steamworks.SteamID
+		valid bool
+	}{
+		{"nil", steamworks.SteamIDNil, false},
+		{"invalid type", steamworks.NewSteamID(public, steamworks.AccountTypeInvalid, 0, 1), false},
+		{"type out of range", steamworks.NewSteamID(public, 11, 0, 1), false},
+		{"invalid universe", steamworks.NewSteamID(steamworks.UniverseInvalid, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 1), false},
+		{"universe out of range", steamworks.NewSteamID(5, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 1), false},
+		{"dev universe", steamworks.NewSteamID(steamworks.UniverseDev, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 1), true},
+
+		{"individual", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 1), true},
+		{"individual instance 0", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, 0, 1), true},
+		{"individual web instance", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceWeb, 1), true},
+		{"individual instance too large", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceWeb+1, 1), false},
+		{"individual account 0", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 0), false},
+
+		{"clan", steamworks.NewSteamID(public, steamworks.AccountTypeClan, 0, 4), true},
+		{"clan with instance", steamworks.NewSteamID(public, steamworks.AccountTypeClan, 1, 4), false},
+		{"clan account 0", steamworks.NewSteamID(public, steamworks.AccountTypeClan, 0, 0), false},
+
+		{"game server", steamworks.NewSteamID(public, steamworks.AccountTypeGameServer, 0, 5), true},
+		{"game server with instance", steamworks.NewSteamID(public, steamworks.AccountTypeGameServer, 7, 5), true},
+		{"game server account 0", steamworks.NewSteamID(public, steamworks.AccountTypeGameServer, 0, 0), false},
+
+		// Other account types have no further rules.
+		{"anonymous game server account 0", steamworks.NewSteamID(public, steamworks.AccountTypeAnonGameServer, 0, 0), true},
+		{"multiseat", steamworks.NewSteamID(public, steamworks.AccountTypeMultiseat, 0, 0), true},
+		{"pending", steamworks.NewSteamID(public, steamworks.AccountTypePending, 0, 0), true},
+		{"content server", steamworks.NewSteamID(public, steamworks.AccountTypeContentServer, 0, 0), true},
+		{"chat account 0", steamworks.NewSteamID(public, steamworks.AccountTypeChat, 0, 0), true},
+		{"lobby", steamworks.NewLobbyID(public, 2), true},
+		{"console user", steamworks.NewSteamID(public, steamworks.AccountTypeConsoleUser, 0, 0), true},
+		{"anonymous user", steamworks.NewSteamID(public, steamworks.AccountTypeAnonUser, 0, 0), true},
+	} {
+		if valid := tt.id.IsValid(); valid != tt.valid {
+			t.Errorf("%s (%#x): IsValid() = %v, expected %v", tt.name, uint64(tt.id), valid, tt.valid)
+		}
+	}
+}
+
+func TestSteamIDTypePredicates(t *testing.T) {
+	const public = steamworks.UniversePublic
+
+	type predicates struct {
+		individual, gameServer, clan, chat, lobby bool
+	}
+
+	for _, tt := range []struct {
+		name string
+		id   steamworks.SteamID
+		want predicates
+	}{
+		{"invalid", steamworks.SteamIDNil, predicates{}},
+		{"individual", steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 1), predicates{individual: true}},
+		{"console user", steamworks.NewSteamID(public, steamworks.AccountTypeConsoleUser, 0, 1), predicates{individual: true}},
+		{"anonymous user", steamworks.NewSteamID(public, steamworks.AccountTypeAnonUser, 0, 1), predicates{}},
+		{"multiseat", steamworks.NewSteamID(public, steamworks.AccountTypeMultiseat, 0, 1), predicates{}},
+		{"game server", steamworks.NewSteamID(public, steamworks.AccountTypeGameServer, 0, 1), predicates{gameServer: true}},
+		{"anonymous game server", steamworks.NewSteamID(public, steamworks.AccountTypeAnonGameServer, 0, 1), predicates{gameServer: true}},
+		{"content server", steamworks.NewSteamID(public, steamworks.AccountTypeContentServer, 0, 1), predicates{}},
+		{"clan", steamworks.NewSteamID(public, steamworks.AccountTypeClan, 0, 1), predicates{clan: true}},
+		{"chat", steamworks.NewSteamID(public, steamworks.AccountTypeChat, 0, 1), predicates{chat: true}},
+		{"clan chat", steamworks.NewSteamID(public, steamworks.AccountTypeChat, steamworks.InstanceFlagClan, 1), predicates{chat: true}},
+		{"lobby", steamworks.NewLobbyID(public, 1), predicates{chat: true, lobby: true}},
+		{"lobby without MMS flag", steamworks.NewSteamID(public, steamworks.AccountTypeChat, steamworks.InstanceFlagLobby, 1), predicates{chat: true, lobby: true}},
+		{"lobby flag on clan", steamworks.NewSteamID(public, steamworks.AccountTypeClan, steamworks.InstanceFlagLobby, 1), predicates{clan: true}},
+	} {
+		got := predicates{
+			individual: tt.id.IsIndividual(),
+			gameServer: tt.id.IsGameServer(),
+			clan:       tt.id.IsClan(),
+			chat:       tt.id.IsChat(),
+			lobby:      tt.id.IsLobby(),
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %+v, expected %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSteamIDChatClanConversions(t *testing.T) {
+	const public = steamworks.UniversePublic
+
+	clan := steamworks.NewSteamID(public, steamworks.AccountTypeClan, 0, 4)
+	clanChat := steamworks.NewSteamID(public, steamworks.AccountTypeChat, steamworks.InstanceFlagClan, 4)
+	chat := steamworks.NewSteamID(public, steamworks.AccountTypeChat, 0, 4)
+	lobby := steamworks.NewLobbyID(public, 4)
+	user := steamworks.NewSteamID(public, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 4)
+	beta := steamworks.NewSteamID(steamworks.UniverseBeta, steamworks.AccountTypeClan, 0, 4)
+	betaChat := steamworks.NewSteamID(steamworks.UniverseBeta, steamworks.AccountTypeChat, steamworks.InstanceFlagClan, 4)
+
+	for _, tt := range []struct {
+		name           string
+		id             steamworks.SteamID
+		chatID, clanID steamworks.SteamID
+	}{
+		{"clan", clan, clanChat, clan},
+		{"clan chat", clanChat, clanChat, clan},
+		{"chat", chat, chat, steamworks.SteamIDNil},
+		{"lobby", lobby, lobby, steamworks.SteamIDNil},
+		{"individual", user, steamworks.SteamIDNil, steamworks.SteamIDNil},
+		{"nil", steamworks.SteamIDNil, steamworks.SteamIDNil, steamworks.SteamIDNil},
+		{"beta clan", beta, betaChat, beta},
+		{"beta clan chat", betaChat, betaChat, beta},
+	} {
+		if chatID := tt.id.ChatID(); chatID != tt.chatID {
+			t.Errorf("%s: ChatID() = %#x, expected %#x", tt.name, uint64(chatID), uint64(tt.chatID))
+		}
+		if clanID := tt.id.ClanID(); clanID != tt.clanID {
+			t.Errorf("%s: ClanID() = %#x, expected %#x", tt.name, uint64(clanID), uint64(tt.clanID))
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package steamworks
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ParseError is returned when text cannot be parsed as an ID.
+type ParseError struct {
+	// Kind is the kind of ID that was being parsed, for example "SteamID".
+	Kind string
+	// Text is the text that could not be parsed.
+	Text string
+}
+
+func (err *ParseError) Error() string {
+	return "steamworks: invalid " + err.Kind + " " + strconv.Quote(err.Text)
+}
+
+// steam3Types maps account types to the letters used in the Steam3 format.
+// Chat accounts use 'c' or 'L' instead of 'T' if they have InstanceFlagClan
+// or InstanceFlagLobby set. Steam has no letter for console users; they use
+// 'i', which Steam also uses for account types it does not recognize.
+var steam3Types = [...]byte{
+	AccountTypeInvalid:        'I',
+	AccountTypeIndividual:     'U',
+	AccountTypeMultiseat:      'M',
+	AccountTypeGameServer:     'G',
+	AccountTypeAnonGameServer: 'A',
+	AccountTypePending:        'P',
+	AccountTypeContentServer:  'C',
+	AccountTypeClan:           'g',
+	AccountTypeChat:           'T',
+	AccountTypeConsoleUser:    'i',
+	AccountTypeAnonUser:       'a',
+}
+
+// Steam3String returns the Steam3 string representation of this ID, for
+// example [U:1:22202] or [g:1:4].
+//
+// The instance is always included for multiseat and anonymous game server
+// accounts. For other account types, it is included if it is not the default
+// that ParseSteamID assumes: InstanceDesktop for individual accounts and 0
+// for everything else. For chat accounts, the clan or lobby instance flag is
+// represented by the letter c or L, and is omitted from the instance.
+//
+// ParseSteamID accepts every string returned by Steam3String, and returns the
+// original SteamID unless the account type is not one of the AccountType
+// constants.
+func (id SteamID) Steam3String() string {
+	accountType := id.Type()
+	instance := id.Instance()
+
+	letter := byte('i')
+	if int(accountType) < len(steam3Types) && steam3Types[accountType] != 0 {
+		letter = steam3Types[accountType]
+	}
+
+	if accountType == AccountTypeChat {
+		if instance&InstanceFlagClan != 0 {
+			letter = 'c'
+			instance &^= InstanceFlagClan
+		} else if instance&InstanceFlagLobby != 0 {
+			letter = 'L'
+			instance &^= InstanceFlagLobby
+		}
+	}
+
+	var showInstance bool
+	switch accountType {
+	case AccountTypeMultiseat, AccountTypeAnonGameServer:
+		showInstance = true
+	case AccountTypeIndividual:
+		showInstance = instance != InstanceDesktop
+	default:
+		showInstance = instance != 0
+	}
+
+	s := "[" + string(letter) + ":" + strconv.FormatUint(uint64(id.Universe()), 10) + ":" + strconv.FormatUint(uint64(id.AccountID()), 10)
+	if showInstance {
+		s += ":" + strconv.FormatUint(uint64(instance), 10)
+	}
+	return s + "]"
+}
+
+// ParseSteamID parses a SteamID in any of the following formats:
+//
+//    76561197960287930                                    (64-bit decimal)
+//    STEAM_0:0:11101                                      (Steam2)
+//    [U:1:22202]                                          (Steam3)
+//    [L:1:2:3]                                            (Steam3 with instance)
+//    https://steamcommunity.com/profiles/76561197960287930
+//    https://steamcommunity.com/profiles/[U:1:22202]
+//
+// Steam2 IDs are always individual accounts on the desktop instance. As in
+// the Source engine, the universe STEAM_0 is treated as UniversePublic.
+//
+// Steam3 IDs without an instance use InstanceDesktop for individual accounts
+// and 0 for everything else. The chat letters c and L set InstanceFlagClan and
+// InstanceFlagLobby, respectively.
+//
+// ParseSteamID only checks the syntax of s. Use IsValid to check whether the
+// result is a valid SteamID. Vanity URLs (steamcommunity.com/id/...) cannot be
+// resolved without a web request and are not supported.
+//
+// If s cannot be parsed, the error is a *ParseError.
+func ParseSteamID(s string) (SteamID, error) {
+	if id, ok := parseProfileURL(s); ok {
+		return id, nil
+	}
+	if id, ok := parseSteamIDNoURL(s); ok {
+		return id, nil
+	}
+	return SteamIDNil, &ParseError{Kind: "SteamID", Text: s}
+}
+
+func parseSteamIDNoURL(s string) (SteamID, bool) {
+	if strings.HasPrefix(s, "STEAM_") {
+		return parseSteam2(s[len("STEAM_"):])
+	}
+	if strings.HasPrefix(s, "[") && strings.HasSuffix(s, "]") {
+		return parseSteam3(s[1 : len(s)-1])
+	}
+
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return SteamIDNil, false
+	}
+	return SteamID(n), true
+}
+
+func parseSteam2(s string) (SteamID, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return SteamIDNil, false
+	}
+
+	universe, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return SteamIDNil, false
+	}
+	if universe == 0 {
+		universe = uint64(UniversePublic)
+	}
+
+	low, err := strconv.ParseUint(parts[1], 10, 1)
+	if err != nil {
+		return SteamIDNil, false
+	}
+
+	high, err := strconv.ParseUint(parts[2], 10, 31)
+	if err != nil {
+		return SteamIDNil, false
+	}
+
+	return NewSteamID(AccountUniverse(universe), AccountTypeIndividual, InstanceDesktop, uint32(high<<1|low)), true
+}
+
+func parseSteam3(s string) (SteamID, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return SteamIDNil, false
+	}
+	if len(parts[0]) != 1 {
+		return SteamIDNil, false
+	}
+
+	var accountType AccountType
+	var flags AccountInstance
+	switch letter := parts[0][0]; letter {
+	case 'c':
+		accountType, flags = AccountTypeChat, InstanceFlagClan
+	case 'L':
+		accountType, flags = AccountTypeChat, InstanceFlagLobby
+	default:
+		found := false
+		for t, l := range steam3Types {
+			if l != 0 && l == letter {
+				accountType, found = AccountType(t), true
+				break
+			}
+		}
+		if !found {
+			return SteamIDNil, false
+		}
+	}
+
+	universe, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return SteamIDNil, false
+	}
+
+	accountID, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return SteamIDNil, false
+	}
+
+	var instance AccountInstance
+	if len(parts) == 4 {
+		n, err := strconv.ParseUint(parts[3], 10, 20)
+		if err != nil {
+			return SteamIDNil, false
+		}
+		instance = AccountInstance(n)
+	} else if accountType == AccountTypeIndividual {
+		instance = InstanceDesktop
+	}
+
+	return NewSteamID(AccountUniverse(universe), accountType, instance|flags, uint32(accountID)), true
+}
+
+func parseProfileURL(s string) (SteamID, bool) {
+	if strings.HasPrefix(s, "https://") {
+		s = s[len("https://"):]
+	} else if strings.HasPrefix(s, "http://") {
+		s = s[len("http://"):]
+	}
+	s = strings.TrimPrefix(s, "www.")
+
+	const prefix = "steamcommunity.com/profiles/"
+	if !strings.HasPrefix(s, prefix) {
+		return SteamIDNil, false
+	}
+	s = s[len(prefix):]
+
+	if i := strings.IndexAny(s, "?#"); i != -1 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '/'); i != -1 {
+		// allow sub-pages such as /profiles/7656.../games
+		s = s[:i]
+	}
+
+	return parseSteamIDNoURL(s)
+}
+
+// MarshalText implements encoding.TextMarshaler. The ID is encoded as a 64-bit
+// decimal number.
+func (id SteamID) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(id), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Any format accepted by
+// ParseSteamID can be decoded.
+func (id *SteamID) UnmarshalText(text []byte) error {
+	parsed, err := ParseSteamID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The ID is encoded as a string
+// containing a 64-bit decimal number, as JavaScript numbers cannot represent
+// every SteamID exactly.
+func (id SteamID) MarshalJSON() ([]byte, error) {
+	return strconv.AppendQuote(nil, strconv.FormatUint(uint64(id), 10)), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. The ID may be a JSON number or a
+// string in any format accepted by ParseSteamID.
+func (id *SteamID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) != 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return id.UnmarshalText([]byte(s))
+	}
+
+	n, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return &ParseError{Kind: "SteamID", Text: string(data)}
+	}
+	*id = SteamID(n)
+	return nil
+}
+
+var errSteamIDScanType = errors.New("steamworks: cannot scan SteamID from this type")
+
+// Scan implements sql.Scanner. Integers are interpreted as 64-bit SteamIDs,
+// and strings may be in any format accepted by ParseSteamID. NULL is scanned
+// as SteamIDNil.
+func (id *SteamID) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*id = SteamIDNil
+	case int64:
+		*id = SteamID(uint64(v))
+	case uint64:
+		*id = SteamID(v)
+	case []byte:
+		return id.UnmarshalText(v)
+	case string:
+		return id.UnmarshalText([]byte(v))
+	default:
+		return errSteamIDScanType
+	}
+	return nil
+}
+
+// Value implements driver.Valuer. The ID is stored as a signed 64-bit integer,
+// which has the same bits as the SteamID. SteamIDs in UniversePublic are
+// always positive.
+func (id SteamID) Value() (driver.Value, error) {
+	return int64(id), nil
+}
@@ -0,0 +1,124 @@
+package steamworks_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+)
+
+func TestSteam3String(t *testing.T) {
+	for _, tt := range []struct {
+		id   steamworks.SteamID
+		text string
+	}{
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 22202), "[U:1:22202]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeIndividual, steamworks.InstanceWeb, 22202), "[U:1:22202:4]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeMultiseat, 0, 5), "[M:1:5:0]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeGameServer, 0, 5), "[G:1:5]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeAnonGameServer, 3, 5), "[A:1:5:3]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypePending, 0, 5), "[P:1:5]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeContentServer, 0, 5), "[C:1:5]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeClan, 0, 4), "[g:1:4]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeChat, 0, 4), "[T:1:4]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeChat, steamworks.InstanceFlagClan, 4), "[c:1:4]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeChat, steamworks.InstanceFlagLobby, 2), "[L:1:2]"},
+		{steamworks.NewLobbyID(steamworks.UniversePublic, 2), "[L:1:2:131072]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeConsoleUser, 0, 5), "[i:1:5]"},
+		{steamworks.NewSteamID(steamworks.UniversePublic, steamworks.AccountTypeAnonUser, 0, 5), "[a:1:5]"},
+		{steamworks.NewSteamID(steamworks.UniverseBeta, steamworks.AccountTypeIndividual, steamworks.InstanceDesktop, 7), "[U:2:7]"},
+		{steamworks.SteamIDNil, "[I:0:0]"},
+	} {
+		if text := tt.id.Steam3String(); text != tt.text {
+			t.Errorf("%d: Steam3String() = %q, expected %q", uint64(tt.id), text, tt.text)
+		}
+
+		id, err := steamworks.ParseSteamID(tt.text)
+		if err != nil {
+			t.Errorf("ParseSteamID(%q): %v", tt.text, err)
+		} else if id != tt.id {
+			t.Errorf("ParseSteamID(%q) = %d, expected %d", tt.text, uint64(id), uint64(tt.id))
+		}
+	}
+}
+
+// TestSteam3StringRoundTrip checks that ParseSteamID returns the original
+// SteamID for every account type and a range of instances.
+func TestSteam3StringRoundTrip(t *testing.T) {
+	types := []steamworks.AccountType{
+		steamworks.AccountTypeInvalid,
+		steamworks.AccountTypeIndividual,
+		steamworks.AccountTypeMultiseat,
+		steamworks.AccountTypeGameServer,
+		steamworks.AccountTypeAnonGameServer,
+		steamworks.AccountTypePending,
+		steamworks.AccountTypeContentServer,
+		steamworks.AccountTypeClan,
+		steamworks.AccountTypeChat,
+		steamworks.AccountTypeConsoleUser,
+		steamworks.AccountTypeAnonUser,
+	}
+	instances := []steamworks.AccountInstance{
+		0,
+		steamworks.InstanceDesktop,
+		steamworks.InstanceWeb,
+		steamworks.InstanceFlagClan,
+		steamworks.InstanceFlagLobby,
+		steamworks.InstanceFlagLobby | steamworks.InstanceFlagMMSLobby,
+		steamworks.InstanceFlagClan | steamworks.InstanceFlagLobby | 7,
+		0xFFFFF,
+	}
+
+	for _, accountType := range types {
+		for _, instance := range instances {
+			for _, accountID := range []uint32{0, 1, 22202, 0xFFFFFFFF} {
+				id := steamworks.NewSteamID(steamworks.UniversePublic, accountType, instance, accountID)
+				text := id.Steam3String()
+
+				parsed, err := steamworks.ParseSteamID(text)
+				if err != nil {
+					t.Errorf("ParseSteamID(%q): %v", text, err)
+				} else if parsed != id {
+					t.Errorf("ParseSteamID(%q) = %d, expected %d", text, uint64(parsed), uint64(id))
+				}
+			}
+		}
+	}
+}
+
+func TestParseSteamID(t *testing.T) {
+	user := steamworks.SteamID(76561197960287930)
+
+	for _, text := range []string{
+		"76561197960287930",
+		"STEAM_0:0:11101",
+		"STEAM_1:0:11101",
+		"[U:1:22202]",
+		"[U:1:22202:1]",
+		"https://steamcommunity.com/profiles/76561197960287930",
+		"http://www.steamcommunity.com/profiles/[U:1:22202]/games?tab=all",
+	} {
+		id, err := steamworks.ParseSteamID(text)
+		if err != nil {
+			t.Errorf("ParseSteamID(%q): %v", text, err)
+		} else if id != user {
+			t.Errorf("ParseSteamID(%q) = %d, expected %d", text, uint64(id), uint64(user))
+		}
+	}
+
+	for _, text := range []string{
+		"",
+		"STEAM_0:2:11101",
+		"STEAM_0:0",
+		"[U:1]",
+		"[X:1:22202]",
+		"[U:1:22202:1048576]",
+		"U:1:22202",
+		"https://steamcommunity.com/id/gabelogannewell",
+	} {
+		if id, err := steamworks.ParseSteamID(text); err == nil {
+			t.Errorf("ParseSteamID(%q) = %d, expected an error", text, uint64(id))
+		} else if _, ok := err.(*steamworks.ParseError); !ok {
+			t.Errorf("ParseSteamID(%q): error %v is not a *ParseError", text, err)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package steamnet
+
+import "github.com/BenLubar/steamworks"
+
+// Network is the network name returned by Addr.Network.
+const Network = "steam"
+
+// Addr is the network address of a Steam user for the net.Conn and
+// net.PacketConn implementations in this package.
+type Addr steamworks.SteamID
+
+// SteamID returns the Steam ID of the user.
+func (a Addr) SteamID() steamworks.SteamID {
+	return steamworks.SteamID(a)
+}
+
+// Network implements net.Addr.
+func (a Addr) Network() string {
+	return Network
+}
+
+// String implements net.Addr. It returns the Steam ID in the format used by
+// steamworks.SteamID.String.
+func (a Addr) String() string {
+	return steamworks.SteamID(a).String()
+}
@@ -1,9 +1,6 @@
 package steamnet
 
-import (
-	"github.com/BenLubar/steamworks"
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks"
 
 // CloseChannel closes a P2P channel when you're done talking to a user on the
 // specific channel.
@@ -15,9 +12,12 @@ import (
 // Returns true if the channel was successfully closed; otherwise, false if
 // there was no active session or channel with the user.
 func CloseChannel(user steamworks.SteamID, channel int32) bool {
-	defer internal.Cleanup()()
+	return For(steamworks.Global()).CloseChannel(user, channel)
+}
 
-	return internal.SteamAPI_ISteamNetworking_CloseP2PChannelWithUser(internal.SteamID(user), channel)
+// CloseChannel is like the package-level CloseChannel, but uses n.
+func (n *Networking) CloseChannel(user steamworks.SteamID, channel int32) bool {
+	return n.backend().CloseP2PChannelWithUser(user, channel)
 }
 
 // CloseAllChannels should be called when you're done communicating with a user,
@@ -30,7 +30,10 @@ func CloseChannel(user steamworks.SteamID, channel int32) bool {
 // Returns true if the session was successfully closed; otherwise, false if no
 // connection was open with the user.
 func CloseAllChannels(user steamworks.SteamID) bool {
-	defer internal.Cleanup()()
+	return For(steamworks.Global()).CloseAllChannels(user)
+}
 
-	return internal.SteamAPI_ISteamNetworking_CloseP2PSessionWithUser(internal.SteamID(user))
+// CloseAllChannels is like the package-level CloseAllChannels, but uses n.
+func (n *Networking) CloseAllChannels(user steamworks.SteamID) bool {
+	return n.backend().CloseP2PSessionWithUser(user)
 }
@@ -0,0 +1,370 @@
+package steamnet
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// conn is a stream connection to one user on one channel.
+type conn struct {
+	m    *streamMux
+	user steamworks.SteamID
+
+	// writeLock keeps the frames of one Write together.
+	writeLock sync.Mutex
+
+	lock          sync.Mutex
+	changed       chan struct{}
+	dialing       bool
+	buf           [][]byte
+	buffered      int
+	err           error
+	readEOF       bool
+	remoteClosed  bool
+	readClosed    bool
+	writeClosed   bool
+	localClosed   bool
+	readDeadline  time.Time
+	writeDeadline time.Time
+}
+
+func newConn(m *streamMux, user steamworks.SteamID, dialing bool) *conn {
+	return &conn{
+		m:       m,
+		user:    user,
+		changed: make(chan struct{}),
+		dialing: dialing,
+	}
+}
+
+// broadcastLocked wakes up every goroutine waiting for c to change. The
+// caller must hold c.lock.
+func (c *conn) broadcastLocked() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// wait waits until c changes, room is closed, or the deadline passes. A nil
+// room or a zero deadline is ignored.
+func (c *conn) wait(changed, room <-chan struct{}, deadline time.Time) error {
+	var expired <-chan time.Time
+	if !deadline.IsZero() {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return os.ErrDeadlineExceeded
+		}
+		t := time.NewTimer(remaining)
+		defer t.Stop()
+		expired = t.C
+	}
+
+	select {
+	case <-changed:
+	case <-room:
+	case <-expired:
+	}
+
+	return nil
+}
+
+// establish marks a dialed connection as accepted by the remote user. It
+// returns false if the connection was already established.
+func (c *conn) establish() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if !c.dialing {
+		return false
+	}
+
+	c.dialing = false
+	c.broadcastLocked()
+
+	return true
+}
+
+// establishing returns true if c is waiting for the remote user to accept it.
+func (c *conn) establishing() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.dialing
+}
+
+// deliver adds data to the data waiting to be read. It returns false if that
+// would be more than maxReceiveBuffer.
+func (c *conn) deliver(data []byte) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.readClosed || c.localClosed || len(data) == 0 {
+		return true
+	}
+
+	if c.buffered+len(data) > maxReceiveBuffer {
+		return false
+	}
+
+	c.buf = append(c.buf, data)
+	c.buffered += len(data)
+	c.broadcastLocked()
+
+	return true
+}
+
+// remoteFin records that the remote user will not send any more data. If
+// closed is true, the remote user closed the connection entirely.
+func (c *conn) remoteFin(closed bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.readEOF = true
+	if closed {
+		c.remoteClosed = true
+	}
+	c.broadcastLocked()
+}
+
+// fail makes later calls to Read and Write return err.
+func (c *conn) fail(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err == nil {
+		c.err = err
+	}
+	c.broadcastLocked()
+}
+
+// Read reads data sent by the remote user. Once the remote user calls Close
+// or CloseWrite and all of the data sent before that has been read, Read
+// returns io.EOF.
+func (c *conn) Read(p []byte) (int, error) {
+	for {
+		c.lock.Lock()
+		switch {
+		case c.localClosed:
+			c.lock.Unlock()
+			return 0, c.opError("read", net.ErrClosed)
+		case len(c.buf) != 0:
+			n := copy(p, c.buf[0])
+			c.buffered -= n
+			if c.buf[0] = c.buf[0][n:]; len(c.buf[0]) == 0 {
+				c.buf[0] = nil
+				c.buf = c.buf[1:]
+			}
+			c.lock.Unlock()
+			return n, nil
+		case c.err != nil:
+			err := c.err
+			c.lock.Unlock()
+			return 0, c.opError("read", err)
+		case c.readEOF || c.readClosed:
+			c.lock.Unlock()
+			return 0, io.EOF
+		}
+		changed, deadline := c.changed, c.readDeadline
+		c.lock.Unlock()
+
+		if err := c.wait(changed, nil, deadline); err != nil {
+			return 0, c.opError("read", err)
+		}
+	}
+}
+
+// Write sends p to the remote user as reliable messages of up to 1MB each.
+// If too much data is queued to be sent, Write waits for room until the
+// write deadline.
+func (c *conn) Write(p []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	var written int
+	for written < len(p) {
+		chunk := p[written:]
+		if len(chunk) > maxFrameData {
+			chunk = chunk[:maxFrameData]
+		}
+
+		if err := c.send(frameData, chunk); err != nil {
+			return written, c.opError("write", err)
+		}
+
+		written += len(chunk)
+	}
+
+	return written, nil
+}
+
+// send sends a frame, waiting for room in Steam's send buffer. The caller
+// must hold c.writeLock.
+func (c *conn) send(frame byte, data []byte) error {
+	msg := make([]byte, 1+len(data))
+	msg[0] = frame
+	copy(msg[1:], data)
+
+	for {
+		c.lock.Lock()
+		var err error
+		switch {
+		case c.localClosed:
+			err = net.ErrClosed
+		case c.err != nil:
+			err = c.err
+		case c.writeClosed:
+			err = errWriteClosed
+		case c.remoteClosed:
+			err = errClosedByRemote
+		}
+		changed, deadline := c.changed, c.writeDeadline
+		c.lock.Unlock()
+
+		if err != nil {
+			return err
+		}
+
+		// control frames that did not fit in the send buffer go first
+		err = ErrBufferFull
+		if !c.m.controlPending(c.user) {
+			err = c.m.n.SendPacket(c.user, msg, Reliable, c.m.channel)
+		}
+		if err != ErrBufferFull {
+			return err
+		}
+
+		if err := c.wait(changed, c.m.room(), deadline); err != nil {
+			return err
+		}
+	}
+}
+
+// CloseRead discards data sent by the remote user. Later calls to Read
+// return io.EOF.
+func (c *conn) CloseRead() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.localClosed {
+		return c.opError("close", net.ErrClosed)
+	}
+
+	c.readClosed = true
+	c.buf, c.buffered = nil, 0
+	c.broadcastLocked()
+
+	return nil
+}
+
+// CloseWrite tells the remote user that no more data will be sent. Reads on
+// the remote end return io.EOF once they have read all of the data.
+func (c *conn) CloseWrite() error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	c.lock.Lock()
+	if c.localClosed {
+		c.lock.Unlock()
+		return c.opError("close", net.ErrClosed)
+	}
+	if c.writeClosed || c.err != nil {
+		c.lock.Unlock()
+		return nil
+	}
+	c.writeClosed = true
+	c.lock.Unlock()
+
+	if err := c.m.sendControl(c.user, frameFin); err != nil {
+		return c.opError("close", err)
+	}
+
+	return nil
+}
+
+// Close closes the connection. Data already written is still delivered.
+func (c *conn) Close() error {
+	c.lock.Lock()
+	if c.localClosed {
+		c.lock.Unlock()
+		return c.opError("close", net.ErrClosed)
+	}
+	c.localClosed = true
+	c.buf, c.buffered = nil, 0
+	c.broadcastLocked()
+	failed := c.err != nil
+	c.lock.Unlock()
+
+	if failed {
+		// the connection was already removed
+		return nil
+	}
+
+	// wait for a blocked Write to give up so that the close frame is the
+	// last frame sent
+	c.writeLock.Lock()
+	err := c.m.sendControl(c.user, frameClose)
+	c.writeLock.Unlock()
+
+	// a new connection to the user can be dialed right away; if the remote
+	// user has not closed its end yet, the mux waits for it
+	c.lock.Lock()
+	remoteOpen := !c.dialing && !c.remoteClosed
+	c.lock.Unlock()
+	c.m.remove(c, remoteOpen && err == nil)
+
+	if err != nil {
+		return c.opError("close", err)
+	}
+
+	return nil
+}
+
+func (c *conn) opError(op string, err error) error {
+	return &net.OpError{
+		Op:     op,
+		Net:    Network,
+		Source: c.LocalAddr(),
+		Addr:   c.RemoteAddr(),
+		Err:    err,
+	}
+}
+
+func (c *conn) LocalAddr() net.Addr {
+	return Addr(c.m.n.h.Backend().SteamID())
+}
+
+func (c *conn) RemoteAddr() net.Addr {
+	return Addr(c.user)
+}
+
+func (c *conn) SetDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.readDeadline = t
+	c.writeDeadline = t
+	c.broadcastLocked()
+	c.lock.Unlock()
+
+	return nil
+}
+
+func (c *conn) SetReadDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.readDeadline = t
+	c.broadcastLocked()
+	c.lock.Unlock()
+
+	return nil
+}
+
+func (c *conn) SetWriteDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.writeDeadline = t
+	c.broadcastLocked()
+	c.lock.Unlock()
+
+	return nil
+}
@@ -0,0 +1,234 @@
+package steamnet_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamnet"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+// Stream frame types, from stream.go.
+const (
+	frameData    = 0
+	frameOpen    = 1
+	frameOpenAck = 2
+	frameClose   = 4
+	frameReset   = 5
+)
+
+const streamChannel = 3
+
+// dial dials remoteUser, playing the part of the remote user accepting the
+// connection.
+func dial(t *testing.T, fake *steamtest.Fake) net.Conn {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	type dialResult struct {
+		conn net.Conn
+		err  error
+	}
+	ch := make(chan dialResult, 1)
+	go func() {
+		conn, err := steamnet.Dial(ctx, remoteUser, streamChannel)
+		ch <- dialResult{conn, err}
+	}()
+
+	for {
+		for _, p := range fake.TakeSentPackets() {
+			if len(p.Data) == 1 && p.Data[0] == frameOpen {
+				fake.DeliverPacket(remoteUser, streamChannel, []byte{frameOpenAck})
+			}
+		}
+		steamworks.RunCallbacks()
+
+		select {
+		case r := <-ch:
+			if r.err != nil {
+				t.Fatal(r.err)
+			}
+			return r.conn
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+// sentFrames returns the frame types sent since the last call.
+func sentFrames(fake *steamtest.Fake) []byte {
+	var frames []byte
+	for _, p := range fake.TakeSentPackets() {
+		frames = append(frames, p.Data[0])
+	}
+	return frames
+}
+
+func TestConnRedial(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	conn := dial(t, fake)
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if frames := sentFrames(fake); string(frames) != string([]byte{frameClose}) {
+		t.Errorf("sent frames: got %v, expected [%d]", frames, frameClose)
+	}
+
+	conn = dial(t, fake)
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConnCloseBufferFull(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	conn := dial(t, fake)
+
+	fake.SetSendBufferFull(true)
+	if err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	steamworks.RunCallbacks()
+	if frames := sentFrames(fake); len(frames) != 0 {
+		t.Errorf("sent frames with a full buffer: got %v, expected none", frames)
+	}
+
+	fake.SetSendBufferFull(false)
+	steamworks.RunCallbacks()
+	if frames := sentFrames(fake); string(frames) != string([]byte{frameClose}) {
+		t.Errorf("sent frames: got %v, expected [%d]", frames, frameClose)
+	}
+}
+
+func TestConnReceiveBufferFull(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	conn := dial(t, fake)
+	defer conn.Close()
+
+	frame := make([]byte, 1<<20)
+	frame[0] = frameData
+	for i := 0; i < 17; i++ {
+		fake.DeliverPacket(remoteUser, streamChannel, frame)
+	}
+	steamworks.RunCallbacks()
+
+	if frames := sentFrames(fake); string(frames) != string([]byte{frameReset}) {
+		t.Errorf("sent frames: got %v, expected [%d]", frames, frameReset)
+	}
+
+	n, err := io.Copy(io.Discard, conn)
+	if !errors.Is(err, steamnet.ErrReceiveBufferFull) {
+		t.Errorf("read error: got %v, expected %v", err, steamnet.ErrReceiveBufferFull)
+	}
+	if n != 16*(1<<20-1) {
+		t.Errorf("read %d bytes, expected %d", n, 16*(1<<20-1))
+	}
+}
+
+func TestConnWriteBufferFull(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	conn := dial(t, fake)
+	defer conn.Close()
+
+	fake.SetSendBufferFull(true)
+	written := make(chan error, 1)
+	go func() {
+		_, err := conn.Write([]byte("hello"))
+		written <- err
+	}()
+
+	// Without a callback tick, the write does not try again, even once
+	// there is room.
+	time.Sleep(10 * time.Millisecond)
+	fake.SetSendBufferFull(false)
+	select {
+	case err := <-written:
+		t.Fatalf("write returned %v before a callback tick", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	if frames := sentFrames(fake); len(frames) != 0 {
+		t.Errorf("sent frames before a callback tick: got %v, expected none", frames)
+	}
+
+	steamworks.RunCallbacks()
+	select {
+	case err := <-written:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("write did not return after a callback tick")
+	}
+	if frames := sentFrames(fake); string(frames) != string([]byte{frameData}) {
+		t.Errorf("sent frames: got %v, expected [%d]", frames, frameData)
+	}
+}
+
+func TestDialAfterShutdown(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	client, err := steamworks.NewClient(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := steamnet.For(client)
+
+	conn := dial(t, fake)
+	l, err := n.NewListener(streamChannel + 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client.Shutdown()
+
+	if _, err := conn.Read(make([]byte, 1)); err == nil {
+		t.Error("read after shutdown succeeded")
+	}
+	if _, err := l.Accept(); !errors.Is(err, net.ErrClosed) {
+		t.Errorf("accept after shutdown: got %v, expected %v", err, net.ErrClosed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := n.Dial(ctx, remoteUser, streamChannel); err == nil || errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Dial after shutdown: got %v, expected it to fail right away", err)
+	}
+	if _, err := n.NewListener(streamChannel); err == nil {
+		t.Error("NewListener after shutdown succeeded")
+	}
+}
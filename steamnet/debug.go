@@ -5,7 +5,6 @@ import (
 	"net"
 
 	"github.com/BenLubar/steamworks"
-	"github.com/BenLubar/steamworks/internal"
 )
 
 // SessionState is the current connection state to a specified user, returned
@@ -48,27 +47,30 @@ type SessionState struct {
 //
 // Returns nil if there was no open session with the specified user.
 func GetSessionState(user steamworks.SteamID) *SessionState {
-	defer internal.Cleanup()()
+	return For(steamworks.Global()).GetSessionState(user)
+}
 
-	var state internal.P2PSessionState
-	if !internal.SteamAPI_ISteamNetworking_GetP2PSessionState(internal.SteamID(user), &state) {
+// GetSessionState is like the package-level GetSessionState, but uses n.
+func (n *Networking) GetSessionState(user steamworks.SteamID) *SessionState {
+	state, ok := n.backend().GetP2PSessionState(user)
+	if !ok {
 		return nil
 	}
 
 	remoteIP := make(net.IP, 4)
-	binary.BigEndian.PutUint32(remoteIP, uint32(state.NRemoteIP))
+	binary.BigEndian.PutUint32(remoteIP, state.RemoteIP)
 	if remoteIP.IsUnspecified() {
 		remoteIP = nil
 	}
 
 	return &SessionState{
-		LastError:            toError(internal.EP2PSessionError(state.EP2PSessionError)),
+		LastError:            toError(state.SessionError),
 		RemoteIP:             remoteIP,
-		RemotePort:           int(state.NRemotePort),
-		BytesQueuedForSend:   int(state.NBytesQueuedForSend),
-		PacketsQueuedForSend: int(state.NPacketsQueuedForSend),
-		ConnectionActive:     state.BConnectionActive != 0,
-		Connecting:           state.BConnecting != 0,
-		UsingRelay:           state.BUsingRelay != 0,
+		RemotePort:           int(state.RemotePort),
+		BytesQueuedForSend:   int(state.BytesQueuedForSend),
+		PacketsQueuedForSend: int(state.PacketsQueuedForSend),
+		ConnectionActive:     state.ConnectionActive,
+		Connecting:           state.Connecting,
+		UsingRelay:           state.UsingRelay,
 	}
 }
@@ -0,0 +1,222 @@
+package steamnet
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// acceptBacklog is the number of connections a listener holds until they are
+// accepted. Connections beyond that are refused.
+const acceptBacklog = 64
+
+// Dial opens a stream connection to user on channel. The remote user must
+// have a listener for the channel, created by NewListener.
+//
+// The connection is like a TCP connection: data is delivered reliably and in
+// order, without message boundaries. It is sent as reliable messages; writes
+// larger than the 1MB message limit are split. The returned net.Conn also has
+// CloseRead and CloseWrite methods, like *net.TCPConn.
+//
+// Up to 16MB of received data is held until it is read. If the remote user
+// sends more than that, the connection is reset, and reads fail with an error
+// wrapping ErrReceiveBufferFull.
+//
+// If packets cannot get through to the user, as reported to
+// RegisterErrorCallback, reads and writes fail with an error wrapping
+// ErrTimeout, ErrDestinationNotLoggedIn, or another Error.
+//
+// Packets for stream connections are read once per call to
+// steamworks.RunCallbacks, as for HandlePackets, so the callback goroutine
+// must be running or RunCallbacks must be called regularly.
+//
+// A channel used for stream connections should not be used with ReadPacket
+// or ListenPacket. Only one connection to each user can be open on a
+// channel.
+//
+// When the handle is shut down, its connections and listeners fail, and
+// later calls to Dial and NewListener return an error.
+func Dial(ctx context.Context, user steamworks.SteamID, channel int32) (net.Conn, error) {
+	return For(steamworks.Global()).Dial(ctx, user, channel)
+}
+
+// Dial is like the package-level Dial, but uses n.
+func (n *Networking) Dial(ctx context.Context, user steamworks.SteamID, channel int32) (net.Conn, error) {
+	n.streamLock.Lock()
+	if n.streamsClosed {
+		n.streamLock.Unlock()
+		// the local Steam ID cannot be read after shutdown
+		return nil, &net.OpError{Op: "dial", Net: Network, Addr: Addr(user), Err: errShutdown}
+	}
+	m := n.streamMuxLocked(channel)
+	if m.conns[user] != nil {
+		n.streamLock.Unlock()
+		return nil, dialError(n, user, ErrAlreadyConnected)
+	}
+	c := newConn(m, user, true)
+	m.conns[user] = c
+	n.streamLock.Unlock()
+
+	if err := m.sendControl(user, frameOpen); err != nil {
+		m.remove(c, false)
+		return nil, dialError(n, user, err)
+	}
+
+	for {
+		c.lock.Lock()
+		dialing, err, changed := c.dialing, c.err, c.changed
+		c.lock.Unlock()
+
+		if err != nil {
+			return nil, dialError(n, user, err)
+		}
+		if !dialing {
+			return c, nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			_ = c.Close()
+			return nil, dialError(n, user, ctx.Err())
+		}
+	}
+}
+
+func dialError(n *Networking, user steamworks.SteamID, err error) error {
+	return &net.OpError{
+		Op:     "dial",
+		Net:    Network,
+		Source: Addr(n.h.Backend().SteamID()),
+		Addr:   Addr(user),
+		Err:    err,
+	}
+}
+
+// NewListener returns a listener for stream connections on channel. See Dial
+// for details about the connections.
+//
+// The listener accepts P2P session requests from every user, as if by
+// Listen, until it is closed. If a SessionManager is open, only the requests
+// its SessionOptions allow are accepted. Only one listener can exist for a
+// channel at a time.
+func NewListener(channel int32) (net.Listener, error) {
+	return For(steamworks.Global()).NewListener(channel)
+}
+
+// NewListener is like the package-level NewListener, but uses n.
+func (n *Networking) NewListener(channel int32) (net.Listener, error) {
+	n.streamLock.Lock()
+	defer n.streamLock.Unlock()
+
+	if n.streamsClosed {
+		// the local Steam ID cannot be read after shutdown
+		return nil, &net.OpError{Op: "listen", Net: Network, Err: errShutdown}
+	}
+	m := n.streamMuxLocked(channel)
+	if m.listener != nil {
+		return nil, &net.OpError{
+			Op:     "listen",
+			Net:    Network,
+			Source: Addr(n.h.Backend().SteamID()),
+			Err:    ErrListening,
+		}
+	}
+
+	l := &listener{
+		m:       m,
+		pending: make(chan *conn, acceptBacklog),
+		done:    make(chan struct{}),
+	}
+	l.reg = n.Listen(func(steamworks.SteamID) bool { return true })
+	m.listener = l
+
+	return l, nil
+}
+
+type listener struct {
+	m   *streamMux
+	reg steamworks.Registration
+
+	pending chan *conn
+
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// enqueue adds c to the connections waiting to be accepted. The caller must
+// hold n.streamLock. It returns false if the backlog is full.
+func (l *listener) enqueue(c *conn) bool {
+	select {
+	case l.pending <- c:
+		return true
+	default:
+		return false
+	}
+}
+
+func (l *listener) Accept() (net.Conn, error) {
+	select {
+	case <-l.done:
+		return nil, l.opError(net.ErrClosed)
+	default:
+	}
+
+	select {
+	case c := <-l.pending:
+		return c, nil
+	case <-l.done:
+		return nil, l.opError(net.ErrClosed)
+	}
+}
+
+func (l *listener) Close() error {
+	err := l.opError(net.ErrClosed)
+
+	l.closeOnce.Do(func() {
+		err = nil
+
+		n := l.m.n
+		n.streamLock.Lock()
+		if l.m.listener == l {
+			l.m.listener = nil
+			l.m.releaseLocked()
+		}
+		n.streamLock.Unlock()
+
+		l.shutdown()
+	})
+
+	return err
+}
+
+// shutdown stops accepting connections and closes the ones that were not
+// accepted yet.
+func (l *listener) shutdown() {
+	close(l.done)
+	l.reg.Unregister()
+
+	for {
+		select {
+		case c := <-l.pending:
+			_ = c.Close()
+		default:
+			return
+		}
+	}
+}
+
+func (l *listener) opError(err error) error {
+	return &net.OpError{
+		Op:     "accept",
+		Net:    Network,
+		Source: l.Addr(),
+		Err:    err,
+	}
+}
+
+func (l *listener) Addr() net.Addr {
+	return Addr(l.m.n.h.Backend().SteamID())
+}
@@ -11,9 +11,15 @@ import (
 // All queued packets unsent at this point will be dropped, further attempts to
 // send will retry making the connection (but will be dropped if we fail again).
 func RegisterErrorCallback(f func(steamworks.SteamID, error)) steamworks.Registration {
-	return internal.RegisterCallback_P2PSessionConnectFail(func(data *internal.P2PSessionConnectFail, _ bool) {
-		f(steamworks.SteamID(data.SteamIDRemote.Get()), toError(internal.EP2PSessionError(data.EP2PSessionError)))
-	}, 0)
+	return For(steamworks.Global()).RegisterErrorCallback(f)
+}
+
+// RegisterErrorCallback is like the package-level RegisterErrorCallback, but
+// uses n.
+func (n *Networking) RegisterErrorCallback(f func(steamworks.SteamID, error)) steamworks.Registration {
+	return n.backend().OnP2PSessionConnectFail(func(user steamworks.SteamID, code internal.EP2PSessionError) {
+		f(user, toError(code))
+	})
 }
 
 // Error represents a connection error in the Steam P2P API.
@@ -1,15 +1,15 @@
 // Package steamnet wraps Steam's peer-to-peer networking API.
 //
-// This package works in both clients and servers.
+// This package works in both clients and servers. The package-level functions
+// use steamworks.Global(). A process that runs both a game client and a game
+// server should use For with each steamworks.Client and steamworks.Server
+// instead.
 //
 // See the Steam Networking documentation for more details.
 // <https://partner.steamgames.com/doc/features/multiplayer/networking>
 package steamnet
 
-import (
-	"github.com/BenLubar/steamworks"
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks"
 
 // Listen registers a function to handle connection requests.
 //
@@ -17,14 +17,21 @@ import (
 // connection to be accepted if it returns true.
 //
 // Multiple listeners may be registered simultaneously, and connections will be
-// accepted if any listener returns true.
+// accepted if any listener returns true. While a SessionManager is open, a
+// connection is only accepted if its SessionOptions also allow it.
 func Listen(accept func(steamworks.SteamID) bool) steamworks.Registration {
-	return internal.RegisterCallback_P2PSessionRequest(func(data *internal.P2PSessionRequest, _ bool) {
-		id := internal.SteamID(data.SteamIDRemote.Get())
-		if accept(steamworks.SteamID(id)) {
-			internal.SteamAPI_ISteamNetworking_AcceptP2PSessionWithUser(id)
+	return For(steamworks.Global()).Listen(accept)
+}
+
+// Listen is like the package-level Listen, but uses n.
+func (n *Networking) Listen(accept func(steamworks.SteamID) bool) steamworks.Registration {
+	networking := n.backend()
+
+	return networking.OnP2PSessionRequest(func(user steamworks.SteamID) {
+		if accept(user) && n.allowSession(user) {
+			networking.AcceptP2PSessionWithUser(user)
 		}
-	}, 0)
+	})
 }
 
 // SetAllowPacketRelay allows or disallows P2P connections to fall back to
@@ -37,5 +44,11 @@ func Listen(accept func(steamworks.SteamID) bool) steamworks.Registration {
 //
 // P2P packet relay is allowed by default.
 func SetAllowPacketRelay(allow bool) {
-	internal.SteamAPI_ISteamNetworking_AllowP2PPacketRelay(allow)
+	For(steamworks.Global()).SetAllowPacketRelay(allow)
+}
+
+// SetAllowPacketRelay is like the package-level SetAllowPacketRelay, but uses
+// n.
+func (n *Networking) SetAllowPacketRelay(allow bool) {
+	n.backend().AllowP2PPacketRelay(allow)
 }
@@ -0,0 +1,85 @@
+package steamnet
+
+import (
+	"sync"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// Networking is the peer-to-peer networking API of a game client or game
+// server. The package-level functions use the Networking for
+// steamworks.Global().
+type Networking struct {
+	h steamworks.Handle
+
+	channelLocks sync.Map
+	recv         *receiver
+
+	streamLock    sync.Mutex
+	streams       map[int32]*streamMux
+	streamsClosed bool
+
+	sessionLock sync.Mutex
+	sessions    *SessionManager
+}
+
+var networksLock sync.Mutex
+var networks = make(map[steamworks.Handle]*Networking)
+
+// For returns the peer-to-peer networking API for h, such as a
+// *steamworks.Client or a *steamworks.Server.
+func For(h steamworks.Handle) *Networking {
+	networksLock.Lock()
+	n := networks[h]
+	if n != nil {
+		networksLock.Unlock()
+		return n
+	}
+
+	n = &Networking{h: h}
+	n.recv = newReceiver(n)
+	networks[h] = n
+	networksLock.Unlock()
+
+	h.OnShutdown(func() {
+		networksLock.Lock()
+		if networks[h] == n {
+			delete(networks, h)
+		}
+		networksLock.Unlock()
+
+		if m := n.sessionManager(); m != nil {
+			m.Close()
+		}
+		n.closeStreams()
+		n.recv.shutdown()
+	})
+
+	return n
+}
+
+// sessionManager returns the open SessionManager, or nil if there is none.
+func (n *Networking) sessionManager() *SessionManager {
+	n.sessionLock.Lock()
+	defer n.sessionLock.Unlock()
+
+	return n.sessions
+}
+
+// allowSession returns false if the open SessionManager, if there is one,
+// does not allow a session with user.
+func (n *Networking) allowSession(user steamworks.SteamID) bool {
+	m := n.sessionManager()
+	return m == nil || m.accept(user)
+}
+
+// touch records activity with user for the SessionManager, if there is one.
+func (n *Networking) touch(user steamworks.SteamID, sent bool) {
+	if m := n.sessionManager(); m != nil {
+		m.touch(user, sent)
+	}
+}
+
+func (n *Networking) backend() steamworks.NetworkingBackend {
+	return n.h.Backend().Networking()
+}
@@ -0,0 +1,247 @@
+package steamnet
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// ListenPacket returns a net.PacketConn that reads packets sent to the local
+// user on channel and sends packets with the given send type.
+//
+// The addresses used by the PacketConn are Addr values. WriteTo also accepts
+// *Addr. Errors from WriteTo and ReadFrom are *net.OpError values, and the
+// ErrBufferFull and ErrPacketTooLarge errors returned by SendPacket are
+// available as the Err field.
+//
+// The PacketConn does not accept sessions; use Listen for that. Close closes
+// the channel with every user the PacketConn has sent packets to or received
+// packets from.
+//
+// Only one reader should use a channel at a time. Packets read by ReadPacket
+// on the same channel are not seen by the PacketConn.
+//
+// Like Receive, a blocked ReadFrom is woken up by steamworks.RunCallbacks. If
+// the Steamworks SDK is not available in this build and there is no read
+// deadline, ReadFrom returns steamworks.ErrUnsupported instead of blocking
+// forever.
+func ListenPacket(channel int32, sendType Reliability) net.PacketConn {
+	return For(steamworks.Global()).ListenPacket(channel, sendType)
+}
+
+// ListenPacket is like the package-level ListenPacket, but uses n.
+func (n *Networking) ListenPacket(channel int32, sendType Reliability) net.PacketConn {
+	return &packetConn{
+		n:        n,
+		channel:  channel,
+		sendType: sendType,
+		peers:    make(map[steamworks.SteamID]bool),
+		readWake: make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+}
+
+type packetConn struct {
+	n        *Networking
+	channel  int32
+	sendType Reliability
+
+	lock          sync.Mutex
+	peers         map[steamworks.SteamID]bool
+	readDeadline  time.Time
+	writeDeadline time.Time
+	// readWake is closed and replaced when the read deadline changes, so
+	// that a blocked ReadFrom uses the new deadline.
+	readWake chan struct{}
+
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func (c *packetConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	r := c.n.recv
+
+	for {
+		select {
+		case <-c.closed:
+			return 0, nil, c.opError("read", nil, net.ErrClosed)
+		default:
+		}
+
+		n, user, err := c.n.ReadPacketInto(p, c.channel)
+		if err == io.ErrShortBuffer {
+			// like UDP, discard the part of the packet that does not fit
+			var data []byte
+			data, user = c.n.ReadPacket(c.channel)
+			n = copy(p, data)
+		}
+		if user != 0 {
+			c.addPeer(user)
+			return n, Addr(user), nil
+		}
+
+		c.lock.Lock()
+		deadline, wake := c.readDeadline, c.readWake
+		c.lock.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !deadline.IsZero() {
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return 0, nil, c.opError("read", nil, os.ErrDeadlineExceeded)
+			}
+			timer = time.NewTimer(remaining)
+			timeout = timer.C
+		} else if !steamworks.Supported() {
+			return 0, nil, c.opError("read", nil, steamworks.ErrUnsupported)
+		}
+
+		w := make(chan Packet, 1)
+		if !r.addWaiter(c.channel, w) {
+			return 0, nil, c.opError("read", nil, errShutdown)
+		}
+
+		var pkt Packet
+		select {
+		case pkt = <-w:
+		case <-r.done:
+		case <-c.closed:
+		case <-wake:
+		case <-timeout:
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+
+		if pkt.User == 0 && !r.removeWaiter(c.channel, w) {
+			// a packet was delivered while we were giving up
+			pkt = <-w
+		}
+		if pkt.User != 0 {
+			n, user := copy(p, pkt.Data), pkt.User
+			pkt.Release()
+			c.addPeer(user)
+			return n, Addr(user), nil
+		}
+
+		select {
+		case <-r.done:
+			return 0, nil, c.opError("read", nil, errShutdown)
+		default:
+		}
+		// closed, timed out, or the deadline changed; the next iteration
+		// decides which
+	}
+}
+
+func (c *packetConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	var user steamworks.SteamID
+	switch a := addr.(type) {
+	case Addr:
+		user = a.SteamID()
+	case *Addr:
+		user = a.SteamID()
+	default:
+		return 0, c.opError("write", addr, net.InvalidAddrError("steamnet: address is not a steamnet.Addr"))
+	}
+
+	select {
+	case <-c.closed:
+		return 0, c.opError("write", addr, net.ErrClosed)
+	default:
+	}
+
+	c.lock.Lock()
+	deadline := c.writeDeadline
+	c.lock.Unlock()
+	if !deadline.IsZero() && !time.Now().Before(deadline) {
+		return 0, c.opError("write", addr, os.ErrDeadlineExceeded)
+	}
+
+	if err := c.n.SendPacket(user, p, c.sendType, c.channel); err != nil {
+		return 0, c.opError("write", addr, err)
+	}
+	c.addPeer(user)
+
+	return len(p), nil
+}
+
+func (c *packetConn) addPeer(user steamworks.SteamID) {
+	c.lock.Lock()
+	if c.peers != nil {
+		c.peers[user] = true
+	}
+	c.lock.Unlock()
+}
+
+func (c *packetConn) opError(op string, addr net.Addr, err error) error {
+	return &net.OpError{
+		Op:     op,
+		Net:    Network,
+		Source: c.LocalAddr(),
+		Addr:   addr,
+		Err:    err,
+	}
+}
+
+func (c *packetConn) Close() error {
+	err := c.opError("close", nil, net.ErrClosed)
+
+	c.closeOnce.Do(func() {
+		err = nil
+		close(c.closed)
+
+		c.lock.Lock()
+		peers := c.peers
+		c.peers = nil
+		c.lock.Unlock()
+
+		for user := range peers {
+			c.n.CloseChannel(user, c.channel)
+		}
+	})
+
+	return err
+}
+
+func (c *packetConn) LocalAddr() net.Addr {
+	return Addr(c.n.h.Backend().SteamID())
+}
+
+func (c *packetConn) SetDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.setReadDeadlineLocked(t)
+	c.writeDeadline = t
+	c.lock.Unlock()
+
+	return nil
+}
+
+func (c *packetConn) SetReadDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.setReadDeadlineLocked(t)
+	c.lock.Unlock()
+
+	return nil
+}
+
+// setReadDeadlineLocked sets the read deadline and wakes up a blocked
+// ReadFrom. The caller must hold c.lock.
+func (c *packetConn) setReadDeadlineLocked(t time.Time) {
+	c.readDeadline = t
+	close(c.readWake)
+	c.readWake = make(chan struct{})
+}
+
+func (c *packetConn) SetWriteDeadline(t time.Time) error {
+	c.lock.Lock()
+	c.writeDeadline = t
+	c.lock.Unlock()
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package steamnet_test
+
+import (
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamnet"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+const (
+	localUser  steamworks.SteamID = 76561197960287930
+	remoteUser steamworks.SteamID = 76561197960287931
+)
+
+type readResult struct {
+	n    int
+	addr net.Addr
+	err  error
+}
+
+func startRead(conn net.PacketConn, p []byte) <-chan readResult {
+	ch := make(chan readResult, 1)
+	go func() {
+		n, addr, err := conn.ReadFrom(p)
+		ch <- readResult{n, addr, err}
+	}()
+	return ch
+}
+
+// waitRead calls RunCallbacks until the read finishes.
+func waitRead(t *testing.T, ch <-chan readResult) readResult {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		steamworks.RunCallbacks()
+
+		select {
+		case r := <-ch:
+			return r
+		case <-timeout:
+			t.Fatal("ReadFrom did not return")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestPacketConnReadFrom(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	conn := steamnet.ListenPacket(1, steamnet.Reliable)
+	defer conn.Close()
+
+	t.Run("packet", func(t *testing.T) {
+		p := make([]byte, 4)
+		ch := startRead(conn, p)
+		fake.DeliverPacket(remoteUser, 1, []byte("ping pong"))
+
+		r := waitRead(t, ch)
+		if r.err != nil {
+			t.Fatal(r.err)
+		}
+		if r.addr != steamnet.Addr(remoteUser) || string(p[:r.n]) != "ping" {
+			t.Errorf("got (%q, %v), expected (\"ping\", %v)", p[:r.n], r.addr, remoteUser)
+		}
+	})
+
+	t.Run("deadline", func(t *testing.T) {
+		ch := startRead(conn, make([]byte, 4))
+		time.Sleep(10 * time.Millisecond)
+		conn.SetReadDeadline(time.Now())
+		defer conn.SetReadDeadline(time.Time{})
+
+		if r := waitRead(t, ch); !errors.Is(r.err, os.ErrDeadlineExceeded) {
+			t.Errorf("got error %v, expected %v", r.err, os.ErrDeadlineExceeded)
+		}
+	})
+
+	t.Run("close", func(t *testing.T) {
+		ch := startRead(conn, make([]byte, 4))
+		time.Sleep(10 * time.Millisecond)
+		conn.Close()
+
+		if r := waitRead(t, ch); !errors.Is(r.err, net.ErrClosed) {
+			t.Errorf("got error %v, expected %v", r.err, net.ErrClosed)
+		}
+	})
+}
+
+func TestPacketConnUnsupported(t *testing.T) {
+	if steamworks.Supported() {
+		t.Skip("the Steamworks SDK is available in this build")
+	}
+
+	conn := steamnet.ListenPacket(1, steamnet.Reliable)
+	defer conn.Close()
+
+	if _, _, err := conn.ReadFrom(make([]byte, 4)); !errors.Is(err, steamworks.ErrUnsupported) {
+		t.Errorf("got error %v, expected %v", err, steamworks.ErrUnsupported)
+	}
+}
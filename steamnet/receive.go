@@ -0,0 +1,427 @@
+package steamnet
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// Packet is a P2P packet returned by Receive or sent on a channel returned by
+// SubscribePackets.
+type Packet struct {
+	// User is the sender of the packet.
+	User steamworks.SteamID
+	// Channel is the channel the packet was sent on.
+	Channel int32
+	// Data is the contents of the packet.
+	Data []byte
+
+	buf *[]byte
+}
+
+// Release returns the buffer holding the packet's data to a pool so that it
+// can be reused for a later packet. Data must not be used after calling
+// Release. Calling Release is optional; if it is not called, the buffer is
+// garbage collected as usual.
+func (p *Packet) Release() {
+	if p.buf != nil {
+		bufferPool.Put(p.buf)
+		p.buf = nil
+	}
+	p.Data = nil
+}
+
+// bufferPool holds buffers for received packets. A buffer that was grown for
+// a large reliable packet keeps its size.
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		buf := make([]byte, 1200)
+		return &buf
+	},
+}
+
+func pooledPacket(user steamworks.SteamID, channel int32, data []byte) Packet {
+	buf := bufferPool.Get().(*[]byte)
+	if cap(*buf) < len(data) {
+		*buf = make([]byte, len(data))
+	}
+	*buf = (*buf)[:cap(*buf)]
+
+	return Packet{
+		User:    user,
+		Channel: channel,
+		Data:    (*buf)[:copy(*buf, data)],
+		buf:     buf,
+	}
+}
+
+// Receive waits for a packet on channel and returns it. It returns an error
+// if ctx is done first, or if the Steamworks API is shut down.
+//
+// Receive checks for a packet immediately, and after that once per call to
+// steamworks.RunCallbacks, so it only blocks for long if the callback
+// goroutine is running or RunCallbacks is called by another goroutine.
+//
+// If a channel has functions registered by HandlePackets or SubscribePackets,
+// each packet is also delivered to them.
+func Receive(ctx context.Context, channel int32) (Packet, error) {
+	return For(steamworks.Global()).Receive(ctx, channel)
+}
+
+// Receive is like the package-level Receive, but uses n.
+func (n *Networking) Receive(ctx context.Context, channel int32) (Packet, error) {
+	r := n.recv
+
+	buf := bufferPool.Get().(*[]byte)
+	*buf = (*buf)[:cap(*buf)]
+	for {
+		size, user, err := n.ReadPacketInto(*buf, channel)
+		if err == io.ErrShortBuffer {
+			*buf = make([]byte, size)
+			continue
+		}
+		if user != 0 {
+			return Packet{User: user, Channel: channel, Data: (*buf)[:size], buf: buf}, nil
+		}
+		bufferPool.Put(buf)
+		break
+	}
+
+	w := make(chan Packet, 1)
+	if !r.addWaiter(channel, w) {
+		return Packet{}, errShutdown
+	}
+
+	select {
+	case p := <-w:
+		return p, nil
+	case <-r.done:
+	case <-ctx.Done():
+	}
+
+	if !r.removeWaiter(channel, w) {
+		// a packet was delivered while we were giving up
+		return <-w, nil
+	}
+
+	if ctx.Err() != nil {
+		return Packet{}, ctx.Err()
+	}
+	return Packet{}, errShutdown
+}
+
+// HandlePackets registers f to be called with each packet received on
+// channel. The receiver polls every channel with a registered function once
+// per call to steamworks.RunCallbacks, and f is called on the goroutine that
+// called RunCallbacks.
+//
+// The packet's Data is only valid until f returns; f must copy it to keep it.
+// Release must not be called on the packet.
+func HandlePackets(channel int32, f func(Packet)) steamworks.Registration {
+	return For(steamworks.Global()).HandlePackets(channel, f)
+}
+
+// HandlePackets is like the package-level HandlePackets, but uses n.
+func (n *Networking) HandlePackets(channel int32, f func(Packet)) steamworks.Registration {
+	reg := &packetRegistration{channel: channel, handle: f}
+	n.recv.add(reg)
+	return reg
+}
+
+// SubscribePackets returns a Go channel that receives the packets received on
+// channel. See HandlePackets for when the channel is polled.
+//
+// Buffer is the capacity of the Go channel. If it is full, new packets are
+// dropped. Call Release on each packet when done with it to reuse its buffer.
+//
+// Calling Unregister on the returned Registration stops delivery and closes
+// the Go channel.
+func SubscribePackets(channel int32, buffer int) (<-chan Packet, steamworks.Registration) {
+	return For(steamworks.Global()).SubscribePackets(channel, buffer)
+}
+
+// SubscribePackets is like the package-level SubscribePackets, but uses n.
+func (n *Networking) SubscribePackets(channel int32, buffer int) (<-chan Packet, steamworks.Registration) {
+	ch := make(chan Packet, buffer)
+	reg := &packetRegistration{channel: channel, ch: ch}
+	n.recv.add(reg)
+	return ch, reg
+}
+
+// receiver polls the channels that have registrations or waiters once per
+// callback tick.
+type receiver struct {
+	n *Networking
+
+	lock     sync.Mutex
+	channels map[int32]*receiverChannel
+	tick     steamworks.Registration
+	done     chan struct{}
+
+	// polling is held while a tick is polling, so that ticks from several
+	// goroutines do not poll at the same time.
+	polling sync.Mutex
+	scratch []byte
+}
+
+type receiverChannel struct {
+	regs    []*packetRegistration
+	waiters []chan<- Packet
+}
+
+type packetRegistration struct {
+	r       *receiver
+	channel int32
+
+	handle func(Packet)
+
+	lock   sync.Mutex
+	ch     chan Packet
+	closed bool
+}
+
+func newReceiver(n *Networking) *receiver {
+	return &receiver{
+		n:        n,
+		channels: make(map[int32]*receiverChannel),
+		done:     make(chan struct{}),
+		scratch:  make([]byte, 1200),
+	}
+}
+
+// channelLocked returns the state of channel, starting polling if needed.
+// The caller must hold r.lock. It returns nil if r was shut down.
+func (r *receiver) channelLocked(channel int32) *receiverChannel {
+	if r.channels == nil {
+		return nil
+	}
+
+	rc := r.channels[channel]
+	if rc == nil {
+		rc = &receiverChannel{}
+		r.channels[channel] = rc
+	}
+
+	if r.tick == nil {
+		r.tick = steamworks.OnRunCallbacks(r.poll)
+	}
+
+	return rc
+}
+
+// releaseLocked forgets channel if nothing is waiting for it, and stops
+// polling if no channels are left. The caller must hold r.lock.
+func (r *receiver) releaseLocked(channel int32) {
+	if rc := r.channels[channel]; rc != nil && len(rc.regs) == 0 && len(rc.waiters) == 0 {
+		delete(r.channels, channel)
+	}
+
+	if len(r.channels) == 0 && r.tick != nil {
+		r.tick.Unregister()
+		r.tick = nil
+	}
+}
+
+func (r *receiver) add(reg *packetRegistration) {
+	reg.r = r
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rc := r.channelLocked(reg.channel)
+	if rc == nil {
+		reg.closeChannel()
+		return
+	}
+
+	// copy so that a tick in progress is not affected
+	rc.regs = append(rc.regs[:len(rc.regs):len(rc.regs)], reg)
+}
+
+func (reg *packetRegistration) Unregister() {
+	r := reg.r
+
+	r.lock.Lock()
+	if rc := r.channels[reg.channel]; rc != nil {
+		for i, other := range rc.regs {
+			if other == reg {
+				rc.regs = append(rc.regs[:i:i], rc.regs[i+1:]...)
+				break
+			}
+		}
+		r.releaseLocked(reg.channel)
+	}
+	r.lock.Unlock()
+
+	reg.closeChannel()
+}
+
+func (reg *packetRegistration) closeChannel() {
+	if reg.ch == nil {
+		return
+	}
+
+	reg.lock.Lock()
+	if !reg.closed {
+		reg.closed = true
+		close(reg.ch)
+	}
+	reg.lock.Unlock()
+}
+
+func (reg *packetRegistration) deliver(p Packet) {
+	if reg.handle != nil {
+		reg.handle(p)
+		return
+	}
+
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+
+	if reg.closed {
+		return
+	}
+
+	p = pooledPacket(p.User, p.Channel, p.Data)
+	select {
+	case reg.ch <- p:
+	default:
+		p.Release()
+	}
+}
+
+func (r *receiver) addWaiter(channel int32, w chan<- Packet) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rc := r.channelLocked(channel)
+	if rc == nil {
+		return false
+	}
+
+	rc.waiters = append(rc.waiters, w)
+	return true
+}
+
+// removeWaiter removes w, returning false if a packet was already delivered
+// to it.
+func (r *receiver) removeWaiter(channel int32, w chan<- Packet) bool {
+	// pollChannel holds the channel's lock from reading a packet until it
+	// has chosen a waiter for it.
+	lock := r.n.channelLock(channel)
+	lock.Lock()
+	defer lock.Unlock()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	rc := r.channels[channel]
+	if rc == nil {
+		return r.channels == nil
+	}
+
+	for i, other := range rc.waiters {
+		if other == w {
+			rc.waiters = append(rc.waiters[:i], rc.waiters[i+1:]...)
+			r.releaseLocked(channel)
+			return true
+		}
+	}
+
+	return false
+}
+
+// poll reads the packets available on every channel that has registrations
+// or waiters. Each waiter receives one packet.
+func (r *receiver) poll() {
+	if !r.polling.TryLock() {
+		return
+	}
+	defer r.polling.Unlock()
+
+	r.lock.Lock()
+	channels := make([]int32, 0, len(r.channels))
+	for channel := range r.channels {
+		channels = append(channels, channel)
+	}
+	r.lock.Unlock()
+
+	for _, channel := range channels {
+		r.pollChannel(channel)
+	}
+}
+
+// pollChannel delivers the packets available on channel. The caller must
+// hold r.polling, which also protects r.scratch.
+func (r *receiver) pollChannel(channel int32) {
+	// The packet is read and its waiter chosen with the channel's lock held
+	// so that a waiter that gives up cannot leave the packet with nobody to
+	// deliver it to. Ticks polling other channels are not held up.
+	lock := r.n.channelLock(channel)
+
+	for {
+		lock.Lock()
+
+		r.lock.Lock()
+		rc := r.channels[channel]
+		r.lock.Unlock()
+		if rc == nil {
+			lock.Unlock()
+			return
+		}
+
+		size, user, err := r.n.readPacketLocked(r.scratch, channel)
+		if err == io.ErrShortBuffer {
+			r.scratch = make([]byte, size)
+			lock.Unlock()
+			continue
+		}
+		if user == 0 {
+			lock.Unlock()
+			return
+		}
+
+		r.lock.Lock()
+		var regs []*packetRegistration
+		var w chan<- Packet
+		if rc = r.channels[channel]; rc != nil {
+			regs = rc.regs
+			if len(rc.waiters) != 0 {
+				w = rc.waiters[0]
+				rc.waiters = rc.waiters[1:]
+				r.releaseLocked(channel)
+			}
+		}
+		r.lock.Unlock()
+		lock.Unlock()
+
+		p := Packet{User: user, Channel: channel, Data: r.scratch[:size]}
+		for _, reg := range regs {
+			reg.deliver(p)
+		}
+		if w != nil {
+			w <- pooledPacket(user, channel, p.Data)
+		}
+	}
+}
+
+// shutdown stops polling and wakes up every call to Receive.
+func (r *receiver) shutdown() {
+	r.lock.Lock()
+	channels := r.channels
+	r.channels = nil
+	if r.tick != nil {
+		r.tick.Unregister()
+		r.tick = nil
+	}
+	close(r.done)
+	r.lock.Unlock()
+
+	for _, rc := range channels {
+		for _, reg := range rc.regs {
+			reg.closeChannel()
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package steamnet_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamnet"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+type receiveResult struct {
+	p   steamnet.Packet
+	err error
+}
+
+func startReceive(ctx context.Context, channel int32) <-chan receiveResult {
+	ch := make(chan receiveResult, 1)
+	go func() {
+		p, err := steamnet.Receive(ctx, channel)
+		ch <- receiveResult{p, err}
+	}()
+	return ch
+}
+
+// waitReceive calls RunCallbacks until the receive finishes.
+func waitReceive(t *testing.T, ch <-chan receiveResult) receiveResult {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		steamworks.RunCallbacks()
+
+		select {
+		case r := <-ch:
+			return r
+		case <-timeout:
+			t.Fatal("Receive did not return")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestReceiveChannels(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	canceled := startReceive(ctx, 1)
+	cancel()
+	if r := waitReceive(t, canceled); r.err != context.Canceled {
+		t.Errorf("canceled Receive: got (%q, %v), expected %v", r.p.Data, r.err, context.Canceled)
+	}
+
+	packets, reg := steamnet.SubscribePackets(3, 1)
+	defer reg.Unregister()
+
+	first := startReceive(context.Background(), 1)
+	second := startReceive(context.Background(), 2)
+	fake.DeliverPacket(remoteUser, 1, []byte("one"))
+	fake.DeliverPacket(remoteUser, 2, []byte("two"))
+	fake.DeliverPacket(remoteUser, 3, []byte("three"))
+
+	for _, tt := range []struct {
+		ch   <-chan receiveResult
+		data string
+	}{
+		{first, "one"},
+		{second, "two"},
+	} {
+		r := waitReceive(t, tt.ch)
+		if r.err != nil || r.p.User != remoteUser || string(r.p.Data) != tt.data {
+			t.Errorf("got (%v, %q, %v), expected (%v, %q, nil)", r.p.User, r.p.Data, r.err, remoteUser, tt.data)
+		}
+		r.p.Release()
+	}
+
+	select {
+	case p := <-packets:
+		if string(p.Data) != "three" {
+			t.Errorf("subscription: got %q, expected %q", p.Data, "three")
+		}
+	default:
+		t.Error("subscription: no packet")
+	}
+}
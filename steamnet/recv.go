@@ -1,42 +1,105 @@
 package steamnet
 
 import (
+	"io"
 	"sync"
-	"unsafe"
 
 	"github.com/BenLubar/steamworks"
-	"github.com/BenLubar/steamworks/internal"
 )
 
-var packetLock sync.Mutex
-
 // ReadPacket checks if a P2P packet is available and returns the packet if
 // there is one.
 //
 // This should be called in a loop for each channel that you use.
 //
 // This call is non-blocking. It will return (nil, 0) if no data is available.
+//
+// ReadPacket allocates a new buffer for each packet. ReadPacketInto reuses
+// the caller's buffer, and Receive, HandlePackets, and SubscribePackets poll
+// for packets once per call to steamworks.RunCallbacks.
 func ReadPacket(channel int32) ([]byte, steamworks.SteamID) {
-	defer internal.Cleanup()()
+	return For(steamworks.Global()).ReadPacket(channel)
+}
+
+// ReadPacket is like the package-level ReadPacket, but uses n.
+func (n *Networking) ReadPacket(channel int32) ([]byte, steamworks.SteamID) {
+	networking := n.backend()
 
 	// Although the call is non-blocking, we need to call two functions, and
-	// we don't want the state of the connection to be changed by another
+	// we don't want the state of the channel to be changed by another
 	// caller to ReadPacket in-between.
-	packetLock.Lock()
-	defer packetLock.Unlock()
+	lock := n.channelLock(channel)
+	lock.Lock()
+	defer lock.Unlock()
 
-	var size uint32
-	if !internal.SteamAPI_ISteamNetworking_IsP2PPacketAvailable(&size, channel) {
+	size, ok := networking.IsP2PPacketAvailable(channel)
+	if !ok {
 		return nil, 0
 	}
 
 	buffer := make([]byte, size)
-	var steamID internal.SteamID
-	if !internal.SteamAPI_ISteamNetworking_ReadP2PPacket(unsafe.Pointer(&buffer[0]), size, &size, &steamID, channel) {
+	size, steamID, ok := networking.ReadP2PPacket(buffer, channel)
+	if !ok {
 		panic("steamnet: packet was not actually available")
 	}
 	if int(size) != len(buffer) {
 		panic("steamnet: packet size mismatch")
 	}
-	return buffer, steamworks.SteamID(steamID)
+	n.touch(steamID, false)
+	return buffer, steamID
+}
+
+// ReadPacketInto is like ReadPacket, but reads the packet into buf rather
+// than allocating a new buffer. It returns the size of the packet and the
+// user who sent it.
+//
+// If no packet is available, ReadPacketInto returns (0, 0, nil). If the
+// packet does not fit in buf, it is left unread, and ReadPacketInto returns
+// the size of the packet and io.ErrShortBuffer.
+func ReadPacketInto(buf []byte, channel int32) (int, steamworks.SteamID, error) {
+	return For(steamworks.Global()).ReadPacketInto(buf, channel)
+}
+
+// ReadPacketInto is like the package-level ReadPacketInto, but uses n.
+func (n *Networking) ReadPacketInto(buf []byte, channel int32) (int, steamworks.SteamID, error) {
+	lock := n.channelLock(channel)
+	lock.Lock()
+	defer lock.Unlock()
+
+	return n.readPacketLocked(buf, channel)
+}
+
+// readPacketLocked implements ReadPacketInto. The caller must hold the lock
+// returned by channelLock.
+func (n *Networking) readPacketLocked(buf []byte, channel int32) (int, steamworks.SteamID, error) {
+	networking := n.backend()
+
+	size, ok := networking.IsP2PPacketAvailable(channel)
+	if !ok {
+		return 0, 0, nil
+	}
+	if int(size) > len(buf) {
+		return int(size), 0, io.ErrShortBuffer
+	}
+
+	read, steamID, ok := networking.ReadP2PPacket(buf[:size], channel)
+	if !ok {
+		panic("steamnet: packet was not actually available")
+	}
+	if read != size {
+		panic("steamnet: packet size mismatch")
+	}
+	n.touch(steamID, false)
+	return int(size), steamID, nil
+}
+
+// channelLock returns the lock that keeps readers of channel from reading
+// each other's packets.
+func (n *Networking) channelLock(channel int32) *sync.Mutex {
+	if lock, ok := n.channelLocks.Load(channel); ok {
+		return lock.(*sync.Mutex)
+	}
+
+	lock, _ := n.channelLocks.LoadOrStore(channel, new(sync.Mutex))
+	return lock.(*sync.Mutex)
 }
@@ -1,9 +1,6 @@
 package steamnet
 
 import (
-	"runtime"
-	"unsafe"
-
 	"github.com/BenLubar/steamworks"
 	"github.com/BenLubar/steamworks/internal"
 )
@@ -86,10 +83,20 @@ func (err sendError) Timeout() bool {
 // Note that a nil return value does not mean the packet was successfully
 // received. If the packet is not received after a timeout of 20 seconds, an
 // error will be sent to the function registered with RegisterErrorCallback.
+//
+// If the Steamworks API is not available in this build, SendPacket returns
+// steamworks.ErrUnsupported.
 func SendPacket(user steamworks.SteamID, data []byte, sendType Reliability, channel int32) error {
-	defer internal.Cleanup()()
+	return For(steamworks.Global()).SendPacket(user, data, sendType, channel)
+}
+
+// SendPacket is like the package-level SendPacket, but uses n.
+func (n *Networking) SendPacket(user steamworks.SteamID, data []byte, sendType Reliability, channel int32) error {
+	if !steamworks.Supported() {
+		return steamworks.ErrUnsupported
+	}
 
-	if !internal.SteamAPI_ISteamNetworking_SendP2PPacket(internal.SteamID(user), unsafe.Pointer(&data[0]), uint32(len(data)), sendType, channel) {
+	if !n.backend().SendP2PPacket(user, data, sendType, channel) {
 		if !user.IsValid() {
 			return ErrTargetUserInvalid
 		}
@@ -98,7 +105,7 @@ func SendPacket(user steamworks.SteamID, data []byte, sendType Reliability, chan
 		}
 		return ErrBufferFull
 	}
-	runtime.KeepAlive(&data[0])
+	n.touch(user, true)
 
 	return nil
 }
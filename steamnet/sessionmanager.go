@@ -0,0 +1,460 @@
+//go:generate go get golang.org/x/tools/cmd/stringer
+//go:generate stringer -type PeerState,PeerEventType -trimprefix Peer -output sessionmanager_string.go
+
+package steamnet
+
+import (
+	"errors"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// PeerState is the state of the P2P session with a peer, as tracked by a
+// SessionManager.
+type PeerState int
+
+const (
+	// PeerConnecting means Steam is establishing a connection to the peer,
+	// or re-establishing one that was lost.
+	PeerConnecting PeerState = iota
+	// PeerActive means there is a direct connection to the peer.
+	PeerActive
+	// PeerRelayed means there is a connection to the peer through a Steam
+	// relay server.
+	PeerRelayed
+	// PeerFailed means packets could not get through to the peer. Peer.Err
+	// says why.
+	PeerFailed
+)
+
+// PeerEventType is the type of a PeerEvent.
+type PeerEventType int
+
+const (
+	// PeerConnected is sent when a connection to the peer is established.
+	// A peer whose connection is lost while the session stays open goes
+	// back to PeerConnecting without an event, and PeerConnected is sent
+	// again when the connection is re-established.
+	PeerConnected PeerEventType = iota
+	// PeerDisconnected is sent when the session with the peer ends. Err
+	// says why, or is nil if the session was closed normally.
+	PeerDisconnected
+	// PeerRelayChanged is sent when the connection to the peer switches
+	// between a direct connection and a Steam relay server.
+	PeerRelayChanged
+)
+
+// ErrIdle is the Err of a PeerDisconnected event for a peer that was closed
+// because it was idle for longer than SessionOptions.IdleTimeout.
+var ErrIdle = errors.New("steamnet: the session was idle for too long")
+
+// ErrSessionManagerExists is returned by NewSessionManager if a
+// SessionManager is already open.
+var ErrSessionManagerExists = errors.New("steamnet: a SessionManager is already open")
+
+// Peer describes the session with a peer at the last time the SessionManager
+// checked it.
+type Peer struct {
+	// User is the peer's Steam ID.
+	User steamworks.SteamID
+	// State is the state of the session.
+	State PeerState
+	// Err is the error that made the session fail, if State is PeerFailed.
+	Err error
+	// RemoteIP of the other end of the connection (if set). Could be a Steam
+	// relay server.
+	RemoteIP net.IP
+	// RemotePort of the other end of the connection (if set).
+	RemotePort int
+	// BytesQueuedForSend is the number of bytes queued up to be sent to the
+	// peer.
+	BytesQueuedForSend int
+	// PacketsQueuedForSend is the number of packets queued up to be sent to
+	// the peer.
+	PacketsQueuedForSend int
+	// Since is the time the session entered State.
+	Since time.Time
+	// LastActivity is the last time a packet was sent to or read from the
+	// peer using this package.
+	LastActivity time.Time
+}
+
+// PeerEvent is a change in the session with a peer.
+type PeerEvent struct {
+	Type PeerEventType
+	// Peer is the peer after the change.
+	Peer Peer
+	// Err is the reason for a PeerDisconnected event.
+	Err error
+}
+
+// SessionOptions configures a SessionManager.
+type SessionOptions struct {
+	// MaxPeers limits the number of peers whose session requests are
+	// accepted. Failed peers do not count. If MaxPeers is 0, there is no
+	// limit. Sessions started by sending a packet are not limited.
+	//
+	// MaxPeers and AllowList apply to the session requests accepted by
+	// every function registered with Listen and by NewListener, not only
+	// the ones accepted by the SessionManager itself.
+	MaxPeers int
+
+	// AllowList, if non-nil, lists the only users whose session requests
+	// are accepted.
+	AllowList []steamworks.SteamID
+
+	// IdleTimeout closes the session with a peer that has not sent or
+	// received a packet for this long. Failed peers are forgotten after
+	// the same amount of time. If IdleTimeout is 0, idle sessions are not
+	// closed.
+	IdleTimeout time.Duration
+
+	// PollInterval is how often the state of each session is checked. If
+	// PollInterval is 0, a quarter of a second is used.
+	PollInterval time.Duration
+
+	// OnEvent, if non-nil, is called with each PeerEvent. It is run like
+	// a callback handler, by steamworks.Execute, so it follows the
+	// WithExecutor and WithDispatchQueue options, and a panic is passed
+	// to steamworks.OnCallbackPanic.
+	OnEvent func(PeerEvent)
+}
+
+// SessionManager tracks the P2P session with every peer: it accepts session
+// requests allowed by its SessionOptions, checks the state of each session
+// once per PollInterval, sends PeerEvents when a session connects,
+// disconnects, or starts or stops using a relay, and closes idle sessions.
+//
+// Peers are added when their session request is accepted or when a packet is
+// sent to them. Their activity is recorded by the functions in this package
+// that send and read packets.
+//
+// The state is checked during calls to steamworks.RunCallbacks, so the
+// callback goroutine must be running or RunCallbacks must be called
+// regularly.
+type SessionManager struct {
+	n     *Networking
+	opts  SessionOptions
+	allow map[steamworks.SteamID]bool
+
+	lock     sync.Mutex
+	peers    map[steamworks.SteamID]*Peer
+	lastPoll time.Time
+	closed   bool
+
+	regs []steamworks.Registration
+}
+
+// NewSessionManager starts tracking P2P sessions. Only one SessionManager can
+// be open at a time. While it is open, listeners created by NewListener leave
+// accepting sessions to it.
+func NewSessionManager(opts SessionOptions) (*SessionManager, error) {
+	return For(steamworks.Global()).NewSessionManager(opts)
+}
+
+// NewSessionManager is like the package-level NewSessionManager, but uses n.
+func (n *Networking) NewSessionManager(opts SessionOptions) (*SessionManager, error) {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = time.Second / 4
+	}
+
+	m := &SessionManager{
+		n:     n,
+		opts:  opts,
+		peers: make(map[steamworks.SteamID]*Peer),
+	}
+	if opts.AllowList != nil {
+		m.allow = make(map[steamworks.SteamID]bool, len(opts.AllowList))
+		for _, user := range opts.AllowList {
+			m.allow[user] = true
+		}
+	}
+
+	n.sessionLock.Lock()
+	if n.sessions != nil {
+		n.sessionLock.Unlock()
+		return nil, ErrSessionManagerExists
+	}
+	n.sessions = m
+	n.sessionLock.Unlock()
+
+	m.regs = []steamworks.Registration{
+		// Listen checks m.accept before accepting a request.
+		n.Listen(func(steamworks.SteamID) bool { return true }),
+		n.RegisterErrorCallback(m.onError),
+		steamworks.OnRunCallbacks(m.tick),
+	}
+
+	return m, nil
+}
+
+// Close stops tracking sessions. The sessions are not closed.
+func (m *SessionManager) Close() {
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		return
+	}
+	m.closed = true
+	regs := m.regs
+	m.lock.Unlock()
+
+	for _, reg := range regs {
+		reg.Unregister()
+	}
+
+	m.n.sessionLock.Lock()
+	if m.n.sessions == m {
+		m.n.sessions = nil
+	}
+	m.n.sessionLock.Unlock()
+}
+
+// Peers returns the tracked peers, sorted by Steam ID.
+func (m *SessionManager) Peers() []Peer {
+	m.lock.Lock()
+	peers := make([]Peer, 0, len(m.peers))
+	for _, p := range m.peers {
+		peers = append(peers, *p)
+	}
+	m.lock.Unlock()
+
+	sort.Slice(peers, func(i, j int) bool { return peers[i].User < peers[j].User })
+
+	return peers
+}
+
+// Peer returns the tracked peer with the given Steam ID.
+func (m *SessionManager) Peer(user steamworks.SteamID) (Peer, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if p := m.peers[user]; p != nil {
+		return *p, true
+	}
+
+	return Peer{}, false
+}
+
+// Disconnect closes the session with user, as if by CloseAllChannels, and
+// stops tracking it.
+func (m *SessionManager) Disconnect(user steamworks.SteamID) {
+	m.lock.Lock()
+	p := m.peers[user]
+	delete(m.peers, user)
+	m.lock.Unlock()
+
+	m.n.CloseAllChannels(user)
+
+	if p != nil {
+		m.emit([]PeerEvent{{Type: PeerDisconnected, Peer: *p}})
+	}
+}
+
+func (m *SessionManager) accept(user steamworks.SteamID) bool {
+	if m.allow != nil && !m.allow[user] {
+		return false
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return false
+	}
+
+	if p := m.peers[user]; p != nil && p.State != PeerFailed {
+		return true
+	}
+
+	if m.opts.MaxPeers > 0 {
+		count := 0
+		for _, p := range m.peers {
+			if p.State != PeerFailed {
+				count++
+			}
+		}
+		if count >= m.opts.MaxPeers {
+			return false
+		}
+	}
+
+	m.addLocked(user)
+
+	return true
+}
+
+// addLocked starts tracking user. The caller must hold m.lock.
+func (m *SessionManager) addLocked(user steamworks.SteamID) *Peer {
+	now := time.Now()
+	p := &Peer{
+		User:         user,
+		State:        PeerConnecting,
+		Since:        now,
+		LastActivity: now,
+	}
+	m.peers[user] = p
+
+	return p
+}
+
+// touch records activity with user. A packet sent to an unknown user starts
+// tracking it.
+func (m *SessionManager) touch(user steamworks.SteamID, sent bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.closed {
+		return
+	}
+
+	p := m.peers[user]
+	if p == nil || p.State == PeerFailed {
+		if !sent {
+			return
+		}
+		p = m.addLocked(user)
+	}
+
+	p.LastActivity = time.Now()
+}
+
+func (m *SessionManager) onError(user steamworks.SteamID, err error) {
+	m.lock.Lock()
+	p := m.peers[user]
+	if p == nil || p.State == PeerFailed {
+		m.lock.Unlock()
+		return
+	}
+	p.State = PeerFailed
+	p.Err = err
+	p.Since = time.Now()
+	peer := *p
+	m.lock.Unlock()
+
+	m.emit([]PeerEvent{{Type: PeerDisconnected, Peer: peer, Err: err}})
+}
+
+func (m *SessionManager) tick() {
+	now := time.Now()
+
+	m.lock.Lock()
+	if m.closed || now.Sub(m.lastPoll) < m.opts.PollInterval {
+		m.lock.Unlock()
+		return
+	}
+	m.lastPoll = now
+
+	users := make([]steamworks.SteamID, 0, len(m.peers))
+	for user, p := range m.peers {
+		if p.State != PeerFailed {
+			users = append(users, user)
+		} else if m.idle(p, now) {
+			delete(m.peers, user)
+		}
+	}
+	m.lock.Unlock()
+
+	var events []PeerEvent
+	var idle []steamworks.SteamID
+	for _, user := range users {
+		state := m.n.GetSessionState(user)
+
+		m.lock.Lock()
+		p := m.peers[user]
+		if p == nil || p.State == PeerFailed {
+			m.lock.Unlock()
+			continue
+		}
+
+		if state == nil {
+			// A connecting peer may not have a session yet.
+			if p.State != PeerConnecting {
+				delete(m.peers, user)
+				events = append(events, PeerEvent{Type: PeerDisconnected, Peer: *p})
+			} else if m.idle(p, now) {
+				delete(m.peers, user)
+				events = append(events, PeerEvent{Type: PeerDisconnected, Peer: *p, Err: ErrIdle})
+			}
+			m.lock.Unlock()
+			continue
+		}
+
+		p.RemoteIP = state.RemoteIP
+		p.RemotePort = state.RemotePort
+		p.BytesQueuedForSend = state.BytesQueuedForSend
+		p.PacketsQueuedForSend = state.PacketsQueuedForSend
+
+		newState := PeerConnecting
+		switch {
+		case state.ConnectionActive && state.UsingRelay:
+			newState = PeerRelayed
+		case state.ConnectionActive:
+			newState = PeerActive
+		case !state.Connecting && state.LastError != nil:
+			newState = PeerFailed
+		}
+
+		if newState != p.State {
+			oldState := p.State
+			p.State = newState
+			p.Since = now
+
+			switch {
+			case newState == PeerFailed:
+				p.Err = state.LastError
+				events = append(events, PeerEvent{Type: PeerDisconnected, Peer: *p, Err: state.LastError})
+			case oldState == PeerConnecting:
+				events = append(events, PeerEvent{Type: PeerConnected, Peer: *p})
+			case newState != PeerConnecting:
+				events = append(events, PeerEvent{Type: PeerRelayChanged, Peer: *p})
+			default:
+				// The connection was lost but Steam is still trying;
+				// PeerConnected is sent again if it comes back.
+			}
+		}
+
+		if p.State != PeerFailed && m.idle(p, now) {
+			delete(m.peers, user)
+			idle = append(idle, user)
+			events = append(events, PeerEvent{Type: PeerDisconnected, Peer: *p, Err: ErrIdle})
+		}
+		m.lock.Unlock()
+	}
+
+	for _, user := range idle {
+		m.n.CloseAllChannels(user)
+	}
+
+	m.emit(events)
+}
+
+// idle returns true if p should be forgotten because of IdleTimeout. Failed
+// peers are timed from when they failed.
+func (m *SessionManager) idle(p *Peer, now time.Time) bool {
+	if m.opts.IdleTimeout <= 0 {
+		return false
+	}
+
+	last := p.LastActivity
+	if p.State == PeerFailed {
+		last = p.Since
+	}
+
+	return now.Sub(last) > m.opts.IdleTimeout
+}
+
+func (m *SessionManager) emit(events []PeerEvent) {
+	if m.opts.OnEvent == nil {
+		return
+	}
+
+	for _, e := range events {
+		e := e
+		steamworks.Execute(func() { m.opts.OnEvent(e) })
+	}
+}
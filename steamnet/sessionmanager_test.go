@@ -0,0 +1,255 @@
+package steamnet_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamnet"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+func TestSessionManagerLimitsListen(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	const otherUser steamworks.SteamID = 76561197960287932
+
+	m, err := steamnet.NewSessionManager(steamnet.SessionOptions{
+		AllowList: []steamworks.SteamID{remoteUser, otherUser},
+		MaxPeers:  1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	reg := steamnet.Listen(func(steamworks.SteamID) bool { return true })
+	defer reg.Unregister()
+
+	const strangerUser steamworks.SteamID = 76561197960287933
+	fake.PostP2PSessionRequest(strangerUser)
+	fake.PostP2PSessionRequest(remoteUser)
+	fake.PostP2PSessionRequest(otherUser)
+	steamworks.RunCallbacks()
+
+	if fake.SessionAccepted(strangerUser) {
+		t.Error("session with a user not in AllowList was accepted")
+	}
+	if !fake.SessionAccepted(remoteUser) {
+		t.Error("session with an allowed user was not accepted")
+	}
+	if fake.SessionAccepted(otherUser) {
+		t.Error("session beyond MaxPeers was accepted")
+	}
+}
+
+func TestSessionManagerEventPanic(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	var panics []steamworks.CallbackPanic
+	panicReg := steamworks.HandleCallbackPanics(func(p steamworks.CallbackPanic) {
+		panics = append(panics, p)
+	})
+	defer panicReg.Unregister()
+
+	var events []steamnet.PeerEvent
+	m, err := steamnet.NewSessionManager(steamnet.SessionOptions{
+		OnEvent: func(e steamnet.PeerEvent) {
+			events = append(events, e)
+			panic("event")
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	fake.PostP2PSessionRequest(remoteUser)
+	steamworks.RunCallbacks()
+	m.Disconnect(remoteUser)
+
+	if len(events) == 0 || events[len(events)-1].Type != steamnet.PeerDisconnected {
+		t.Errorf("events: got %+v, expected PeerDisconnected last", events)
+	}
+	if len(panics) != len(events) {
+		t.Errorf("recovered %d panics, expected one for each of the %d events", len(panics), len(events))
+	}
+	for _, p := range panics {
+		if p.Value != "event" {
+			t.Errorf("recovered panic value: got %v, expected \"event\"", p.Value)
+		}
+	}
+}
+
+// pollSessions waits for a PollInterval of a millisecond to pass and calls
+// RunCallbacks, returning and clearing the events recorded since the last
+// call.
+func pollSessions(events *[]steamnet.PeerEvent) []steamnet.PeerEvent {
+	time.Sleep(2 * time.Millisecond)
+	steamworks.RunCallbacks()
+
+	e := *events
+	*events = nil
+	return e
+}
+
+func TestSessionManagerPolling(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	var events []steamnet.PeerEvent
+	m, err := steamnet.NewSessionManager(steamnet.SessionOptions{
+		PollInterval: time.Millisecond,
+		OnEvent:      func(e steamnet.PeerEvent) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	// Sending a packet starts tracking the peer before it has a session.
+	if err := steamnet.SendPacket(remoteUser, []byte("hello"), steamnet.Reliable, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	active := &steamnet.SessionState{
+		ConnectionActive:     true,
+		RemoteIP:             net.IPv4(192, 0, 2, 1),
+		RemotePort:           27015,
+		BytesQueuedForSend:   100,
+		PacketsQueuedForSend: 2,
+	}
+	relayed := *active
+	relayed.UsingRelay = true
+
+	for _, tt := range []struct {
+		name  string
+		state *steamnet.SessionState
+		quiet bool                   // no event is expected
+		event steamnet.PeerEventType // ignored if quiet
+		peer  steamnet.PeerState     // ignored if gone
+		gone  bool
+	}{
+		{name: "no session", quiet: true, peer: steamnet.PeerConnecting},
+		{name: "connecting", state: &steamnet.SessionState{Connecting: true}, quiet: true, peer: steamnet.PeerConnecting},
+		{name: "active", state: active, event: steamnet.PeerConnected, peer: steamnet.PeerActive},
+		{name: "relayed", state: &relayed, event: steamnet.PeerRelayChanged, peer: steamnet.PeerRelayed},
+		{name: "direct", state: active, event: steamnet.PeerRelayChanged, peer: steamnet.PeerActive},
+		{name: "reconnecting", state: &steamnet.SessionState{Connecting: true}, quiet: true, peer: steamnet.PeerConnecting},
+		{name: "reconnected", state: active, event: steamnet.PeerConnected, peer: steamnet.PeerActive},
+		{name: "closed", event: steamnet.PeerDisconnected, gone: true},
+	} {
+		fake.SetSessionState(remoteUser, tt.state)
+		got := pollSessions(&events)
+
+		switch {
+		case tt.quiet && len(got) != 0:
+			t.Errorf("%s: got events %+v, expected none", tt.name, got)
+		case !tt.quiet && (len(got) != 1 || got[0].Type != tt.event || got[0].Err != nil):
+			t.Errorf("%s: got events %+v, expected one %v event", tt.name, got, tt.event)
+		case !tt.quiet && !tt.gone && got[0].Peer.State != tt.peer:
+			t.Errorf("%s: event peer state %v, expected %v", tt.name, got[0].Peer.State, tt.peer)
+		}
+
+		p, ok := m.Peer(remoteUser)
+		if tt.gone {
+			if ok {
+				t.Errorf("%s: peer is still tracked: %+v", tt.name, p)
+			}
+			continue
+		}
+		if !ok {
+			t.Fatalf("%s: peer is not tracked", tt.name)
+		}
+		if p.State != tt.peer {
+			t.Errorf("%s: State = %v, expected %v", tt.name, p.State, tt.peer)
+		}
+		if tt.state == active && (!p.RemoteIP.Equal(active.RemoteIP) || p.RemotePort != active.RemotePort || p.BytesQueuedForSend != active.BytesQueuedForSend || p.PacketsQueuedForSend != active.PacketsQueuedForSend) {
+			t.Errorf("%s: got stats %v:%d, %d bytes, %d packets, expected %v:%d, %d bytes, %d packets", tt.name,
+				p.RemoteIP, p.RemotePort, p.BytesQueuedForSend, p.PacketsQueuedForSend,
+				active.RemoteIP, active.RemotePort, active.BytesQueuedForSend, active.PacketsQueuedForSend)
+		}
+	}
+
+	// A session that gives up connecting fails with its last error.
+	if err := steamnet.SendPacket(remoteUser, []byte("hello"), steamnet.Reliable, 0); err != nil {
+		t.Fatal(err)
+	}
+	fake.SetSessionState(remoteUser, &steamnet.SessionState{LastError: steamnet.ErrTimeout})
+	if got := pollSessions(&events); len(got) != 1 || got[0].Type != steamnet.PeerDisconnected || got[0].Err != steamnet.ErrTimeout {
+		t.Errorf("failed: got events %+v, expected PeerDisconnected with %v", got, steamnet.ErrTimeout)
+	}
+	if p, ok := m.Peer(remoteUser); !ok || p.State != steamnet.PeerFailed || p.Err != steamnet.ErrTimeout {
+		t.Errorf("failed: got peer %+v, expected PeerFailed with %v", p, steamnet.ErrTimeout)
+	}
+}
+
+func TestSessionManagerIdleTimeout(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	const idleTimeout = 100 * time.Millisecond
+
+	var events []steamnet.PeerEvent
+	m, err := steamnet.NewSessionManager(steamnet.SessionOptions{
+		IdleTimeout:  idleTimeout,
+		PollInterval: time.Millisecond,
+		OnEvent:      func(e steamnet.PeerEvent) { events = append(events, e) },
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	const otherUser steamworks.SteamID = 76561197960287932
+
+	// remoteUser has an active session; otherUser never gets one.
+	fake.PostP2PSessionRequest(remoteUser)
+	if err := steamnet.SendPacket(otherUser, []byte("hello"), steamnet.Reliable, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got := pollSessions(&events); len(got) != 1 || got[0].Type != steamnet.PeerConnected || got[0].Peer.User != remoteUser {
+		t.Errorf("got events %+v, expected PeerConnected for %v", got, remoteUser)
+	}
+
+	time.Sleep(idleTimeout)
+	got := pollSessions(&events)
+	if len(got) != 2 {
+		t.Fatalf("got events %+v, expected PeerDisconnected for both peers", got)
+	}
+	for _, e := range got {
+		if e.Type != steamnet.PeerDisconnected || e.Err != steamnet.ErrIdle {
+			t.Errorf("%v: got %v event with error %v, expected PeerDisconnected with ErrIdle", e.Peer.User, e.Type, e.Err)
+		}
+	}
+
+	if peers := m.Peers(); len(peers) != 0 {
+		t.Errorf("peers still tracked: %+v", peers)
+	}
+	if fake.SessionAccepted(remoteUser) {
+		t.Error("idle session was not closed")
+	}
+}
@@ -0,0 +1,401 @@
+package steamnet
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// Each reliable message sent by a stream connection starts with one of these
+// frame types.
+const (
+	frameData byte = iota
+	frameOpen
+	frameOpenAck
+	frameFin
+	frameClose
+	frameReset
+)
+
+// maxFrameData is the largest amount of data that fits in one frame, as
+// reliable messages are limited to 1MB.
+const maxFrameData = 1<<20 - 1
+
+// maxReceiveBuffer is the amount of data a connection holds until it is read.
+// If the remote user sends more than that, the connection is reset.
+const maxReceiveBuffer = 16 << 20
+
+// Errors returned by the net.Conn and net.Listener implementations in this
+// package. They are wrapped in a *net.OpError.
+var (
+	ErrConnectionRefused = errors.New("steamnet: the remote user is not listening on the channel")
+	ErrConnectionReset   = errors.New("steamnet: connection reset by the remote user")
+	ErrAlreadyConnected  = errors.New("steamnet: already connected to the user on the channel")
+	ErrListening         = errors.New("steamnet: a listener already exists for the channel")
+	ErrReceiveBufferFull = errors.New("steamnet: too much data was received without being read")
+
+	errClosedByRemote = errors.New("steamnet: connection closed by the remote user")
+	errWriteClosed    = errors.New("steamnet: write after CloseWrite")
+	errShutdown       = errors.New("steamnet: the Steamworks API was shut down")
+)
+
+// streamMux passes the packets sent to one channel to the stream connection
+// for the user who sent them.
+type streamMux struct {
+	n       *Networking
+	channel int32
+
+	// conns, closing, and listener are guarded by n.streamLock. closing
+	// has the users whose connection was closed locally before the remote
+	// user closed it; the channel is closed once their close frame arrives.
+	conns    map[steamworks.SteamID]*conn
+	closing  map[steamworks.SteamID]bool
+	listener *listener
+
+	errReg    steamworks.Registration
+	packetReg steamworks.Registration
+
+	// controlLock guards the control frames waiting for room in Steam's
+	// send buffer, which are sent again by retry once per callback tick,
+	// and wake, which retry closes to let blocked writes try again. It can
+	// be acquired while holding n.streamLock, but not the other way
+	// around.
+	controlLock sync.Mutex
+	control     []controlFrame
+	wake        chan struct{}
+	retry       steamworks.Registration
+}
+
+// controlFrame is a control frame waiting to be sent.
+type controlFrame struct {
+	user  steamworks.SteamID
+	frame byte
+}
+
+// streamMuxLocked returns the streamMux for channel, starting it if needed.
+// The caller must hold n.streamLock and call releaseLocked if it does not
+// add a connection or listener.
+func (n *Networking) streamMuxLocked(channel int32) *streamMux {
+	if m := n.streams[channel]; m != nil {
+		return m
+	}
+
+	m := &streamMux{
+		n:       n,
+		channel: channel,
+		conns:   make(map[steamworks.SteamID]*conn),
+		closing: make(map[steamworks.SteamID]bool),
+	}
+	m.errReg = n.RegisterErrorCallback(m.onError)
+	m.packetReg = n.HandlePackets(channel, m.handle)
+
+	if n.streams == nil {
+		n.streams = make(map[int32]*streamMux)
+	}
+	n.streams[channel] = m
+
+	return m
+}
+
+// releaseLocked stops m if it has no connections, listener, or control
+// frames left to send. The caller must hold n.streamLock.
+func (m *streamMux) releaseLocked() {
+	if len(m.conns) != 0 || len(m.closing) != 0 || m.listener != nil || m.n.streams[m.channel] != m {
+		return
+	}
+
+	m.controlLock.Lock()
+	sending := len(m.control) != 0
+	m.controlLock.Unlock()
+	if sending {
+		return
+	}
+
+	delete(m.n.streams, m.channel)
+	m.errReg.Unregister()
+	m.packetReg.Unregister()
+}
+
+// closeStreams fails every stream connection and listener of n. It is called
+// when the handle is shut down. Later calls to Dial and NewListener fail.
+func (n *Networking) closeStreams() {
+	n.streamLock.Lock()
+	streams := n.streams
+	n.streams = nil
+	n.streamsClosed = true
+	n.streamLock.Unlock()
+
+	for _, m := range streams {
+		m.errReg.Unregister()
+		m.packetReg.Unregister()
+
+		m.controlLock.Lock()
+		m.control = nil
+		if m.retry != nil {
+			m.retry.Unregister()
+			m.retry = nil
+		}
+		if m.wake != nil {
+			close(m.wake)
+			m.wake = nil
+		}
+		m.controlLock.Unlock()
+
+		n.streamLock.Lock()
+		conns, l := m.conns, m.listener
+		m.conns, m.closing, m.listener = nil, nil, nil
+		n.streamLock.Unlock()
+
+		for _, c := range conns {
+			c.fail(errShutdown)
+		}
+		if l != nil {
+			_ = l.Close()
+		}
+	}
+}
+
+func (m *streamMux) handle(p Packet) {
+	user, data := p.User, p.Data
+	if len(data) == 0 {
+		return
+	}
+
+	m.n.streamLock.Lock()
+	if m.n.streams[m.channel] != m {
+		// shut down
+		m.n.streamLock.Unlock()
+		return
+	}
+	c := m.conns[user]
+
+	if data[0] == frameOpen {
+		if c != nil && c.establish() {
+			// both users dialed each other at the same time
+			m.n.streamLock.Unlock()
+			_ = m.sendControl(user, frameOpenAck)
+			return
+		}
+
+		delete(m.closing, user)
+		if c != nil {
+			// the remote user started over, so the old connection
+			// is gone even if it was not closed
+			delete(m.conns, user)
+			c.fail(ErrConnectionReset)
+		}
+
+		c = newConn(m, user, false)
+		if m.listener == nil || !m.listener.enqueue(c) {
+			m.releaseLocked()
+			m.n.streamLock.Unlock()
+			_ = m.sendControl(user, frameReset)
+			return
+		}
+		m.conns[user] = c
+
+		m.n.streamLock.Unlock()
+		_ = m.sendControl(user, frameOpenAck)
+		return
+	}
+
+	if c == nil {
+		switch data[0] {
+		case frameData, frameFin:
+			delete(m.closing, user)
+			m.releaseLocked()
+			m.n.streamLock.Unlock()
+			_ = m.sendControl(user, frameReset)
+		case frameReset:
+			delete(m.closing, user)
+			m.releaseLocked()
+			m.n.streamLock.Unlock()
+		case frameClose:
+			if m.closing[user] {
+				// both ends are closed
+				delete(m.closing, user)
+				m.releaseLocked()
+				m.n.streamLock.Unlock()
+				m.n.CloseChannel(user, m.channel)
+				return
+			}
+			m.n.streamLock.Unlock()
+		default:
+			m.n.streamLock.Unlock()
+		}
+		return
+	}
+
+	if data[0] != frameOpenAck && data[0] != frameReset && c.establishing() {
+		// the remote user sent this before it saw our open frame, so it
+		// belongs to a connection that was closed locally
+		delete(m.closing, user)
+		m.n.streamLock.Unlock()
+		return
+	}
+
+	switch data[0] {
+	case frameData:
+		// the packet's buffer is reused after handle returns
+		if !c.deliver(append([]byte(nil), data[1:]...)) {
+			delete(m.conns, user)
+			m.releaseLocked()
+			m.n.streamLock.Unlock()
+			c.fail(ErrReceiveBufferFull)
+			_ = m.sendControl(user, frameReset)
+			return
+		}
+	case frameOpenAck:
+		c.establish()
+	case frameFin:
+		c.remoteFin(false)
+	case frameClose:
+		c.remoteFin(true)
+	case frameReset:
+		delete(m.conns, user)
+		m.releaseLocked()
+		if c.establishing() {
+			c.fail(ErrConnectionRefused)
+		} else {
+			c.fail(ErrConnectionReset)
+		}
+	}
+
+	m.n.streamLock.Unlock()
+}
+
+func (m *streamMux) onError(user steamworks.SteamID, err error) {
+	m.n.streamLock.Lock()
+	c := m.conns[user]
+	if c != nil {
+		delete(m.conns, user)
+		m.releaseLocked()
+	}
+	m.n.streamLock.Unlock()
+
+	if c != nil {
+		c.fail(err)
+	}
+}
+
+// remove removes c from m after it is closed locally, so that a new
+// connection to the user can be dialed. If remoteOpen is true, the channel is
+// closed once the remote user closes its end too.
+func (m *streamMux) remove(c *conn, remoteOpen bool) {
+	m.n.streamLock.Lock()
+	if m.conns[c.user] == c {
+		delete(m.conns, c.user)
+		if remoteOpen {
+			m.closing[c.user] = true
+		}
+		m.releaseLocked()
+	}
+	m.n.streamLock.Unlock()
+}
+
+// sendControl sends a frame with no data. If Steam's send buffer is full, the
+// frame is sent again once per callback tick until there is room, and frames
+// to the same user are sent after it. The returned error is for other
+// failures, which sending again would not fix.
+func (m *streamMux) sendControl(user steamworks.SteamID, frame byte) error {
+	m.controlLock.Lock()
+	defer m.controlLock.Unlock()
+
+	if !m.controlPendingLocked(user) {
+		err := m.n.SendPacket(user, []byte{frame}, Reliable, m.channel)
+		if err != ErrBufferFull {
+			return err
+		}
+	}
+
+	m.control = append(m.control, controlFrame{user: user, frame: frame})
+	m.startRetryLocked()
+
+	return nil
+}
+
+// room returns a channel that is closed at the next callback tick. Steam does
+// not report when there is room in its send buffer, so a write blocked by a
+// full buffer tries again once per tick.
+func (m *streamMux) room() <-chan struct{} {
+	m.controlLock.Lock()
+	defer m.controlLock.Unlock()
+
+	if m.wake == nil {
+		m.wake = make(chan struct{})
+	}
+	m.startRetryLocked()
+
+	return m.wake
+}
+
+// startRetryLocked registers retryControl to run at each callback tick. The
+// caller must hold m.controlLock.
+func (m *streamMux) startRetryLocked() {
+	if m.retry == nil {
+		m.retry = steamworks.OnRunCallbacks(m.retryControl)
+	}
+}
+
+// controlPending returns true if a control frame to user is waiting to be
+// sent. Data frames wait for it so that the frames arrive in order.
+func (m *streamMux) controlPending(user steamworks.SteamID) bool {
+	m.controlLock.Lock()
+	defer m.controlLock.Unlock()
+
+	return m.controlPendingLocked(user)
+}
+
+func (m *streamMux) controlPendingLocked(user steamworks.SteamID) bool {
+	for _, f := range m.control {
+		if f.user == user {
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryControl sends the control frames that did not fit in Steam's send
+// buffer, in order, stopping at the first one to each user that still does
+// not fit. Then it wakes the writes waiting for room.
+func (m *streamMux) retryControl() {
+	m.controlLock.Lock()
+	wake := m.wake
+	m.wake = nil
+	var blocked map[steamworks.SteamID]bool
+	remaining := m.control[:0]
+	for _, f := range m.control {
+		if !blocked[f.user] {
+			err := m.n.SendPacket(f.user, []byte{f.frame}, Reliable, m.channel)
+			if err != ErrBufferFull {
+				// sent, or failed in a way that the error
+				// callback reports
+				continue
+			}
+			if blocked == nil {
+				blocked = make(map[steamworks.SteamID]bool)
+			}
+			blocked[f.user] = true
+		}
+		remaining = append(remaining, f)
+	}
+	m.control = remaining
+	done := len(remaining) == 0
+	if done && m.retry != nil {
+		m.retry.Unregister()
+		m.retry = nil
+	}
+	m.controlLock.Unlock()
+
+	if wake != nil {
+		close(wake)
+	}
+
+	if done {
+		m.n.streamLock.Lock()
+		m.releaseLocked()
+		m.n.streamLock.Unlock()
+	}
+}
@@ -6,9 +6,7 @@ import (
 )
 
 func OnChanged(f func()) steamworks.Registration {
-	return internal.RegisterCallback_SteamParentalSettingsChanged(func(*internal.SteamParentalSettingsChanged, bool) {
-		f()
-	}, 0)
+	return steamworks.GetBackend().ParentalSettings().OnParentalSettingsChanged(f)
 }
 
 type Feature internal.EParentalFeature
@@ -33,25 +31,25 @@ func (f Feature) String() string {
 }
 
 func (f Feature) IsBlocked() bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsFeatureBlocked(internal.EParentalFeature(f))
+	return steamworks.GetBackend().ParentalSettings().IsFeatureBlocked(internal.EParentalFeature(f))
 }
 
 func (f Feature) IsInBlockList() bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsFeatureInBlockList(internal.EParentalFeature(f))
+	return steamworks.GetBackend().ParentalSettings().IsFeatureInBlockList(internal.EParentalFeature(f))
 }
 
 func IsParentalLockEnabled() bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsParentalLockEnabled()
+	return steamworks.GetBackend().ParentalSettings().IsParentalLockEnabled()
 }
 
 func IsParentalLockLocked() bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsParentalLockLocked()
+	return steamworks.GetBackend().ParentalSettings().IsParentalLockLocked()
 }
 
 func IsAppBlocked(appID steamworks.AppID) bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsAppBlocked(internal.AppId(appID))
+	return steamworks.GetBackend().ParentalSettings().IsAppBlocked(appID)
 }
 
 func IsAppInBlockList(appID steamworks.AppID) bool {
-	return internal.SteamAPI_ISteamParentalSettings_BIsAppInBlockList(internal.AppId(appID))
+	return steamworks.GetBackend().ParentalSettings().IsAppInBlockList(appID)
 }
@@ -0,0 +1,250 @@
+// Package steamreplay records the callbacks Steam delivers to a program and
+// replays them later, for reproducing bugs that depend on the exact order of
+// events.
+//
+// A recording is a sequence of Records, each holding the raw callback struct
+// from the Steamworks SDK. Replaying a recording passes each struct to the
+// handlers registered for its callback type, exactly as RunCallbacks would,
+// so handlers registered by other steamworks packages see the same sequence
+// of callbacks without Steam running.
+//
+// Example:
+//
+//    f, err := os.Create("session.steamrec")
+//    if err != nil {
+//        handleError(err)
+//    }
+//    defer f.Close()
+//
+//    rec, err := steamreplay.Start(f)
+//    if err != nil {
+//        handleError(err)
+//    }
+//    defer rec.Stop()
+//
+// And later, in a test:
+//
+//    steamnet.Listen(handleSessionRequest)
+//
+//    f, err := os.Open("testdata/session.steamrec")
+//    if err != nil {
+//        t.Fatal(err)
+//    }
+//    defer f.Close()
+//
+//    if err := steamreplay.Replay(f); err != nil {
+//        t.Fatal(err)
+//    }
+package steamreplay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// Version is the version of the file format written by Writer.
+const Version = 1
+
+const magic = "STEAMREC"
+
+// Errors returned by Reader.
+var (
+	ErrNotRecording = errors.New("steamreplay: not a callback recording")
+	ErrVersion      = errors.New("steamreplay: unsupported recording version")
+)
+
+// PlatformError is returned when replaying a recording made on a different
+// platform. The layout of callback structs depends on the operating system
+// and architecture, so they cannot be replayed elsewhere.
+type PlatformError struct {
+	Recorded string
+	Current  string
+}
+
+func (err *PlatformError) Error() string {
+	return "steamreplay: recording was made on " + err.Recorded + ", but this is " + err.Current
+}
+
+// Platform returns the platform of recordings made by this program, in the
+// form GOOS/GOARCH.
+func Platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
+
+// Record is a single callback delivered by Steam.
+type Record struct {
+	// Time is when the callback was delivered.
+	Time time.Time
+	// CallbackID is the type of the callback.
+	CallbackID steamworks.CallbackID
+	// Call is the API call this callback is the result of, or 0 if the
+	// callback was broadcast.
+	Call steamworks.APICall
+	// IOFailure is true if Steam reported an I/O failure for Call.
+	IOFailure bool
+	// Data is the callback struct, in the memory layout used by the
+	// Steamworks SDK on the platform the recording was made on.
+	Data []byte
+}
+
+const (
+	flagIOFailure = 1 << iota
+)
+
+// recordHeaderSize is the size of a record before its data: time (8 bytes),
+// callback ID (4), API call (8), flags (1), and data length (4).
+const recordHeaderSize = 8 + 4 + 8 + 1 + 4
+
+// Writer writes a recording. All integers are little-endian.
+//
+// The file starts with the 8-byte magic string STEAMREC, a 2-byte version
+// number, and the platform as a 1-byte length followed by that many bytes.
+// Each record is then written as the time in nanoseconds since the Unix
+// epoch (8 bytes), the callback ID (4 bytes), the API call (8 bytes), flags
+// (1 byte, with bit 0 set for an I/O failure), the length of the data (4
+// bytes), and the data.
+type Writer struct {
+	w   *bufio.Writer
+	buf [recordHeaderSize]byte
+}
+
+// NewWriter writes the header of a recording made on this platform to w and
+// returns a Writer for its records. Writes are buffered, so Flush must be
+// called once all records have been written.
+func NewWriter(w io.Writer) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	platform := Platform()
+
+	var header [len(magic) + 2 + 1]byte
+	copy(header[:], magic)
+	binary.LittleEndian.PutUint16(header[len(magic):], Version)
+	header[len(magic)+2] = byte(len(platform))
+
+	if _, err := bw.Write(header[:]); err != nil {
+		return nil, err
+	}
+	if _, err := bw.WriteString(platform); err != nil {
+		return nil, err
+	}
+
+	return &Writer{w: bw}, nil
+}
+
+// Write adds a record to the recording.
+func (w *Writer) Write(r Record) error {
+	binary.LittleEndian.PutUint64(w.buf[0:], uint64(r.Time.UnixNano()))
+	binary.LittleEndian.PutUint32(w.buf[8:], uint32(r.CallbackID))
+	binary.LittleEndian.PutUint64(w.buf[12:], uint64(r.Call))
+	w.buf[20] = 0
+	if r.IOFailure {
+		w.buf[20] |= flagIOFailure
+	}
+	binary.LittleEndian.PutUint32(w.buf[21:], uint32(len(r.Data)))
+
+	if _, err := w.w.Write(w.buf[:]); err != nil {
+		return err
+	}
+	_, err := w.w.Write(r.Data)
+	return err
+}
+
+// Flush writes any buffered records to the underlying io.Writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Reader reads a recording.
+type Reader struct {
+	r        *bufio.Reader
+	platform string
+	buf      [recordHeaderSize]byte
+}
+
+// NewReader reads the header of a recording from r and returns a Reader for
+// its records.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+
+	var header [len(magic) + 2 + 1]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, ErrNotRecording
+		}
+		return nil, err
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, ErrNotRecording
+	}
+	if binary.LittleEndian.Uint16(header[len(magic):]) != Version {
+		return nil, ErrVersion
+	}
+
+	platform := make([]byte, header[len(magic)+2])
+	if _, err := io.ReadFull(br, platform); err != nil {
+		return nil, noEOF(err)
+	}
+
+	return &Reader{r: br, platform: string(platform)}, nil
+}
+
+// Platform returns the platform the recording was made on, in the form
+// GOOS/GOARCH.
+func (r *Reader) Platform() string {
+	return r.platform
+}
+
+// CheckPlatform returns a *PlatformError if the recording cannot be replayed
+// on this platform.
+func (r *Reader) CheckPlatform() error {
+	if current := Platform(); r.platform != current {
+		return &PlatformError{Recorded: r.platform, Current: current}
+	}
+	return nil
+}
+
+// Next returns the next record. At the end of the recording, Next returns
+// io.EOF.
+func (r *Reader) Next() (Record, error) {
+	// io.ReadFull only returns io.EOF if no bytes were read, which is the
+	// end of the recording.
+	if _, err := io.ReadFull(r.r, r.buf[:]); err != nil {
+		return Record{}, err
+	}
+
+	rec := Record{
+		Time:       time.Unix(0, int64(binary.LittleEndian.Uint64(r.buf[0:]))),
+		CallbackID: steamworks.CallbackID(binary.LittleEndian.Uint32(r.buf[8:])),
+		Call:       steamworks.APICall(binary.LittleEndian.Uint64(r.buf[12:])),
+		IOFailure:  r.buf[20]&flagIOFailure != 0,
+	}
+
+	size := binary.LittleEndian.Uint32(r.buf[21:])
+	if size > maxDataSize {
+		return Record{}, errors.New("steamreplay: callback data too large (" + strconv.FormatUint(uint64(size), 10) + " bytes)")
+	}
+
+	rec.Data = make([]byte, size)
+	if _, err := io.ReadFull(r.r, rec.Data); err != nil {
+		return Record{}, noEOF(err)
+	}
+
+	return rec, nil
+}
+
+// maxDataSize is much larger than any callback struct in the Steamworks SDK,
+// but small enough that a corrupted length does not allocate too much memory.
+const maxDataSize = 1 << 20
+
+func noEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+//go:build !cgo || !(386 || amd64) || !(windows || linux || darwin)
+// +build !cgo !386,!amd64 !windows,!linux,!darwin
+
+package steamreplay
+
+import (
+	"io"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// Recorder writes every callback Steam delivers to a recording until Stop is
+// called.
+type Recorder struct{}
+
+// Start returns steamworks.ErrUnsupported in builds without the Steamworks
+// SDK.
+func Start(w io.Writer) (*Recorder, error) {
+	return nil, steamworks.ErrUnsupported
+}
+
+// Stop does nothing in builds without the Steamworks SDK.
+func (r *Recorder) Stop() error {
+	return nil
+}
+
+// Deliver does nothing in builds without the Steamworks SDK.
+func Deliver(rec Record) int {
+	return 0
+}
+
+// Replay returns steamworks.ErrUnsupported in builds without the Steamworks
+// SDK.
+func Replay(r io.Reader) error {
+	return steamworks.ErrUnsupported
+}
@@ -0,0 +1,138 @@
+//go:build cgo && (windows || linux || darwin) && (386 || amd64)
+// +build cgo
+// +build windows linux darwin
+// +build 386 amd64
+
+package steamreplay
+
+import (
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// Recorder writes every callback Steam delivers to a recording until Stop is
+// called.
+type Recorder struct {
+	lock sync.Mutex
+	w    *Writer
+	err  error
+	taps []steamworks.Registration
+	once sync.Once
+}
+
+var recordLock sync.Mutex
+var recording *Recorder
+
+var errAlreadyRecording = errors.New("steamreplay: already recording")
+
+// Start begins recording callbacks to w. Start must be called after
+// steamworks.InitClient or steamworks.InitServer, and only one Recorder can
+// be active at a time.
+func Start(w io.Writer) (*Recorder, error) {
+	recordLock.Lock()
+	defer recordLock.Unlock()
+
+	if recording != nil {
+		return nil, errAlreadyRecording
+	}
+
+	rw, err := NewWriter(w)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Recorder{w: rw}
+	recording = r
+
+	internal.SetRecordCallback(r.record)
+	for callbackType := range internal.CallbackSizes {
+		r.taps = append(r.taps, internal.RegisterTap(callbackType, internal.SideDefault))
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) record(callbackType int32, apiCallID internal.SteamAPICall, ioFailure bool, data []byte) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.err != nil {
+		return
+	}
+
+	r.err = r.w.Write(Record{
+		Time:       time.Now(),
+		CallbackID: steamworks.CallbackID(callbackType),
+		Call:       steamworks.APICall(apiCallID),
+		IOFailure:  ioFailure,
+		Data:       data,
+	})
+}
+
+// Stop stops recording and flushes the recording to the io.Writer passed to
+// Start. It returns the first error encountered while writing. It is safe to
+// call Stop more than once.
+func (r *Recorder) Stop() error {
+	r.once.Do(func() {
+		recordLock.Lock()
+		internal.SetRecordCallback(nil)
+		for _, tap := range r.taps {
+			tap.Unregister()
+		}
+		recording = nil
+		recordLock.Unlock()
+
+		r.lock.Lock()
+		if r.err == nil {
+			r.err = r.w.Flush()
+		}
+		r.lock.Unlock()
+	})
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.err
+}
+
+// Deliver passes a recorded callback to the handlers currently registered for
+// its callback type, or to the handler waiting for its API call, and returns
+// the number of handlers it was passed to.
+//
+// Handlers are run the same way RunCallbacks runs them, including using the
+// executor configured by steamworks.InitClient or steamworks.InitServer.
+// Steam does not need to be initialized.
+func Deliver(rec Record) int {
+	return internal.Replay(int32(rec.CallbackID), internal.SteamAPICall(rec.Call), rec.IOFailure, rec.Data)
+}
+
+// Replay reads a recording from r and passes each record to Deliver, without
+// waiting between records. It returns a *PlatformError without delivering
+// anything if the recording was made on a different platform.
+func Replay(r io.Reader) error {
+	rr, err := NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	if err = rr.CheckPlatform(); err != nil {
+		return err
+	}
+
+	for {
+		rec, err := rr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		Deliver(rec)
+	}
+}
@@ -0,0 +1,201 @@
+package steamtest
+
+import (
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamauth"
+)
+
+type licenseKey struct {
+	steamID steamworks.SteamID
+	appID   steamworks.AppID
+}
+
+type fakeAuth struct {
+	f *Fake
+
+	ticket     []byte
+	nextTicket uint32
+	tickets    map[uint32]bool
+	results    map[steamworks.SteamID]internal.EBeginAuthSessionResult
+	sessions   map[steamworks.SteamID]bool
+	licenses   map[licenseKey]bool
+
+	onValidate hooks[func(steamworks.SteamID, steamworks.SteamID, internal.EAuthSessionResponse)]
+}
+
+// SetAuthTicket sets the ticket returned by steamauth.CreateTicket. If no
+// ticket is set, the ticket is the local SteamID in little-endian byte order.
+func (f *Fake) SetAuthTicket(ticket []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.auth.ticket = append([]byte(nil), ticket...)
+}
+
+// AuthTicketActive returns true if the auth ticket with the specified handle
+// has been created and not canceled.
+func (f *Fake) AuthTicketActive(handle uint32) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.auth.tickets[handle]
+}
+
+// SetBeginAuthSessionError sets the error steamauth.BeginSession returns for
+// steamID. The error must be nil or one of the errors exported by steamauth.
+//
+// By default, BeginSession succeeds unless the ticket is empty or there is
+// already a session for steamID.
+func (f *Fake) SetBeginAuthSessionError(steamID steamworks.SteamID, err error) {
+	var result internal.EBeginAuthSessionResult
+
+	switch err {
+	case nil:
+		result = internal.EBeginAuthSessionResult_OK
+	case steamauth.ErrInvalidTicket:
+		result = internal.EBeginAuthSessionResult_InvalidTicket
+	case steamauth.ErrDuplicateRequest:
+		result = internal.EBeginAuthSessionResult_DuplicateRequest
+	case steamauth.ErrInvalidVersion:
+		result = internal.EBeginAuthSessionResult_InvalidVersion
+	case steamauth.ErrGameMismatch:
+		result = internal.EBeginAuthSessionResult_GameMismatch
+	case steamauth.ErrExpired:
+		result = internal.EBeginAuthSessionResult_ExpiredTicket
+	default:
+		panic("steamtest: unsupported BeginAuthSession error: " + err.Error())
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.auth.results == nil {
+		f.auth.results = make(map[steamworks.SteamID]internal.EBeginAuthSessionResult)
+	}
+	f.auth.results[steamID] = result
+}
+
+// AuthSessionActive returns true if an auth session has been started with
+// steamID and not ended.
+func (f *Fake) AuthSessionActive(steamID steamworks.SteamID) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.auth.sessions[steamID]
+}
+
+// PostAuthSessionStatus simulates Steam's response to an auth ticket
+// validation request. status must not be steamauth.StatusClosed or
+// steamauth.StatusUnknown, as Steam never sends those.
+func (f *Fake) PostAuthSessionStatus(steamID, ownerID steamworks.SteamID, status steamauth.SessionStatus) {
+	if status < steamauth.StatusOK {
+		panic("steamtest: invalid auth session status: " + status.String())
+	}
+
+	response := internal.EAuthSessionResponse(status - 2)
+
+	post(f, &f.auth.onValidate, func(fn func(steamworks.SteamID, steamworks.SteamID, internal.EAuthSessionResponse)) {
+		fn(steamID, ownerID, response)
+	})
+	f.PostEvent(steamworks.ValidateAuthTicketResponse{
+		SteamID:             steamID,
+		AuthSessionResponse: response,
+		OwnerSteamID:        ownerID,
+	})
+}
+
+// SetLicense sets whether steamID owns appID, as reported by
+// steamauth.Session.OwnsDLC.
+func (f *Fake) SetLicense(steamID steamworks.SteamID, appID steamworks.AppID, owned bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.auth.licenses == nil {
+		f.auth.licenses = make(map[licenseKey]bool)
+	}
+	f.auth.licenses[licenseKey{steamID, appID}] = owned
+}
+
+func (a *fakeAuth) GetAuthSessionTicket(ticket []byte) (uint32, int) {
+	a.f.lock.Lock()
+	defer a.f.lock.Unlock()
+
+	data := a.ticket
+	if data == nil {
+		id := uint64(a.f.steamID)
+		data = make([]byte, 8)
+		for i := range data {
+			data[i] = byte(id >> (8 * uint(i)))
+		}
+	}
+
+	if len(data) > len(ticket) {
+		return 0, 0
+	}
+
+	if a.tickets == nil {
+		a.tickets = make(map[uint32]bool)
+	}
+	a.nextTicket++
+	a.tickets[a.nextTicket] = true
+
+	return a.nextTicket, copy(ticket, data)
+}
+
+func (a *fakeAuth) CancelAuthTicket(handle uint32) {
+	a.f.lock.Lock()
+	defer a.f.lock.Unlock()
+
+	delete(a.tickets, handle)
+}
+
+func (a *fakeAuth) BeginAuthSession(ticket []byte, steamID steamworks.SteamID) internal.EBeginAuthSessionResult {
+	a.f.lock.Lock()
+	defer a.f.lock.Unlock()
+
+	if len(ticket) == 0 {
+		return internal.EBeginAuthSessionResult_InvalidTicket
+	}
+
+	if a.sessions[steamID] {
+		return internal.EBeginAuthSessionResult_DuplicateRequest
+	}
+
+	if result, ok := a.results[steamID]; ok && result != internal.EBeginAuthSessionResult_OK {
+		return result
+	}
+
+	if a.sessions == nil {
+		a.sessions = make(map[steamworks.SteamID]bool)
+	}
+	a.sessions[steamID] = true
+
+	return internal.EBeginAuthSessionResult_OK
+}
+
+func (a *fakeAuth) EndAuthSession(steamID steamworks.SteamID) {
+	a.f.lock.Lock()
+	defer a.f.lock.Unlock()
+
+	delete(a.sessions, steamID)
+}
+
+func (a *fakeAuth) UserHasLicenseForApp(steamID steamworks.SteamID, appID steamworks.AppID) internal.EUserHasLicenseForAppResult {
+	a.f.lock.Lock()
+	defer a.f.lock.Unlock()
+
+	if !a.sessions[steamID] {
+		return internal.EUserHasLicenseForAppResult_EUserHasLicenseResultNoAuth
+	}
+
+	if a.licenses[licenseKey{steamID, appID}] {
+		return internal.EUserHasLicenseForAppResult_EUserHasLicenseResultHasLicense
+	}
+
+	return internal.EUserHasLicenseForAppResult_EUserHasLicenseResultDoesNotHaveLicense
+}
+
+func (a *fakeAuth) OnValidateAuthTicketResponse(fn func(steamID, ownerID steamworks.SteamID, response internal.EAuthSessionResponse)) steamworks.Registration {
+	return register(a.f, steamworks.ValidateAuthTicketResponse{}.CallbackID(), &a.onValidate, fn)
+}
@@ -0,0 +1,414 @@
+package steamtest
+
+import (
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamcontroller"
+)
+
+// ControllerState is the state of a simulated controller.
+type ControllerState struct {
+	// GamepadIndex is the XInput index of the controller, or -1 if the
+	// controller is not emulating a gamepad.
+	GamepadIndex int
+
+	Motion steamcontroller.ControllerMotionData
+
+	// LEDColor is the color most recently set by steamcontroller.SetLEDColor.
+	// LEDColorSet is false if the color has not been set or was reset.
+	LEDColor    [3]uint8
+	LEDColorSet bool
+
+	// ActionSet is the currently active action set.
+	ActionSet steamcontroller.ActionSetHandle
+
+	Digital map[steamcontroller.DigitalActionHandle]DigitalActionState
+	Analog  map[steamcontroller.AnalogActionHandle]AnalogActionState
+
+	// Haptics records the haptic feedback sent to the controller.
+	Haptics []HapticPulse
+	// LeftVibration and RightVibration are the motor speeds most recently
+	// set by steamcontroller.TriggerVibration.
+	LeftVibration, RightVibration uint16
+}
+
+// DigitalActionState is the state of a digital action on a simulated
+// controller.
+type DigitalActionState struct {
+	State   bool
+	Active  bool
+	Origins []steamcontroller.ActionOrigin
+}
+
+// AnalogActionState is the state of an analog action on a simulated
+// controller.
+type AnalogActionState struct {
+	X, Y    float32
+	Mode    steamcontroller.SourceMode
+	Active  bool
+	Origins []steamcontroller.ActionOrigin
+	// MomentumStopped is set by steamcontroller.StopAnalogActionMomentum.
+	MomentumStopped bool
+}
+
+// HapticPulse is a haptic pulse sent to a simulated controller. For a single
+// pulse, Off and Repeat are zero.
+type HapticPulse struct {
+	Pad      steamcontroller.Pad
+	Duration uint16
+	Off      uint16
+	Repeat   uint16
+}
+
+type fakeController struct {
+	f *Fake
+
+	controllers []steamcontroller.Handle
+	states      map[steamcontroller.Handle]*ControllerState
+	actionSets  map[string]steamcontroller.ActionSetHandle
+	digital     map[string]steamcontroller.DigitalActionHandle
+	analog      map[string]steamcontroller.AnalogActionHandle
+	nextHandle  uint64
+	initialized bool
+	frames      int
+}
+
+// ConnectController adds a simulated controller and returns its handle.
+func (f *Fake) ConnectController(state ControllerState) steamcontroller.Handle {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	c := &f.controller
+
+	c.nextHandle++
+	handle := steamcontroller.Handle(c.nextHandle)
+
+	if c.states == nil {
+		c.states = make(map[steamcontroller.Handle]*ControllerState)
+	}
+	c.controllers = append(c.controllers, handle)
+	c.states[handle] = &state
+
+	return handle
+}
+
+// DisconnectController removes a simulated controller.
+func (f *Fake) DisconnectController(handle steamcontroller.Handle) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	c := &f.controller
+
+	delete(c.states, handle)
+	for i, h := range c.controllers {
+		if h == handle {
+			c.controllers = append(c.controllers[:i:i], c.controllers[i+1:]...)
+			break
+		}
+	}
+}
+
+// ControllerState returns a copy of the state of a simulated controller.
+func (f *Fake) ControllerState(handle steamcontroller.Handle) (ControllerState, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	state, ok := f.controller.states[handle]
+	if !ok {
+		return ControllerState{}, false
+	}
+
+	return *state, true
+}
+
+// UpdateController calls update with the state of a simulated controller.
+// It does nothing if there is no controller with the specified handle.
+func (f *Fake) UpdateController(handle steamcontroller.Handle, update func(*ControllerState)) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if state, ok := f.controller.states[handle]; ok {
+		update(state)
+	}
+}
+
+// ControllerFrames returns the number of calls to steamcontroller.RunFrame.
+func (f *Fake) ControllerFrames() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.controller.frames
+}
+
+// DefineActionSet makes an action set from the game's VDF file available
+// by name and returns its handle.
+func (f *Fake) DefineActionSet(name string) steamcontroller.ActionSetHandle {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	c := &f.controller
+
+	if c.actionSets == nil {
+		c.actionSets = make(map[string]steamcontroller.ActionSetHandle)
+	}
+	if h, ok := c.actionSets[name]; ok {
+		return h
+	}
+
+	c.nextHandle++
+	h := steamcontroller.ActionSetHandle(c.nextHandle)
+	c.actionSets[name] = h
+	return h
+}
+
+// DefineDigitalAction makes a digital action from the game's VDF file
+// available by name and returns its handle.
+func (f *Fake) DefineDigitalAction(name string) steamcontroller.DigitalActionHandle {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	c := &f.controller
+
+	if c.digital == nil {
+		c.digital = make(map[string]steamcontroller.DigitalActionHandle)
+	}
+	if h, ok := c.digital[name]; ok {
+		return h
+	}
+
+	c.nextHandle++
+	h := steamcontroller.DigitalActionHandle(c.nextHandle)
+	c.digital[name] = h
+	return h
+}
+
+// DefineAnalogAction makes an analog action from the game's VDF file
+// available by name and returns its handle.
+func (f *Fake) DefineAnalogAction(name string) steamcontroller.AnalogActionHandle {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	c := &f.controller
+
+	if c.analog == nil {
+		c.analog = make(map[string]steamcontroller.AnalogActionHandle)
+	}
+	if h, ok := c.analog[name]; ok {
+		return h
+	}
+
+	c.nextHandle++
+	h := steamcontroller.AnalogActionHandle(c.nextHandle)
+	c.analog[name] = h
+	return h
+}
+
+// state returns the state of a controller. The caller must hold the lock.
+func (c *fakeController) state(handle steamcontroller.Handle) *ControllerState {
+	if state, ok := c.states[handle]; ok {
+		return state
+	}
+
+	// Steam ignores calls for controllers that are not connected.
+	return &ControllerState{GamepadIndex: -1}
+}
+
+func (c *fakeController) Init() bool {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	c.initialized = true
+	return true
+}
+
+func (c *fakeController) Shutdown() bool {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	c.initialized = false
+	return true
+}
+
+func (c *fakeController) RunFrame() {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	c.frames++
+}
+
+func (c *fakeController) GetConnectedControllers(handles []internal.ControllerHandle) int {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return copy(handles, c.controllers)
+}
+
+func (c *fakeController) GetControllerForGamepadIndex(index int32) internal.ControllerHandle {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	for _, h := range c.controllers {
+		if c.states[h].GamepadIndex == int(index) {
+			return h
+		}
+	}
+
+	return 0
+}
+
+func (c *fakeController) GetGamepadIndexForController(controller internal.ControllerHandle) int32 {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return int32(c.state(controller).GamepadIndex)
+}
+
+func (c *fakeController) GetMotionData(controller internal.ControllerHandle) (rotQuat [4]float32, posAccel, rotVel [3]float32) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	motion := c.state(controller).Motion
+
+	return motion.RotQuat, motion.PosAccel, motion.RotVel
+}
+
+func (c *fakeController) SetLEDColor(controller internal.ControllerHandle, r, g, b uint8, flags internal.ESteamControllerLEDFlag) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	state := c.state(controller)
+	if flags == internal.ESteamControllerLEDFlag_RestoreUserDefault {
+		state.LEDColor = [3]uint8{}
+		state.LEDColorSet = false
+		return
+	}
+
+	state.LEDColor = [3]uint8{r, g, b}
+	state.LEDColorSet = true
+}
+
+func (c *fakeController) ShowBindingPanel(controller internal.ControllerHandle) bool {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	_, ok := c.states[controller]
+	return ok
+}
+
+func (c *fakeController) GetActionSetHandle(name string) internal.ControllerActionSetHandle {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return c.actionSets[name]
+}
+
+func (c *fakeController) ActivateActionSet(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	c.state(controller).ActionSet = actionSet
+}
+
+func (c *fakeController) GetCurrentActionSet(controller internal.ControllerHandle) internal.ControllerActionSetHandle {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return c.state(controller).ActionSet
+}
+
+func (c *fakeController) GetDigitalActionHandle(name string) internal.ControllerDigitalActionHandle {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return c.digital[name]
+}
+
+func (c *fakeController) GetDigitalActionData(controller internal.ControllerHandle, action internal.ControllerDigitalActionHandle) (state, active bool) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	data := c.state(controller).Digital[action]
+
+	return data.State, data.Active
+}
+
+func (c *fakeController) GetDigitalActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerDigitalActionHandle, origins []internal.EControllerActionOrigin) int {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return copy(origins, c.state(controller).Digital[action].Origins)
+}
+
+func (c *fakeController) GetAnalogActionHandle(name string) internal.ControllerAnalogActionHandle {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return c.analog[name]
+}
+
+func (c *fakeController) GetAnalogActionData(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) (x, y float32, mode internal.EControllerSourceMode, active bool) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	data := c.state(controller).Analog[action]
+
+	return data.X, data.Y, data.Mode, data.Active
+}
+
+func (c *fakeController) GetAnalogActionOrigins(controller internal.ControllerHandle, actionSet internal.ControllerActionSetHandle, action internal.ControllerAnalogActionHandle, origins []internal.EControllerActionOrigin) int {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	return copy(origins, c.state(controller).Analog[action].Origins)
+}
+
+func (c *fakeController) StopAnalogActionMomentum(controller internal.ControllerHandle, action internal.ControllerAnalogActionHandle) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	state := c.state(controller)
+	if data, ok := state.Analog[action]; ok {
+		data.MomentumStopped = true
+		state.Analog[action] = data
+	}
+}
+
+func (c *fakeController) TriggerHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec uint16) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	state := c.state(controller)
+	state.Haptics = append(state.Haptics, HapticPulse{
+		Pad:      targetPad,
+		Duration: durationMicroSec,
+	})
+}
+
+func (c *fakeController) TriggerRepeatedHapticPulse(controller internal.ControllerHandle, targetPad internal.ESteamControllerPad, durationMicroSec, offMicroSec, repeat uint16) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	state := c.state(controller)
+	state.Haptics = append(state.Haptics, HapticPulse{
+		Pad:      targetPad,
+		Duration: durationMicroSec,
+		Off:      offMicroSec,
+		Repeat:   repeat,
+	})
+}
+
+func (c *fakeController) TriggerVibration(controller internal.ControllerHandle, leftSpeed, rightSpeed uint16) {
+	c.f.lock.Lock()
+	defer c.f.lock.Unlock()
+
+	state := c.state(controller)
+	state.LeftVibration = leftSpeed
+	state.RightVibration = rightSpeed
+}
+
+func (c *fakeController) GetGlyphForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return ""
+}
+
+func (c *fakeController) GetStringForActionOrigin(origin internal.EControllerActionOrigin) string {
+	return origin.String()
+}
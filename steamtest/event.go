@@ -0,0 +1,35 @@
+package steamtest
+
+import "github.com/BenLubar/steamworks"
+
+// PostEvent queues e to be delivered by RunCallbacks to subscribers of its
+// type, such as channels returned by steamworks.Subscribe.
+//
+// The Post* methods for specific callbacks, such as PostP2PSessionRequest,
+// also post the matching Event, so PostEvent is only needed for callbacks
+// that the Fake does not otherwise simulate.
+func (f *Fake) PostEvent(e steamworks.Event) {
+	post(f, f.eventHooks(e.CallbackID()), func(fn func(steamworks.Event)) {
+		fn(e)
+	})
+}
+
+// OnEvent implements steamworks.Backend.
+func (f *Fake) OnEvent(id steamworks.CallbackID, fn func(steamworks.Event)) steamworks.Registration {
+	return register(f, id, f.eventHooks(id), fn)
+}
+
+func (f *Fake) eventHooks(id steamworks.CallbackID) *hooks[func(steamworks.Event)] {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.events == nil {
+		f.events = make(map[steamworks.CallbackID]*hooks[func(steamworks.Event)])
+	}
+	h, ok := f.events[id]
+	if !ok {
+		h = new(hooks[func(steamworks.Event)])
+		f.events[id] = h
+	}
+	return h
+}
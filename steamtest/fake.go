@@ -0,0 +1,427 @@
+// Package steamtest provides an in-process fake of the Steamworks API for
+// tests.
+//
+// A Fake implements steamworks.Backend entirely in Go, so code that uses the
+// steamworks packages can be tested without the Steam client or the
+// Steamworks SDK, including builds where the SDK is not available at all.
+// Tests script the fake's state (users, P2P packets, auth ticket validation
+// results, controller state, voice data, parental settings) and post
+// callbacks, which are delivered by steamworks.RunCallbacks just like real
+// Steam callbacks.
+//
+// Example:
+//
+//    func TestLobby(t *testing.T) {
+//        fake := steamtest.New(480, localUser)
+//        defer fake.Install()()
+//
+//        if err := steamworks.InitClient(false); err != nil {
+//            t.Fatal(err)
+//        }
+//        defer steamworks.Shutdown()
+//
+//        fake.DeliverPacket(remoteUser, 0, []byte("hello"))
+//        fake.PostP2PSessionRequest(remoteUser)
+//        steamworks.RunCallbacks()
+//
+//        ...
+//    }
+package steamtest
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// Fake is a steamworks.Backend that simulates Steam in memory.
+//
+// All methods on Fake are safe to call concurrently. Callbacks posted to a
+// Fake are queued and delivered in order by RunCallbacks, on the goroutine
+// that calls RunCallbacks.
+type Fake struct {
+	lock sync.Mutex
+
+	appID   steamworks.AppID
+	steamID steamworks.SteamID
+
+	initialized bool
+	server      bool
+	initErr     error
+	restart     bool
+	failures    map[steamworks.APICall]steamworks.APICallFailure
+	lastCall    steamworks.APICall
+
+	pending  []func()
+	nextHook uint64
+	live     map[uint64]steamworks.RegistrationInfo
+	events   map[steamworks.CallbackID]*hooks[func(steamworks.Event)]
+
+	auth       fakeAuth
+	networking fakeNetworking
+	utils      fakeUtils
+	controller fakeController
+	voice      fakeVoice
+	parental   fakeParentalSettings
+	userStats  fakeUserStats
+	gameServer fakeGameServer
+}
+
+var _ steamworks.Backend = (*Fake)(nil)
+
+// New returns a Fake for the specified app, logged in as steamID.
+func New(appID steamworks.AppID, steamID steamworks.SteamID) *Fake {
+	f := &Fake{
+		appID:   appID,
+		steamID: steamID,
+	}
+
+	f.auth.f = f
+	f.networking.f = f
+	f.utils.f = f
+	f.controller.f = f
+	f.voice.f = f
+	f.parental.f = f
+	f.userStats.f = f
+	f.gameServer.f = f
+
+	f.utils.state.IPCountry = "US"
+	f.utils.state.BatteryPower = 255
+	f.networking.allowRelay = true
+
+	return f
+}
+
+// Install makes f the steamworks.Backend and returns a function that restores
+// the previous Backend.
+//
+// Example:
+//
+//    fake := steamtest.New(appID, steamID)
+//    defer fake.Install()()
+func (f *Fake) Install() (restore func()) {
+	previous := steamworks.SetBackend(f)
+
+	return func() {
+		steamworks.SetBackend(previous)
+	}
+}
+
+// SetInitError causes future calls to InitClient and InitServer to fail with
+// err. Passing nil allows initialization to succeed again.
+func (f *Fake) SetInitError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.initErr = err
+}
+
+// SetRestartApp sets the value returned by RestartAppIfNecessary.
+func (f *Fake) SetRestartApp(restart bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.restart = restart
+}
+
+// SetSteamID changes the SteamID of the current user or game server.
+func (f *Fake) SetSteamID(steamID steamworks.SteamID) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.steamID = steamID
+}
+
+// SetAPICallFailure sets the failure reason reported for call.
+func (f *Fake) SetAPICallFailure(call steamworks.APICall, reason steamworks.APICallFailure) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.failures == nil {
+		f.failures = make(map[steamworks.APICall]steamworks.APICallFailure)
+	}
+	f.failures[call] = reason
+}
+
+// Initialized returns true if InitClient or InitServer has succeeded and
+// Shutdown has not been called since.
+func (f *Fake) Initialized() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.initialized
+}
+
+// Post queues fn to be called by the next call to RunCallbacks. This can be
+// used to simulate callbacks that the Fake does not otherwise support.
+func (f *Fake) Post(fn func()) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.pending = append(f.pending, fn)
+}
+
+// Pending returns the number of callbacks waiting for RunCallbacks.
+func (f *Fake) Pending() int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return len(f.pending)
+}
+
+// RestartAppIfNecessary implements steamworks.Backend.
+func (f *Fake) RestartAppIfNecessary(ownAppID steamworks.AppID) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.restart
+}
+
+// InitClient implements steamworks.Backend.
+func (f *Fake) InitClient() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.initErr != nil {
+		return f.initErr
+	}
+
+	f.initialized = true
+	f.server = false
+	return nil
+}
+
+// InitServer implements steamworks.Backend.
+func (f *Fake) InitServer(ip uint32, steamPort, gamePort, queryPort uint16, serverMode steamworks.ServerMode, version string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.initErr != nil {
+		return f.initErr
+	}
+
+	f.initialized = true
+	f.server = true
+	return nil
+}
+
+// Shutdown implements steamworks.Backend. Pending callbacks are discarded.
+func (f *Fake) Shutdown() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.initialized = false
+	f.server = false
+	f.pending = nil
+}
+
+// RunCallbacks implements steamworks.Backend. Callbacks posted while
+// RunCallbacks is running are delivered by the next call. Each callback is
+// run by steamworks.Execute.
+func (f *Fake) RunCallbacks() {
+	f.lock.Lock()
+	pending := f.pending
+	f.pending = nil
+	f.lock.Unlock()
+
+	for _, fn := range pending {
+		steamworks.Execute(fn)
+	}
+}
+
+// IsGameServer implements steamworks.Backend.
+func (f *Fake) IsGameServer() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.server
+}
+
+// Side implements steamworks.Backend. The game client and game server share
+// the state of the Fake, so Side returns f.
+func (f *Fake) Side(gameServer bool) steamworks.Backend {
+	return f
+}
+
+// AppID implements steamworks.Backend.
+func (f *Fake) AppID() steamworks.AppID {
+	// immutable; no need to lock
+	return f.appID
+}
+
+// SteamID implements steamworks.Backend.
+func (f *Fake) SteamID() steamworks.SteamID {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.steamID
+}
+
+// APICallFailureReason implements steamworks.Backend.
+func (f *Fake) APICallFailureReason(call steamworks.APICall) steamworks.APICallFailure {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if reason, ok := f.failures[call]; ok {
+		return reason
+	}
+
+	return internal.ESteamAPICallFailure_None
+}
+
+// Auth implements steamworks.Backend.
+func (f *Fake) Auth() steamworks.AuthBackend { return &f.auth }
+
+// Networking implements steamworks.Backend.
+func (f *Fake) Networking() steamworks.NetworkingBackend { return &f.networking }
+
+// Utils implements steamworks.Backend.
+func (f *Fake) Utils() steamworks.UtilsBackend { return &f.utils }
+
+// Controller implements steamworks.Backend.
+func (f *Fake) Controller() steamworks.ControllerBackend { return &f.controller }
+
+// Voice implements steamworks.Backend.
+func (f *Fake) Voice() steamworks.VoiceBackend { return &f.voice }
+
+// ParentalSettings implements steamworks.Backend.
+func (f *Fake) ParentalSettings() steamworks.ParentalSettingsBackend { return &f.parental }
+
+// UserStats implements steamworks.Backend.
+func (f *Fake) UserStats() steamworks.UserStatsBackend { return &f.userStats }
+
+// GameServer implements steamworks.Backend.
+func (f *Fake) GameServer() steamworks.GameServerBackend { return &f.gameServer }
+
+// hooks is a set of registered callback functions of one type.
+type hooks[F any] map[uint64]F
+
+type registration struct {
+	once       sync.Once
+	unregister func()
+}
+
+func (r *registration) Unregister() {
+	r.once.Do(r.unregister)
+}
+
+// register adds fn to h and records the registration for Registrations.
+// callbackID is the type of callback the hooks in h receive.
+func register[F any](f *Fake, callbackID steamworks.CallbackID, h *hooks[F], fn F) steamworks.Registration {
+	// skip runtime.Callers, register, and the On* method
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:])
+
+	return addHook(f, callbackID, 0, h, fn, pcs[:n])
+}
+
+// registerCall is like register, but the registration is for the result of
+// call. The caller is responsible for removing it once the result has been
+// delivered, using deliverCall.
+func registerCall[F any](f *Fake, callbackID steamworks.CallbackID, call steamworks.APICall, h *hooks[F], fn F) steamworks.Registration {
+	// skip runtime.Callers, registerCall, and the On* method
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:])
+
+	return addHook(f, callbackID, call, h, fn, pcs[:n])
+}
+
+func addHook[F any](f *Fake, callbackID steamworks.CallbackID, call steamworks.APICall, h *hooks[F], fn F, pcs []uintptr) steamworks.Registration {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if *h == nil {
+		*h = make(hooks[F])
+	}
+	if f.live == nil {
+		f.live = make(map[uint64]steamworks.RegistrationInfo)
+	}
+
+	f.nextHook++
+	id := f.nextHook
+	(*h)[id] = fn
+	f.live[id] = steamworks.RegistrationInfo{
+		ID:         id,
+		CallbackID: callbackID,
+		Call:       call,
+		GameServer: f.server,
+		Registered: time.Now(),
+		PCs:        append([]uintptr(nil), pcs...),
+	}
+
+	return &registration{
+		unregister: func() {
+			f.lock.Lock()
+			defer f.lock.Unlock()
+
+			delete(*h, id)
+			delete(f.live, id)
+		},
+	}
+}
+
+// deliverCall removes the registrations in h that are waiting for the result
+// of call and passes each of them to deliver, in the order they were
+// registered.
+func deliverCall[F any](f *Fake, h *hooks[F], call steamworks.APICall, deliver func(F)) {
+	f.lock.Lock()
+	var ids []uint64
+	for id := range *h {
+		if f.live[id].Call == call {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	fns := make([]F, len(ids))
+	for i, id := range ids {
+		fns[i] = (*h)[id]
+		delete(*h, id)
+		delete(f.live, id)
+	}
+	f.lock.Unlock()
+
+	for _, fn := range fns {
+		deliver(fn)
+	}
+}
+
+// Registrations implements steamworks.Backend.
+func (f *Fake) Registrations() []steamworks.RegistrationInfo {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	regs := make([]steamworks.RegistrationInfo, 0, len(f.live))
+	for _, r := range f.live {
+		regs = append(regs, r)
+	}
+	sort.Slice(regs, func(i, j int) bool { return regs[i].ID < regs[j].ID })
+
+	return regs
+}
+
+// post queues a callback that calls each function registered in h, in the
+// order they were registered. The set of functions is determined when the
+// callback is delivered, not when it is posted.
+func post[F any](f *Fake, h *hooks[F], call func(F)) {
+	f.Post(func() {
+		f.lock.Lock()
+		ids := make([]uint64, 0, len(*h))
+		for id := range *h {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		fns := make([]F, len(ids))
+		for i, id := range ids {
+			fns[i] = (*h)[id]
+		}
+		f.lock.Unlock()
+
+		for _, fn := range fns {
+			call(fn)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package steamtest_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamauth"
+	"github.com/BenLubar/steamworks/steamcontroller"
+	"github.com/BenLubar/steamworks/steamnet"
+	"github.com/BenLubar/steamworks/steamtest"
+	"github.com/BenLubar/steamworks/steamutils"
+)
+
+const (
+	localUser  steamworks.SteamID = 76561197960287930
+	remoteUser steamworks.SteamID = 76561197960287931
+)
+
+// TestOffline runs each wrapper package against the fake, without a Steam
+// client or the Steamworks SDK.
+func TestOffline(t *testing.T) {
+	fake := steamtest.New(480, localUser)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	if id := steamworks.GetBackend().SteamID(); id != localUser {
+		t.Errorf("SteamID: got %v, expected %v", id, localUser)
+	}
+
+	t.Run("steamnet", func(t *testing.T) {
+		var requested []steamworks.SteamID
+		reg := steamnet.Listen(func(user steamworks.SteamID) bool {
+			requested = append(requested, user)
+			return true
+		})
+		defer reg.Unregister()
+
+		fake.PostP2PSessionRequest(remoteUser)
+		steamworks.RunCallbacks()
+
+		if len(requested) != 1 || requested[0] != remoteUser {
+			t.Errorf("session requests: got %v, expected [%v]", requested, remoteUser)
+		}
+		if !fake.SessionAccepted(remoteUser) {
+			t.Error("session was not accepted")
+		}
+
+		fake.DeliverPacket(remoteUser, 1, []byte("ping"))
+		if data, user := steamnet.ReadPacket(1); user != remoteUser || string(data) != "ping" {
+			t.Errorf("ReadPacket: got (%q, %v), expected (\"ping\", %v)", data, user, remoteUser)
+		}
+		if data, user := steamnet.ReadPacket(1); data != nil || user != 0 {
+			t.Errorf("ReadPacket: got (%q, %v), expected no packet", data, user)
+		}
+
+		if err := steamnet.SendPacket(remoteUser, []byte("pong"), steamnet.Reliable, 1); err != nil {
+			t.Errorf("SendPacket: %v", err)
+		}
+
+		fake.SetSendBufferFull(true)
+		if err := steamnet.SendPacket(remoteUser, []byte("lost"), steamnet.Reliable, 1); err != steamnet.ErrBufferFull {
+			t.Errorf("SendPacket with a full buffer: got error %v, expected %v", err, steamnet.ErrBufferFull)
+		}
+		fake.SetSendBufferFull(false)
+
+		if err := steamnet.SendPacket(remoteUser, []byte("again"), steamnet.Reliable, 1); err != nil {
+			t.Errorf("SendPacket: %v", err)
+		}
+
+		sent := fake.TakeSentPackets()
+		if len(sent) != 2 || !bytes.Equal(sent[0].Data, []byte("pong")) || !bytes.Equal(sent[1].Data, []byte("again")) {
+			t.Errorf("sent packets: got %+v, expected pong and again", sent)
+		}
+		for _, p := range sent {
+			if p.Remote != remoteUser || p.Channel != 1 || p.Reliability != steamnet.Reliable {
+				t.Errorf("sent packet: got %+v, expected reliable packet to %v on channel 1", p, remoteUser)
+			}
+		}
+	})
+
+	t.Run("steamauth", func(t *testing.T) {
+		ticket, cancel := steamauth.CreateTicket()
+		defer cancel()
+
+		sess, err := steamauth.BeginSession(ticket, remoteUser)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sess.Close()
+
+		if status := sess.Status(); status != steamauth.StatusUnknown {
+			t.Errorf("status before validation: got %v, expected %v", status, steamauth.StatusUnknown)
+		}
+
+		fake.PostAuthSessionStatus(remoteUser, remoteUser, steamauth.StatusOK)
+		steamworks.RunCallbacks()
+
+		if status := <-sess.Change(); status != steamauth.StatusOK {
+			t.Errorf("status after validation: got %v, expected %v", status, steamauth.StatusOK)
+		}
+		if owner := sess.OwnerID(); owner != remoteUser {
+			t.Errorf("owner: got %v, expected %v", owner, remoteUser)
+		}
+
+		fake.SetBeginAuthSessionError(localUser, steamauth.ErrExpired)
+		if _, err := steamauth.BeginSession(ticket, localUser); err != steamauth.ErrExpired {
+			t.Errorf("BeginSession: got error %v, expected %v", err, steamauth.ErrExpired)
+		}
+	})
+
+	t.Run("steamutils", func(t *testing.T) {
+		state := fake.UtilsState()
+		state.IPCountry = "NZ"
+		fake.SetUtilsState(state)
+
+		changed := 0
+		reg := steamutils.OnIPCountryChanged(func() { changed++ })
+		defer reg.Unregister()
+
+		fake.PostIPCountryChanged("NZ")
+		steamworks.RunCallbacks()
+
+		if changed != 1 {
+			t.Errorf("OnIPCountryChanged called %d times, expected 1", changed)
+		}
+		if country := steamutils.IPCountry(); country != "NZ" {
+			t.Errorf("IPCountry: got %q, expected \"NZ\"", country)
+		}
+	})
+
+	t.Run("steamcontroller", func(t *testing.T) {
+		jump := fake.DefineDigitalAction("jump")
+		controller := fake.ConnectController(steamtest.ControllerState{
+			GamepadIndex: -1,
+			Digital: map[steamcontroller.DigitalActionHandle]steamtest.DigitalActionState{
+				jump: {State: true, Active: true},
+			},
+		})
+
+		steamcontroller.RunFrame()
+
+		if connected := steamcontroller.GetConnectedControllers(); len(connected) != 1 || connected[0] != controller {
+			t.Errorf("connected controllers: got %v, expected [%v]", connected, controller)
+		}
+		if h := steamcontroller.GetDigitalActionHandle("jump"); h != jump {
+			t.Errorf("GetDigitalActionHandle: got %v, expected %v", h, jump)
+		}
+		if state, active := steamcontroller.GetDigitalActionData(controller, jump); !state || !active {
+			t.Errorf("GetDigitalActionData: got (%v, %v), expected (true, true)", state, active)
+		}
+
+		steamcontroller.SetLEDColor(controller, 255, 0, 0)
+		if state, _ := fake.ControllerState(controller); !state.LEDColorSet || state.LEDColor != [3]uint8{255, 0, 0} {
+			t.Errorf("LED color: got %v (set: %v), expected red", state.LEDColor, state.LEDColorSet)
+		}
+	})
+}
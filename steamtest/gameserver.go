@@ -0,0 +1,84 @@
+package steamtest
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// ServerPacket is a UDP packet exchanged with Steam by a game server in
+// GameSocketShare mode.
+type ServerPacket struct {
+	// Addr is the sender of an incoming packet or the recipient of an
+	// outgoing packet.
+	Addr *net.UDPAddr
+	// Data is the contents of the packet.
+	Data []byte
+}
+
+type fakeGameServer struct {
+	f *Fake
+
+	incoming []ServerPacket
+	outgoing []ServerPacket
+}
+
+// QueueOutgoingServerPacket queues a packet for Steam to send from the game
+// port to addr, which must be an IPv4 address.
+func (f *Fake) QueueOutgoingServerPacket(addr *net.UDPAddr, data []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.gameServer.outgoing = append(f.gameServer.outgoing, ServerPacket{
+		Addr: addr,
+		Data: append([]byte(nil), data...),
+	})
+}
+
+// TakeIncomingServerPackets returns the packets passed to Steam from the game
+// port since the last call to TakeIncomingServerPackets, in the order they
+// were received.
+func (f *Fake) TakeIncomingServerPackets() []ServerPacket {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	incoming := f.gameServer.incoming
+	f.gameServer.incoming = nil
+	return incoming
+}
+
+func (g *fakeGameServer) HandleIncomingPacket(data []byte, srcIP uint32, srcPort uint16) bool {
+	g.f.lock.Lock()
+	defer g.f.lock.Unlock()
+
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, srcIP)
+
+	g.incoming = append(g.incoming, ServerPacket{
+		Addr: &net.UDPAddr{IP: ip, Port: int(srcPort)},
+		Data: append([]byte(nil), data...),
+	})
+
+	return len(data) >= 4 && binary.LittleEndian.Uint32(data) == 0xFFFFFFFF
+}
+
+func (g *fakeGameServer) GetNextOutgoingPacket(buffer []byte) (int, uint32, uint16) {
+	g.f.lock.Lock()
+	defer g.f.lock.Unlock()
+
+	if len(g.outgoing) == 0 {
+		return 0, 0, 0
+	}
+
+	packet := g.outgoing[0]
+	g.outgoing = g.outgoing[1:]
+
+	var ip uint32
+	if ip4 := packet.Addr.IP.To4(); ip4 != nil {
+		ip = binary.BigEndian.Uint32(ip4)
+	}
+
+	// Like Steam, truncate the packet if the buffer is too small.
+	size := copy(buffer, packet.Data)
+
+	return size, ip, uint16(packet.Addr.Port)
+}
@@ -0,0 +1,59 @@
+package steamtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+)
+
+// CheckRegistrations fails t if callback registrations made after it is
+// called are still registered when the test finishes, and logs the stack
+// trace of the code that made each one.
+//
+// The check uses the Backend installed when CheckRegistrations is called, so
+// it should be called after the Fake is installed:
+//
+//    func TestLobby(t *testing.T) {
+//        fake := steamtest.New(480, localUser)
+//        defer fake.Install()()
+//        steamtest.CheckRegistrations(t)
+//
+//        ...
+//    }
+//
+// Test cleanup functions run after deferred calls, so registrations that are
+// released by a deferred steamworks.Shutdown are not reported.
+func CheckRegistrations(t testing.TB) {
+	t.Helper()
+
+	b := steamworks.GetBackend()
+
+	before := make(map[uint64]bool)
+	for _, r := range b.Registrations() {
+		before[r.ID] = true
+	}
+
+	t.Cleanup(func() {
+		var leaked []steamworks.RegistrationInfo
+		for _, r := range b.Registrations() {
+			if !before[r.ID] {
+				leaked = append(leaked, r)
+			}
+		}
+
+		if len(leaked) == 0 {
+			return
+		}
+
+		var buf strings.Builder
+		for _, r := range leaked {
+			buf.WriteString("\n")
+			buf.WriteString(r.CallbackID.String())
+			buf.WriteString(" registered at:\n")
+			buf.WriteString(r.Stack())
+		}
+
+		t.Errorf("steamtest: %d callback registrations were not unregistered:%s", len(leaked), buf.String())
+	})
+}
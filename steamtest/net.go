@@ -0,0 +1,271 @@
+package steamtest
+
+import (
+	"encoding/binary"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamnet"
+)
+
+// Packet is a P2P packet sent or received through a Fake.
+type Packet struct {
+	// Remote is the sender of an incoming packet or the recipient of an
+	// outgoing packet.
+	Remote steamworks.SteamID
+	// Channel is the P2P channel number.
+	Channel int32
+	// Reliability is the send type of an outgoing packet. It is always
+	// zero for incoming packets.
+	Reliability steamnet.Reliability
+	// Data is the contents of the packet.
+	Data []byte
+}
+
+type fakeNetworking struct {
+	f *Fake
+
+	incoming   map[int32][]Packet
+	sent       []Packet
+	accepted   map[steamworks.SteamID]bool
+	states     map[steamworks.SteamID]steamworks.P2PSessionState
+	allowRelay bool
+	bufferFull bool
+
+	onSessionRequest     hooks[func(steamworks.SteamID)]
+	onSessionConnectFail hooks[func(steamworks.SteamID, internal.EP2PSessionError)]
+}
+
+// DeliverPacket queues an incoming P2P packet from remote on the specified
+// channel. The packet is available immediately; it is not a callback.
+func (f *Fake) DeliverPacket(remote steamworks.SteamID, channel int32, data []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.networking.incoming == nil {
+		f.networking.incoming = make(map[int32][]Packet)
+	}
+	f.networking.incoming[channel] = append(f.networking.incoming[channel], Packet{
+		Remote:  remote,
+		Channel: channel,
+		Data:    append([]byte(nil), data...),
+	})
+}
+
+// TakeSentPackets returns the P2P packets sent since the last call to
+// TakeSentPackets, in the order they were sent.
+func (f *Fake) TakeSentPackets() []Packet {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	sent := f.networking.sent
+	f.networking.sent = nil
+	return sent
+}
+
+// SetSendBufferFull makes steamnet.SendPacket fail with
+// steamnet.ErrBufferFull, as if too many bytes were queued to be sent, until
+// it is called again with full set to false.
+func (f *Fake) SetSendBufferFull(full bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.networking.bufferFull = full
+}
+
+// SessionAccepted returns true if a P2P session with remote has been accepted
+// and not closed.
+func (f *Fake) SessionAccepted(remote steamworks.SteamID) bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.networking.accepted[remote]
+}
+
+// PacketRelayAllowed returns the value most recently passed to
+// steamnet.SetAllowPacketRelay. The default is true.
+func (f *Fake) PacketRelayAllowed() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.networking.allowRelay
+}
+
+// SetSessionState sets the state returned by steamnet.GetSessionState for
+// remote. Passing nil removes the session.
+func (f *Fake) SetSessionState(remote steamworks.SteamID, state *steamnet.SessionState) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if state == nil {
+		delete(f.networking.states, remote)
+		return
+	}
+
+	var sessionError internal.EP2PSessionError
+	if code, ok := state.LastError.(steamnet.Error); ok {
+		sessionError = internal.EP2PSessionError(code)
+	}
+
+	var remoteIP uint32
+	if ip4 := state.RemoteIP.To4(); ip4 != nil {
+		remoteIP = binary.BigEndian.Uint32(ip4)
+	}
+
+	if f.networking.states == nil {
+		f.networking.states = make(map[steamworks.SteamID]steamworks.P2PSessionState)
+	}
+	f.networking.states[remote] = steamworks.P2PSessionState{
+		ConnectionActive:     state.ConnectionActive,
+		Connecting:           state.Connecting,
+		SessionError:         sessionError,
+		UsingRelay:           state.UsingRelay,
+		BytesQueuedForSend:   int32(state.BytesQueuedForSend),
+		PacketsQueuedForSend: int32(state.PacketsQueuedForSend),
+		RemoteIP:             remoteIP,
+		RemotePort:           uint16(state.RemotePort),
+	}
+}
+
+// PostP2PSessionRequest simulates remote sending the first packet of a new
+// P2P session.
+func (f *Fake) PostP2PSessionRequest(remote steamworks.SteamID) {
+	post(f, &f.networking.onSessionRequest, func(fn func(steamworks.SteamID)) {
+		fn(remote)
+	})
+	f.PostEvent(steamworks.P2PSessionRequest{SteamIDRemote: remote})
+}
+
+// PostP2PSessionConnectFail simulates a failure to deliver packets to remote.
+// err must be one of the steamnet.Err* errors.
+func (f *Fake) PostP2PSessionConnectFail(remote steamworks.SteamID, err error) {
+	code, ok := err.(steamnet.Error)
+	if !ok {
+		panic("steamtest: unsupported P2P session error: " + err.Error())
+	}
+
+	post(f, &f.networking.onSessionConnectFail, func(fn func(steamworks.SteamID, internal.EP2PSessionError)) {
+		fn(remote, internal.EP2PSessionError(code))
+	})
+	f.PostEvent(steamworks.P2PSessionConnectFail{
+		SteamIDRemote:   remote,
+		P2PSessionError: internal.EP2PSessionError(code),
+	})
+}
+
+func (n *fakeNetworking) SendP2PPacket(remote steamworks.SteamID, data []byte, sendType internal.EP2PSend, channel int32) bool {
+	if remote == 0 {
+		return false
+	}
+	if (sendType < internal.EP2PSend_Reliable && len(data) > 1200) || len(data) > 1<<20 {
+		return false
+	}
+
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	if n.bufferFull {
+		return false
+	}
+
+	n.sent = append(n.sent, Packet{
+		Remote:      remote,
+		Channel:     channel,
+		Reliability: sendType,
+		Data:        append([]byte(nil), data...),
+	})
+
+	return true
+}
+
+func (n *fakeNetworking) IsP2PPacketAvailable(channel int32) (uint32, bool) {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	queue := n.incoming[channel]
+	if len(queue) == 0 {
+		return 0, false
+	}
+
+	return uint32(len(queue[0].Data)), true
+}
+
+func (n *fakeNetworking) ReadP2PPacket(buffer []byte, channel int32) (uint32, steamworks.SteamID, bool) {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	queue := n.incoming[channel]
+	if len(queue) == 0 {
+		return 0, 0, false
+	}
+
+	packet := queue[0]
+	n.incoming[channel] = queue[1:]
+
+	// Like Steam, truncate the packet if the buffer is too small.
+	size := copy(buffer, packet.Data)
+
+	return uint32(size), packet.Remote, true
+}
+
+func (n *fakeNetworking) AcceptP2PSessionWithUser(remote steamworks.SteamID) bool {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	if n.accepted == nil {
+		n.accepted = make(map[steamworks.SteamID]bool)
+	}
+	n.accepted[remote] = true
+
+	return true
+}
+
+func (n *fakeNetworking) CloseP2PSessionWithUser(remote steamworks.SteamID) bool {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	wasOpen := n.accepted[remote]
+	delete(n.accepted, remote)
+	delete(n.states, remote)
+
+	return wasOpen
+}
+
+func (n *fakeNetworking) CloseP2PChannelWithUser(remote steamworks.SteamID, channel int32) bool {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	return n.accepted[remote]
+}
+
+func (n *fakeNetworking) GetP2PSessionState(remote steamworks.SteamID) (steamworks.P2PSessionState, bool) {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	if state, ok := n.states[remote]; ok {
+		return state, true
+	}
+
+	if n.accepted[remote] {
+		return steamworks.P2PSessionState{ConnectionActive: true}, true
+	}
+
+	return steamworks.P2PSessionState{}, false
+}
+
+func (n *fakeNetworking) AllowP2PPacketRelay(allow bool) bool {
+	n.f.lock.Lock()
+	defer n.f.lock.Unlock()
+
+	n.allowRelay = allow
+
+	return true
+}
+
+func (n *fakeNetworking) OnP2PSessionRequest(fn func(remote steamworks.SteamID)) steamworks.Registration {
+	return register(n.f, steamworks.P2PSessionRequest{}.CallbackID(), &n.onSessionRequest, fn)
+}
+
+func (n *fakeNetworking) OnP2PSessionConnectFail(fn func(remote steamworks.SteamID, sessionError internal.EP2PSessionError)) steamworks.Registration {
+	return register(n.f, steamworks.P2PSessionConnectFail{}.CallbackID(), &n.onSessionConnectFail, fn)
+}
@@ -0,0 +1,86 @@
+package steamtest
+
+import (
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+	"github.com/BenLubar/steamworks/steamparentalsettings"
+)
+
+// ParentalSettings is the state reported by the steamparentalsettings
+// package.
+type ParentalSettings struct {
+	LockEnabled bool
+	LockLocked  bool
+
+	BlockedApps         map[steamworks.AppID]bool
+	AppsInBlockList     map[steamworks.AppID]bool
+	BlockedFeatures     map[steamparentalsettings.Feature]bool
+	FeaturesInBlockList map[steamparentalsettings.Feature]bool
+}
+
+type fakeParentalSettings struct {
+	f *Fake
+
+	settings ParentalSettings
+
+	onChanged hooks[func()]
+}
+
+// SetParentalSettings replaces the parental settings and posts a
+// notification that they changed.
+func (f *Fake) SetParentalSettings(settings ParentalSettings) {
+	f.lock.Lock()
+	f.parental.settings = settings
+	f.lock.Unlock()
+
+	post(f, &f.parental.onChanged, func(fn func()) {
+		fn()
+	})
+	f.PostEvent(steamworks.SteamParentalSettingsChanged{})
+}
+
+func (p *fakeParentalSettings) IsParentalLockEnabled() bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.LockEnabled
+}
+
+func (p *fakeParentalSettings) IsParentalLockLocked() bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.LockLocked
+}
+
+func (p *fakeParentalSettings) IsAppBlocked(appID steamworks.AppID) bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.BlockedApps[appID]
+}
+
+func (p *fakeParentalSettings) IsAppInBlockList(appID steamworks.AppID) bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.AppsInBlockList[appID]
+}
+
+func (p *fakeParentalSettings) IsFeatureBlocked(feature internal.EParentalFeature) bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.BlockedFeatures[steamparentalsettings.Feature(feature)]
+}
+
+func (p *fakeParentalSettings) IsFeatureInBlockList(feature internal.EParentalFeature) bool {
+	p.f.lock.Lock()
+	defer p.f.lock.Unlock()
+
+	return p.settings.FeaturesInBlockList[steamparentalsettings.Feature(feature)]
+}
+
+func (p *fakeParentalSettings) OnParentalSettingsChanged(fn func()) steamworks.Registration {
+	return register(p.f, steamworks.SteamParentalSettingsChanged{}.CallbackID(), &p.onChanged, fn)
+}
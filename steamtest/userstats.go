@@ -0,0 +1,64 @@
+package steamtest
+
+import (
+	"github.com/BenLubar/steamworks"
+)
+
+type fakeUserStats struct {
+	f *Fake
+
+	leaderboards map[string]uint64
+
+	onFindResult hooks[func(leaderboard uint64, found, ioFailure bool)]
+}
+
+// SetLeaderboard makes FindLeaderboard return handle for the leaderboard
+// with the given name. A handle of 0 removes the leaderboard.
+func (f *Fake) SetLeaderboard(name string, handle uint64) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if handle == 0 {
+		delete(f.userStats.leaderboards, name)
+		return
+	}
+	if f.userStats.leaderboards == nil {
+		f.userStats.leaderboards = make(map[string]uint64)
+	}
+	f.userStats.leaderboards[name] = handle
+}
+
+// newCall returns a new API call handle.
+func (f *Fake) newCall() steamworks.APICall {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.lastCall++
+	return f.lastCall
+}
+
+func (s *fakeUserStats) FindLeaderboard(name string) steamworks.APICall {
+	call := s.f.newCall()
+
+	s.f.Post(func() {
+		s.f.lock.Lock()
+		leaderboard, found := s.leaderboards[name]
+		reason, ok := s.f.failures[call]
+		ioFailure := ok && reason != steamworks.APICallFailureNone
+		s.f.lock.Unlock()
+
+		if ioFailure {
+			leaderboard, found = 0, false
+		}
+
+		deliverCall(s.f, &s.onFindResult, call, func(fn func(uint64, bool, bool)) {
+			fn(leaderboard, found, ioFailure)
+		})
+	})
+
+	return call
+}
+
+func (s *fakeUserStats) OnLeaderboardFindResult(call steamworks.APICall, fn func(leaderboard uint64, found, ioFailure bool)) steamworks.Registration {
+	return registerCall(s.f, steamworks.LeaderboardFindResult{}.CallbackID(), call, &s.onFindResult, fn)
+}
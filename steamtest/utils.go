@@ -0,0 +1,264 @@
+package steamtest
+
+import (
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// UtilsState is the state reported by the steamutils package.
+type UtilsState struct {
+	// BatteryPower is the battery percentage, or 255 if the computer is on
+	// AC power.
+	BatteryPower uint8
+	// IPCountry is the two-letter country code of the user.
+	IPCountry string
+
+	SecondsSinceAppActive      uint32
+	SecondsSinceComputerActive uint32
+	// ServerRealTime is the Steam server time in seconds since the Unix
+	// epoch.
+	ServerRealTime uint32
+
+	OverlayNeedsPresent bool
+	OverlayEnabled      bool
+	BigPictureMode      bool
+
+	RunningInVR               bool
+	VRHeadsetStreamingEnabled bool
+	// VRDashboardStarted counts calls to steamutils.StartVRDashboard.
+	VRDashboardStarted int
+}
+
+type fakeUtils struct {
+	f *Fake
+
+	state       UtilsState
+	enteredText string
+
+	debug   func(string)
+	warning func(string)
+
+	onLowBattery           hooks[func(uint8)]
+	onIPCountryChanged     hooks[func()]
+	onSteamShutdown        hooks[func()]
+	onGamepadTextDismissed hooks[func(bool, uint32)]
+}
+
+// UtilsState returns the current state reported by the steamutils package.
+func (f *Fake) UtilsState() UtilsState {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.utils.state
+}
+
+// SetUtilsState replaces the state reported by the steamutils package. The
+// default state has a battery power of 255 and a country of "US".
+func (f *Fake) SetUtilsState(state UtilsState) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.utils.state = state
+}
+
+// PostLowBatteryPower simulates a low battery warning.
+func (f *Fake) PostLowBatteryPower(minutesLeft uint8) {
+	post(f, &f.utils.onLowBattery, func(fn func(uint8)) {
+		fn(minutesLeft)
+	})
+	f.PostEvent(steamworks.LowBatteryPower{MinutesBatteryLeft: minutesLeft})
+}
+
+// PostIPCountryChanged changes the user's country and posts a notification.
+func (f *Fake) PostIPCountryChanged(country string) {
+	f.lock.Lock()
+	f.utils.state.IPCountry = country
+	f.lock.Unlock()
+
+	post(f, &f.utils.onIPCountryChanged, func(fn func()) {
+		fn()
+	})
+	f.PostEvent(steamworks.IPCountry{})
+}
+
+// PostSteamShutdown simulates the Steam client shutting down.
+func (f *Fake) PostSteamShutdown() {
+	post(f, &f.utils.onSteamShutdown, func(fn func()) {
+		fn()
+	})
+	f.PostEvent(steamworks.SteamShutdown{})
+}
+
+// PostGamepadTextInputDismissed simulates the user closing the big picture
+// text input. If submitted is true, text is the text the user entered.
+func (f *Fake) PostGamepadTextInputDismissed(text string, submitted bool) {
+	f.lock.Lock()
+	f.utils.enteredText = text
+	f.lock.Unlock()
+
+	length := uint32(len(text) + 1)
+
+	post(f, &f.utils.onGamepadTextDismissed, func(fn func(bool, uint32)) {
+		fn(submitted, length)
+	})
+	f.PostEvent(steamworks.GamepadTextInputDismissed{
+		Submitted:     submitted,
+		SubmittedText: length,
+	})
+}
+
+// DebugMessage passes a debug message to the hooks registered with
+// steamutils.RegisterDebugMessageHook. Unlike other callbacks, messages are
+// delivered immediately.
+func (f *Fake) DebugMessage(msg string) {
+	f.lock.Lock()
+	hook := f.utils.debug
+	f.lock.Unlock()
+
+	if hook != nil {
+		hook(msg)
+	}
+}
+
+// WarningMessage passes a warning message to the hooks registered with
+// steamutils.RegisterWarningMessageHook. Unlike other callbacks, messages are
+// delivered immediately.
+func (f *Fake) WarningMessage(msg string) {
+	f.lock.Lock()
+	hook := f.utils.warning
+	f.lock.Unlock()
+
+	if hook != nil {
+		hook(msg)
+	}
+}
+
+func (u *fakeUtils) CurrentBatteryPower() uint8 {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.BatteryPower
+}
+
+func (u *fakeUtils) IPCountry() string {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.IPCountry
+}
+
+func (u *fakeUtils) SecondsSinceAppActive() uint32 {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.SecondsSinceAppActive
+}
+
+func (u *fakeUtils) SecondsSinceComputerActive() uint32 {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.SecondsSinceComputerActive
+}
+
+func (u *fakeUtils) ServerRealTime() uint32 {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.ServerRealTime
+}
+
+func (u *fakeUtils) OverlayNeedsPresent() bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.OverlayNeedsPresent
+}
+
+func (u *fakeUtils) IsOverlayEnabled() bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.OverlayEnabled
+}
+
+func (u *fakeUtils) IsSteamInBigPictureMode() bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.BigPictureMode
+}
+
+func (u *fakeUtils) SetOverlayNotificationInset(horizontal, vertical int32) {}
+
+func (u *fakeUtils) SetOverlayNotificationPosition(position internal.ENotificationPosition) {}
+
+func (u *fakeUtils) ShowGamepadTextInput(inputMode internal.EGamepadTextInputMode, lineInputMode internal.EGamepadTextInputLineMode, description string, maxLength uint32, existingText string) bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.BigPictureMode
+}
+
+func (u *fakeUtils) GetEnteredGamepadTextInput(length uint32) (string, bool) {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	if length == 0 || int(length) > len(u.enteredText)+1 {
+		return "", false
+	}
+
+	return u.enteredText[:length-1], true
+}
+
+func (u *fakeUtils) IsSteamRunningInVR() bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.RunningInVR
+}
+
+func (u *fakeUtils) StartVRDashboard() {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	u.state.VRDashboardStarted++
+}
+
+func (u *fakeUtils) IsVRHeadsetStreamingEnabled() bool {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	return u.state.VRHeadsetStreamingEnabled
+}
+
+func (u *fakeUtils) SetVRHeadsetStreamingEnabled(enabled bool) {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	u.state.VRHeadsetStreamingEnabled = enabled
+}
+
+func (u *fakeUtils) SetWarningMessageHook(debug, warning func(string)) {
+	u.f.lock.Lock()
+	defer u.f.lock.Unlock()
+
+	u.debug = debug
+	u.warning = warning
+}
+
+func (u *fakeUtils) OnLowBatteryPower(fn func(minutesLeft uint8)) steamworks.Registration {
+	return register(u.f, steamworks.LowBatteryPower{}.CallbackID(), &u.onLowBattery, fn)
+}
+
+func (u *fakeUtils) OnIPCountryChanged(fn func()) steamworks.Registration {
+	return register(u.f, steamworks.IPCountry{}.CallbackID(), &u.onIPCountryChanged, fn)
+}
+
+func (u *fakeUtils) OnSteamShutdown(fn func()) steamworks.Registration {
+	return register(u.f, steamworks.SteamShutdown{}.CallbackID(), &u.onSteamShutdown, fn)
+}
+
+func (u *fakeUtils) OnGamepadTextInputDismissed(fn func(submitted bool, length uint32)) steamworks.Registration {
+	return register(u.f, steamworks.GamepadTextInputDismissed{}.CallbackID(), &u.onGamepadTextDismissed, fn)
+}
@@ -0,0 +1,128 @@
+package steamtest
+
+import (
+	"encoding/binary"
+
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// optimalSampleRate is the sample rate reported by the fake voice decoder.
+const optimalSampleRate = 24000
+
+type fakeVoice struct {
+	f *Fake
+
+	recording bool
+	speaking  bool
+	captured  []byte
+}
+
+// CaptureVoice adds compressed voice data to the microphone buffer read by
+// steamvoice.Reader. Data is only captured while recording.
+//
+// The fake voice codec is uncompressed little-endian 16-bit PCM, so the
+// result of steamvoice.DecompressVoice is data reinterpreted as samples.
+func (f *Fake) CaptureVoice(data []byte) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.voice.recording {
+		f.voice.captured = append(f.voice.captured, data...)
+	}
+}
+
+// VoiceRecording returns true if steamvoice.StartRecording has been called
+// more recently than steamvoice.StopRecording.
+func (f *Fake) VoiceRecording() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.voice.recording
+}
+
+// InGameVoiceSpeaking returns the value most recently passed to
+// steamvoice.SetInGameSpeaking.
+func (f *Fake) InGameVoiceSpeaking() bool {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.voice.speaking
+}
+
+func (v *fakeVoice) StartVoiceRecording() {
+	v.f.lock.Lock()
+	defer v.f.lock.Unlock()
+
+	v.recording = true
+}
+
+func (v *fakeVoice) StopVoiceRecording() {
+	v.f.lock.Lock()
+	defer v.f.lock.Unlock()
+
+	v.recording = false
+}
+
+func (v *fakeVoice) SetInGameVoiceSpeaking(speaking bool) {
+	v.f.lock.Lock()
+	defer v.f.lock.Unlock()
+
+	v.speaking = speaking
+}
+
+func (v *fakeVoice) GetAvailableVoice() (uint32, internal.EVoiceResult) {
+	v.f.lock.Lock()
+	defer v.f.lock.Unlock()
+
+	return uint32(len(v.captured)), v.result()
+}
+
+func (v *fakeVoice) GetVoice(buffer []byte) (uint32, internal.EVoiceResult) {
+	v.f.lock.Lock()
+	defer v.f.lock.Unlock()
+
+	if result := v.result(); result != internal.EVoiceResult_OK {
+		return 0, result
+	}
+
+	if len(buffer) < len(v.captured) {
+		return 0, internal.EVoiceResult_BufferTooSmall
+	}
+
+	n := copy(buffer, v.captured)
+	v.captured = v.captured[:0]
+
+	return uint32(n), internal.EVoiceResult_OK
+}
+
+// result returns the voice result for the current state. The caller must
+// hold the lock.
+func (v *fakeVoice) result() internal.EVoiceResult {
+	if len(v.captured) != 0 {
+		return internal.EVoiceResult_OK
+	}
+	if !v.recording {
+		return internal.EVoiceResult_NotRecording
+	}
+	return internal.EVoiceResult_NoData
+}
+
+func (v *fakeVoice) DecompressVoice(compressed []byte, buffer []uint16, sampleRate uint32) (uint32, internal.EVoiceResult) {
+	if len(compressed)%2 != 0 {
+		return 0, internal.EVoiceResult_DataCorrupted
+	}
+
+	if len(buffer) < len(compressed)/2 {
+		return 0, internal.EVoiceResult_BufferTooSmall
+	}
+
+	for i := range compressed[:len(compressed)/2] {
+		buffer[i] = binary.LittleEndian.Uint16(compressed[i*2:])
+	}
+
+	return uint32(len(compressed) / 2), internal.EVoiceResult_OK
+}
+
+func (v *fakeVoice) GetVoiceOptimalSampleRate() uint32 {
+	return optimalSampleRate
+}
@@ -0,0 +1,28 @@
+// Package steamuserstats wraps Steam's stats and leaderboards API.
+//
+// See the Steam Stats and Achievements documentation for more details.
+// <https://partner.steamgames.com/doc/features/achievements>
+package steamuserstats
+
+import (
+	"github.com/BenLubar/steamworks"
+)
+
+// Leaderboard is a handle to a leaderboard. The zero Leaderboard means the
+// leaderboard does not exist.
+type Leaderboard uint64
+
+// FindLeaderboard looks up the leaderboard with the given name. The result
+// is 0 if there is no leaderboard with that name.
+//
+// The result is delivered by steamworks.RunCallbacks.
+func FindLeaderboard(name string) *steamworks.CallResult[Leaderboard] {
+	userStats := steamworks.GetBackend().UserStats()
+	call := userStats.FindLeaderboard(name)
+
+	return steamworks.NewCallResult(call, func(call steamworks.APICall, complete func(Leaderboard, bool)) steamworks.Registration {
+		return userStats.OnLeaderboardFindResult(call, func(leaderboard uint64, found, ioFailure bool) {
+			complete(Leaderboard(leaderboard), ioFailure)
+		})
+	})
+}
@@ -0,0 +1,73 @@
+package steamuserstats_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamtest"
+	"github.com/BenLubar/steamworks/steamuserstats"
+)
+
+func TestFindLeaderboard(t *testing.T) {
+	fake := steamtest.New(480, steamworks.SteamID(76561197960287930))
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	fake.SetLeaderboard("Feet Traveled", 42)
+
+	found := steamuserstats.FindLeaderboard("Feet Traveled")
+	missing := steamuserstats.FindLeaderboard("Quickest Win")
+	failed := steamuserstats.FindLeaderboard("Feet Traveled")
+	fake.SetAPICallFailure(failed.Call(), steamworks.APICallFailureNetworkFailure)
+	canceled := steamuserstats.FindLeaderboard("Feet Traveled")
+	canceled.Cancel()
+
+	if n := pendingCallResults(fake); n != 3 {
+		t.Errorf("%d pending call results before RunCallbacks, expected 3", n)
+	}
+
+	steamworks.RunCallbacks()
+
+	if n := pendingCallResults(fake); n != 0 {
+		t.Errorf("%d pending call results after RunCallbacks, expected 0", n)
+	}
+
+	ctx := context.Background()
+	for _, r := range []*steamworks.CallResult[steamuserstats.Leaderboard]{found, missing, failed, canceled} {
+		select {
+		case <-r.Done():
+		default:
+			t.Fatalf("call %d is still pending after RunCallbacks", r.Call())
+		}
+	}
+
+	if leaderboard, err := found.Wait(ctx); err != nil || leaderboard != 42 {
+		t.Errorf("found: got (%v, %v), expected (42, nil)", leaderboard, err)
+	}
+	if leaderboard, err := missing.Wait(ctx); err != nil || leaderboard != 0 {
+		t.Errorf("missing: got (%v, %v), expected (0, nil)", leaderboard, err)
+	}
+	var callErr *steamworks.CallResultError
+	if _, err := failed.Wait(ctx); !errors.As(err, &callErr) || callErr.Reason != steamworks.APICallFailureNetworkFailure {
+		t.Errorf("failed: got error %v, expected network failure", err)
+	}
+	if _, err := canceled.Wait(ctx); err != steamworks.ErrCallCanceled {
+		t.Errorf("canceled: got error %v, expected %v", err, steamworks.ErrCallCanceled)
+	}
+}
+
+func pendingCallResults(fake *steamtest.Fake) int {
+	n := 0
+	for _, r := range fake.Registrations() {
+		if r.Call != 0 {
+			n++
+		}
+	}
+	return n
+}
@@ -7,11 +7,6 @@ import (
 	"github.com/BenLubar/steamworks/internal"
 )
 
-func init() {
-	internal.OnDebugMessage = onMessage(&debugMessageHooks)
-	internal.OnWarningMessage = onMessage(&warningMessageHooks)
-}
-
 var messageHookLock sync.Mutex
 var debugMessageHooks []func(string)
 var warningMessageHooks []func(string)
@@ -33,10 +28,18 @@ func onMessage(phooks *[]func(string)) func(string) {
 type hookRegistration struct {
 	phooks *[]func(string)
 	index  int
+	done   bool
 }
 
-func (hr hookRegistration) Unregister() {
+func (hr *hookRegistration) Unregister() {
 	messageHookLock.Lock()
+	if hr.done {
+		// The slot may have been reused by a later registration.
+		messageHookLock.Unlock()
+		return
+	}
+	hr.done = true
+
 	// Don't modify the slice directly as the underlying array might be in use
 	// by onMessage.
 	hooks := make([]func(string), len(*hr.phooks), cap(*hr.phooks))
@@ -53,7 +56,7 @@ func (hr hookRegistration) Unregister() {
 	messageHookLock.Unlock()
 }
 
-func registerMessageHook(phooks *[]func(string), f func(string)) hookRegistration {
+func registerMessageHook(phooks *[]func(string), f func(string)) *hookRegistration {
 	initOnce.Do(doInit)
 
 	messageHookLock.Lock()
@@ -61,7 +64,7 @@ func registerMessageHook(phooks *[]func(string), f func(string)) hookRegistratio
 	*phooks = append(*phooks, f)
 	messageHookLock.Unlock()
 
-	return hookRegistration{
+	return &hookRegistration{
 		phooks: phooks,
 		index:  index,
 	}
@@ -80,8 +83,8 @@ func RegisterWarningMessageHook(f func(string)) steamworks.Registration {
 	return registerMessageHook(&warningMessageHooks, f)
 }
 
-var initOnce sync.Once
+var initOnce internal.Once
 
 func doInit() {
-	internal.SetWarningMessageHook()
+	steamworks.GetBackend().Utils().SetWarningMessageHook(onMessage(&debugMessageHooks), onMessage(&warningMessageHooks))
 }
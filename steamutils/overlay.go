@@ -2,8 +2,8 @@ package steamutils
 
 import (
 	"sync"
-	"unsafe"
 
+	"github.com/BenLubar/steamworks"
 	"github.com/BenLubar/steamworks/internal"
 )
 
@@ -23,7 +23,7 @@ import (
 // desirable) and make sure you refresh the screen with Present or SwapBuffers
 // to allow the overlay to do its work.
 func OverlayNeedsPresent() bool {
-	return internal.SteamAPI_ISteamUtils_BOverlayNeedsPresent()
+	return steamworks.GetBackend().Utils().OverlayNeedsPresent()
 }
 
 // IsOverlayEnabled checks if the Steam Overlay is running and the user can
@@ -33,7 +33,7 @@ func OverlayNeedsPresent() bool {
 // process, so this function will initially return false while the overlay
 // is loading.
 func IsOverlayEnabled() bool {
-	return internal.SteamAPI_ISteamUtils_IsOverlayEnabled()
+	return steamworks.GetBackend().Utils().IsOverlayEnabled()
 }
 
 // IsSteamInBigPictureMode checks if Steam and the Steam Overlay are running in
@@ -45,7 +45,7 @@ func IsOverlayEnabled() bool {
 //
 // This will always return false if your app is not the 'game' application type.
 func IsSteamInBigPictureMode() bool {
-	return internal.SteamAPI_ISteamUtils_IsSteamInBigPictureMode()
+	return steamworks.GetBackend().Utils().IsSteamInBigPictureMode()
 }
 
 var gamepadTextLock sync.Mutex
@@ -68,7 +68,7 @@ func GamepadTextInput(password, multiLine bool, description string, maxLength ui
 	gamepadTextLock.Lock()
 	defer gamepadTextLock.Unlock()
 
-	defer internal.Cleanup()()
+	utils := steamworks.GetBackend().Utils()
 
 	inputMode := internal.EGamepadTextInputMode_Normal
 	if password {
@@ -79,32 +79,24 @@ func GamepadTextInput(password, multiLine bool, description string, maxLength ui
 		lineInputMode = internal.EGamepadTextInputLineMode_MultipleLines
 	}
 
-	cdescription := internal.CString(description)
-	defer internal.Free(unsafe.Pointer(cdescription))
-	cexistingText := internal.CString(existingText)
-	defer internal.Free(unsafe.Pointer(cexistingText))
-
 	ch := make(chan *string, 1)
 
-	registration := internal.RegisterCallback_GamepadTextInputDismissed(func(data *internal.GamepadTextInputDismissed, _ bool) {
-		if !data.BSubmitted {
+	registration := utils.OnGamepadTextInputDismissed(func(submitted bool, length uint32) {
+		if !submitted {
 			ch <- nil
 			return
 		}
 
-		ctextBuf := internal.Malloc(uintptr(data.UnSubmittedText) + 1)
-		defer internal.Free(ctextBuf)
-		ctext := (*internal.CChar)(ctextBuf)
-		if !internal.SteamAPI_ISteamUtils_GetEnteredGamepadTextInput(ctext, uint32(data.UnSubmittedText)) {
+		text, ok := utils.GetEnteredGamepadTextInput(length)
+		if !ok {
 			ch <- nil
 			return
 		}
-		text := internal.GoStringN(ctext, uintptr(data.UnSubmittedText))
 		ch <- &text
-	}, 0)
+	})
 	defer registration.Unregister()
 
-	if !internal.SteamAPI_ISteamUtils_ShowGamepadTextInput(inputMode, lineInputMode, cdescription, maxLength, cexistingText) {
+	if !utils.ShowGamepadTextInput(inputMode, lineInputMode, description, maxLength, existingText) {
 		return existingText, false
 	}
 
@@ -122,9 +114,7 @@ func GamepadTextInput(password, multiLine bool, description string, maxLength ui
 //
 // This position is per-game and is reset each launch.
 func SetOverlayNotificationInset(horizontal, vertical int) {
-	defer internal.Cleanup()()
-
-	internal.SteamAPI_ISteamUtils_SetOverlayNotificationInset(int32(horizontal), int32(vertical))
+	steamworks.GetBackend().Utils().SetOverlayNotificationInset(int32(horizontal), int32(vertical))
 }
 
 // SetOverlayNotificationPosition sets which corner the Steam overlay
@@ -135,16 +125,16 @@ func SetOverlayNotificationInset(horizontal, vertical int) {
 //
 // This position is per-game and is reset each launch.
 func SetOverlayNotificationPosition(left, top bool) {
-	defer internal.Cleanup()()
+	utils := steamworks.GetBackend().Utils()
 
 	switch {
 	case left && top:
-		internal.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionTopLeft)
+		utils.SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionTopLeft)
 	case left:
-		internal.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionBottomLeft)
+		utils.SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionBottomLeft)
 	case top:
-		internal.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionTopRight)
+		utils.SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionTopRight)
 	default:
-		internal.SteamAPI_ISteamUtils_SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionBottomRight)
+		utils.SetOverlayNotificationPosition(internal.ENotificationPosition_EPositionBottomRight)
 	}
 }
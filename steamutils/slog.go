@@ -0,0 +1,122 @@
+//go:build go1.21
+// +build go1.21
+
+package steamutils
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/internal"
+)
+
+// SlogHandler is a slog.Handler that adds attributes describing the state of
+// the Steamworks API to each record before passing it to Handler:
+//
+//    app_id       the app ID of the current process
+//    game_server  true if the game server is the only one initialized
+//    callback     the callback type being handled when the record was
+//                 emitted, if any, such as "P2PSessionRequest(1202)"
+//
+// app_id and game_server are recorded when the game client or game server is
+// initialized, so logging does not call into the Steamworks API. They are
+// omitted while neither is initialized.
+//
+// The attributes are added to the group opened by WithGroup, if any.
+type SlogHandler struct {
+	Handler slog.Handler
+}
+
+// NewSlogHandler returns a SlogHandler that passes records to h.
+func NewSlogHandler(h slog.Handler) *SlogHandler {
+	return &SlogHandler{Handler: h}
+}
+
+// Enabled implements slog.Handler.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.Handler.Enabled(ctx, level)
+}
+
+// Handle implements slog.Handler.
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	r = r.Clone()
+	if p := internal.CurrentProcess(); p.Live {
+		r.AddAttrs(
+			slog.Uint64("app_id", uint64(p.AppID)),
+			slog.Bool("game_server", p.GameServer))
+	}
+	if cb := internal.Dispatching(); cb != 0 {
+		r.AddAttrs(slog.String("callback", steamworks.CallbackID(cb).String()))
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs implements slog.Handler.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &SlogHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+// WithGroup implements slog.Handler.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	return &SlogHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// AttachLogger sends Steam's debug and warning messages, and this module's
+// own diagnostics, to logger, using a SlogHandler to add the app ID, game
+// server and callback attributes.
+//
+// Debug messages are logged at slog.LevelDebug and warning messages at
+// slog.LevelWarn. Diagnostics, such as an auth session that was never closed,
+// and panics recovered from callback handlers are logged at slog.LevelError.
+//
+// Debug messages are only produced if Steam is started with -debug_steamapi.
+//
+// AttachLogger registers handlers with steamworks.HandleDiagnostics and
+// steamworks.HandleCallbackPanics, so it can be called at any time.
+// Unregistering the returned Registration stops sending messages to logger.
+func AttachLogger(logger *slog.Logger) steamworks.Registration {
+	al := &attachedLogger{
+		logger: slog.New(NewSlogHandler(logger.Handler())),
+	}
+
+	al.regs = []steamworks.Registration{
+		RegisterDebugMessageHook(func(msg string) {
+			al.log(slog.LevelDebug, msg)
+		}),
+		RegisterWarningMessageHook(func(msg string) {
+			al.log(slog.LevelWarn, msg)
+		}),
+		steamworks.HandleDiagnostics(func(d steamworks.Diagnostic) {
+			al.log(slog.LevelError, d.Message, slog.String("package", d.Package))
+		}),
+		steamworks.HandleCallbackPanics(func(p steamworks.CallbackPanic) {
+			al.log(slog.LevelError, "recovered from panic in callback handler",
+				slog.Any("panic", p.Value),
+				slog.String("stack", string(p.Stack)))
+		}),
+	}
+
+	return al
+}
+
+type attachedLogger struct {
+	logger *slog.Logger
+
+	once sync.Once
+	regs []steamworks.Registration
+}
+
+func (al *attachedLogger) log(level slog.Level, msg string, attrs ...slog.Attr) {
+	al.logger.LogAttrs(context.Background(), level, msg, attrs...)
+}
+
+func (al *attachedLogger) Unregister() {
+	al.once.Do(func() {
+		for _, reg := range al.regs {
+			reg.Unregister()
+		}
+	})
+}
@@ -0,0 +1,66 @@
+//go:build go1.21
+// +build go1.21
+
+package steamutils_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamtest"
+	"github.com/BenLubar/steamworks/steamutils"
+)
+
+func TestSlogHandler(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(steamutils.NewSlogHandler(slog.NewJSONHandler(&buf, nil)))
+
+	record := func() map[string]interface{} {
+		t.Helper()
+
+		var m map[string]interface{}
+		if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+			t.Fatal(err)
+		}
+		buf.Reset()
+		return m
+	}
+
+	// Steam is not initialized, so nothing can be asked of it.
+	logger.Info("before init")
+	if m := record(); m["app_id"] != nil || m["game_server"] != nil {
+		t.Errorf("before init: got %v, expected no app_id or game_server", m)
+	}
+
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false); err != nil {
+		t.Fatal(err)
+	}
+
+	logger.Info("client")
+	if m := record(); m["app_id"] != float64(480) || m["game_server"] != false {
+		t.Errorf("client: got %v, expected app_id 480 and game_server false", m)
+	}
+
+	steamworks.Shutdown()
+
+	logger.Info("after shutdown")
+	if m := record(); m["app_id"] != nil || m["game_server"] != nil {
+		t.Errorf("after shutdown: got %v, expected no app_id or game_server", m)
+	}
+
+	if err := steamworks.InitServer(nil, 0, 27015, 27016, steamworks.Authentication, "1.0", false); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	logger.Info("server")
+	if m := record(); m["app_id"] != float64(480) || m["game_server"] != true {
+		t.Errorf("server: got %v, expected app_id 480 and game_server true", m)
+	}
+}
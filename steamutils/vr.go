@@ -1,23 +1,21 @@
 package steamutils
 
-import "github.com/BenLubar/steamworks/internal"
+import "github.com/BenLubar/steamworks"
 
 // IsSteamRunningInVR returns true if Steam itself is running in VR mode.
 func IsSteamRunningInVR() bool {
-	return internal.SteamAPI_ISteamUtils_IsSteamRunningInVR()
+	return steamworks.GetBackend().Utils().IsSteamRunningInVR()
 }
 
 // StartVRDashboard asks Steam to create and render the OpenVR dashboard.
 func StartVRDashboard() {
-	defer internal.Cleanup()()
-
-	internal.SteamAPI_ISteamUtils_StartVRDashboard()
+	steamworks.GetBackend().Utils().StartVRDashboard()
 }
 
 // IsVRHeadsetStreamingEnabled checks if the HMD view will be streamed via
 // Steam In-Home Streaming.
 func IsVRHeadsetStreamingEnabled() bool {
-	return internal.SteamAPI_ISteamUtils_IsVRHeadsetStreamingEnabled()
+	return steamworks.GetBackend().Utils().IsVRHeadsetStreamingEnabled()
 }
 
 // SetVRHeadsetStreamingEnabled sets whether the HMD content will be streamed
@@ -31,5 +29,5 @@ func IsVRHeadsetStreamingEnabled() bool {
 //
 // This is useful for games that have asymmetric multiplayer gameplay.
 func SetVRHeadsetStreamingEnabled(enabled bool) {
-	internal.SteamAPI_ISteamUtils_SetVRHeadsetStreamingEnabled(enabled)
+	steamworks.GetBackend().Utils().SetVRHeadsetStreamingEnabled(enabled)
 }
@@ -1,8 +1,7 @@
 package steamvoice
 
 import (
-	"unsafe"
-
+	"github.com/BenLubar/steamworks"
 	"github.com/BenLubar/steamworks/internal"
 )
 
@@ -15,7 +14,7 @@ import (
 // you ignore this function and use the native sample rate of your audio output
 // device, which is usually 48000 or 44100.
 func OptimalSampleRate() uint32 {
-	return internal.SteamAPI_ISteamUser_GetVoiceOptimalSampleRate()
+	return steamworks.GetBackend().Voice().GetVoiceOptimalSampleRate()
 }
 
 // DecompressVoice decodes the compressed voice data returned by GetVoice.
@@ -24,17 +23,22 @@ func OptimalSampleRate() uint32 {
 // any sample rate from 11025 to 48000. See OptimalSampleRate for more
 // information.
 func DecompressVoice(compressed []byte, sampleRate uint32) ([]uint16, error) {
+	if !steamworks.Supported() {
+		return nil, steamworks.ErrUnsupported
+	}
+
+	voice := steamworks.GetBackend().Voice()
+
 	buffer := make([]uint16, 10<<10)
 
 	for {
-		var bytesWritten uint32
-		result := internal.SteamAPI_ISteamUser_DecompressVoice(unsafe.Pointer(&compressed[0]), uint32(len(compressed)), unsafe.Pointer(&buffer[0]), uint32(len(buffer))*2, &bytesWritten, sampleRate)
+		samples, result := voice.DecompressVoice(compressed, buffer, sampleRate)
 
 		if result == internal.EVoiceResult_BufferTooSmall {
 			buffer = make([]uint16, len(buffer)*2)
 			continue
 		}
 
-		return buffer[:bytesWritten/2], toError(result)
+		return buffer[:samples], toError(result)
 	}
 }
@@ -9,7 +9,8 @@ import (
 
 // Errors returned by this package.
 //
-// Additional errors include io.EOF and io.ErrShortBuffer.
+// Additional errors include io.EOF, io.ErrShortBuffer, and
+// steamworks.ErrUnsupported.
 var (
 	ErrNotInitialized = errors.New("steamworks/steamvoice: interface has not been initialized")
 	ErrDataCorrupted  = errors.New("steamworks/steamvoice: voice data has been corrupted")
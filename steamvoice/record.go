@@ -6,11 +6,7 @@
 // <https://partner.steamgames.com/doc/features/voice>
 package steamvoice
 
-import (
-	"unsafe"
-
-	"github.com/BenLubar/steamworks/internal"
-)
+import "github.com/BenLubar/steamworks"
 
 // StartRecording starts voice recording.
 //
@@ -18,7 +14,7 @@ import (
 // the user has released their push-to-talk hotkey or the game session has
 // completed.
 func StartRecording() {
-	internal.SteamAPI_ISteamUser_StartVoiceRecording()
+	steamworks.GetBackend().Voice().StartVoiceRecording()
 }
 
 // StopRecording stops voice recording.
@@ -28,7 +24,7 @@ func StartRecording() {
 // should continue to be read from until it returns io.EOF. Only then will
 // voice recording be stopped.
 func StopRecording() {
-	internal.SteamAPI_ISteamUser_StopVoiceRecording()
+	steamworks.GetBackend().Voice().StopVoiceRecording()
 }
 
 // SetInGameSpeaking lets Steam know that the user is currently using voice
@@ -37,7 +33,7 @@ func StopRecording() {
 // This will suppress the microphone for all voice communication in the Steam
 // UI.
 func SetInGameSpeaking(speaking bool) {
-	internal.SteamAPI_ISteamFriends_SetInGameVoiceSpeaking(0, speaking)
+	steamworks.GetBackend().Voice().SetInGameVoiceSpeaking(speaking)
 }
 
 // Reader is a stream of captured audio data from the microphone buffer.
@@ -65,19 +61,21 @@ type VoiceReader struct{}
 // Read implements io.Reader. Reading is non-blocking, and if no data is
 // available, (0, nil) will be returned.
 func (VoiceReader) Read(p []byte) (int, error) {
-	defer internal.Cleanup()()
+	if !steamworks.Supported() {
+		return 0, steamworks.ErrUnsupported
+	}
 
-	var bytesWritten uint32
-	result := internal.SteamAPI_ISteamUser_GetVoice(true, unsafe.Pointer(&p[0]), uint32(len(p)), &bytesWritten, false, nil, 0, nil, 0)
+	bytesWritten, result := steamworks.GetBackend().Voice().GetVoice(p)
 	return int(bytesWritten), toError(result)
 }
 
 // Available returns the number of bytes of compressed voice data currently
 // available from Read.
 func (VoiceReader) Available() (int, error) {
-	defer internal.Cleanup()()
+	if !steamworks.Supported() {
+		return 0, steamworks.ErrUnsupported
+	}
 
-	var bytesAvailable uint32
-	result := internal.SteamAPI_ISteamUser_GetAvailableVoice(&bytesAvailable, nil, 0)
+	bytesAvailable, result := steamworks.GetBackend().Voice().GetAvailableVoice()
 	return int(bytesAvailable), toError(result)
 }
@@ -0,0 +1,77 @@
+package steamworks
+
+import "sync"
+
+var (
+	tickLock  sync.Mutex
+	tickHooks []*tickHook
+)
+
+type tickHook struct {
+	f func()
+
+	// removed is guarded by tickLock.
+	removed bool
+}
+
+// OnRunCallbacks registers f to be called at the end of every call to
+// RunCallbacks, including the calls made by the callback goroutine and by the
+// RunCallbacks methods of Client and Server. f is run like a callback
+// handler: by the Executor or DispatchPending if InitClient or InitServer was
+// called with WithExecutor or WithDispatchQueue, and otherwise on the
+// goroutine that called RunCallbacks. If f panics, the panic is passed to
+// OnCallbackPanic, or to the handler registered with HandleCallbackPanics.
+//
+// Once Unregister returns, calls to f that were queued but not started are
+// skipped.
+//
+// Packages such as steamnet use this to poll Steam once per callback tick
+// rather than running a goroutine of their own.
+func OnRunCallbacks(f func()) Registration {
+	h := &tickHook{f: f}
+
+	tickLock.Lock()
+	tickHooks = append(tickHooks, h)
+	tickLock.Unlock()
+
+	return h
+}
+
+func (h *tickHook) Unregister() {
+	tickLock.Lock()
+	defer tickLock.Unlock()
+
+	h.removed = true
+	for i, hook := range tickHooks {
+		if hook == h {
+			// copy so that a tick in progress is not affected
+			tickHooks = append(tickHooks[:i:i], tickHooks[i+1:]...)
+			return
+		}
+	}
+}
+
+// runCallbacks runs the callbacks of b and then the functions registered
+// with OnRunCallbacks.
+func runCallbacks(b Backend) {
+	b.RunCallbacks()
+
+	tickLock.Lock()
+	hooks := tickHooks
+	tickLock.Unlock()
+
+	for _, h := range hooks {
+		execute(h.run)
+	}
+}
+
+// run calls h.f unless h was unregistered after it was queued.
+func (h *tickHook) run() {
+	tickLock.Lock()
+	removed := h.removed
+	tickLock.Unlock()
+
+	if !removed {
+		h.f()
+	}
+}
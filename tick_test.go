@@ -0,0 +1,50 @@
+package steamworks_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/steamworks"
+	"github.com/BenLubar/steamworks/steamtest"
+)
+
+func TestOnRunCallbacksDispatchQueue(t *testing.T) {
+	fake := steamtest.New(480, 76561197960287930)
+	defer fake.Install()()
+
+	if err := steamworks.InitClient(false, steamworks.WithDispatchQueue()); err != nil {
+		t.Fatal(err)
+	}
+	defer steamworks.Shutdown()
+
+	var panics []steamworks.CallbackPanic
+	panicReg := steamworks.HandleCallbackPanics(func(p steamworks.CallbackPanic) {
+		panics = append(panics, p)
+	})
+	defer panicReg.Unregister()
+
+	called := 0
+	reg := steamworks.OnRunCallbacks(func() {
+		called++
+		panic("tick")
+	})
+
+	steamworks.RunCallbacks()
+	if called != 0 {
+		t.Errorf("hook called %d times before DispatchPending, expected 0", called)
+	}
+
+	steamworks.DispatchPending()
+	if called != 1 {
+		t.Errorf("hook called %d times, expected 1", called)
+	}
+	if len(panics) != 1 || panics[0].Value != "tick" {
+		t.Errorf("recovered panics: got %+v, expected one with value \"tick\"", panics)
+	}
+
+	steamworks.RunCallbacks()
+	reg.Unregister()
+	steamworks.DispatchPending()
+	if called != 1 {
+		t.Errorf("hook called %d times after Unregister, expected 1", called)
+	}
+}
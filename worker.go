@@ -0,0 +1,45 @@
+package steamworks
+
+import "time"
+
+// WithWorkerThread makes calls into the Steamworks SDK that use thread-local
+// memory run on a single long-lived goroutine locked to its own OS thread,
+// instead of locking the calling goroutine's thread and releasing the Steam
+// API's thread-local memory after each call. The worker releases that memory at most once per
+// releaseInterval. A releaseInterval of 0 releases it after every call.
+//
+// This reduces the cost of frequent calls such as reading packets or
+// controller actions, especially when they are grouped using Batch.
+// Initialization, shutdown, and RunCallbacks still run on the calling
+// goroutine, and callback handlers that call the Steamworks SDK wait for the
+// worker like any other goroutine.
+//
+// The worker is shared by the game client and the game server, and it is
+// stopped when the last of them is shut down. WithWorkerThread has no effect
+// in builds that do not link the Steamworks SDK.
+func WithWorkerThread(releaseInterval time.Duration) InitOption {
+	return func(opts *initOptions) {
+		opts.worker = true
+		opts.releaseInterval = releaseInterval
+	}
+}
+
+// Batch runs fn on the worker thread started by WithWorkerThread, so that the
+// calls into the Steamworks SDK made by fn do not each wait for the worker.
+// Batch returns after fn returns. If fn panics, Batch panics with the same
+// value.
+//
+// Example:
+//
+//    steamworks.Batch(func() {
+//        for i, action := range digitalActions {
+//            states[i], _ = steamcontroller.GetDigitalActionData(controller, action)
+//        }
+//    })
+//
+// If the worker thread is not running, Batch calls fn on the current
+// goroutine. fn must not wait for other goroutines that call into the
+// Steamworks SDK, as they wait for fn to return.
+func Batch(fn func()) {
+	batch(fn)
+}